@@ -1,60 +1,160 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
 	"os"
-	"strconv"
-	"time"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
 
 	"github.com/joho/godotenv"
 
 	"subscription-service/internal/app"
 	"subscription-service/internal/config"
+	"subscription-service/internal/migrations"
 )
 
+const usage = `Usage:
+  core [serve] [flags]                  run the HTTP server
+  core migrate %s [flags]
+                                        manage the database schema
+  core migrate create NAME [--dir DIR]  add an empty SQL migration
+
+Run "core serve --help" to list the configuration flags.
+`
+
 func main() {
-	err := godotenv.Load()
-	if err != nil {
-		panic(err)
+	// .env is a convenience for local runs; deployments pass real environment variables.
+	if err := godotenv.Load(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		fail(2, "load .env: %v", err)
 	}
 
-	address := os.Getenv("SERVER_PORT")
-	dbConnectionString := os.Getenv("DATABASE_CONNECTION_STRING")
-	maxOpenConnsRaw := os.Getenv("MAX_OPEN_CONNS")
-	maxOpenConns, err := strconv.ParseInt(maxOpenConnsRaw, 10, 32)
-	if err != nil {
-		panic(err)
+	command, args := "serve", os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		command, args = args[0], args[1:]
 	}
 
-	maxIdleTimeRaw := os.Getenv("MAX_IDLE_TIME")
-	maxIdleTime, err := time.ParseDuration(maxIdleTimeRaw)
-	if err != nil {
-		panic(err)
+	switch command {
+	case "serve":
+		serve(args)
+	case "migrate":
+		migrate(args)
+	case "help":
+		printUsage()
+	default:
+		printUsage()
+		os.Exit(2)
+	}
+}
+
+func serve(args []string) {
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	printConfig := flags.Bool("print-config", false, "print the effective configuration with secrets redacted and exit")
+
+	cfg := loadConfig(flags, args)
+
+	if *printConfig {
+		if err := cfg.Print(os.Stdout); err != nil {
+			fail(1, "%v", err)
+		}
+		return
 	}
 
-	maxLifetimeRaw := os.Getenv("MAX_LIFE_TIME")
-	maxLifeTime, err := time.ParseDuration(maxLifetimeRaw)
+	app.Run(cfg)
+}
+
+func migrate(args []string) {
+	parsed, err := parseMigrateArgs(args)
 	if err != nil {
-		panic(err)
+		if errors.Is(err, errUsage) {
+			printUsage()
+			os.Exit(2)
+		}
+		fail(2, "migrate: %v", err)
+	}
+
+	if parsed.command == "create" {
+		if err := migrations.Create(parsed.dir, parsed.name); err != nil {
+			fail(1, "migrate create: %v", err)
+		}
+		return
+	}
+
+	cfg := loadConfig(flag.NewFlagSet("migrate "+parsed.command, flag.ExitOnError), parsed.flags)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := app.Migrate(ctx, cfg, parsed.command, os.Stdout); err != nil {
+		fail(1, "migrate %s: %v", parsed.command, err)
+	}
+}
+
+// errUsage is a migrate command line without a known command; the usage explains it.
+var errUsage = errors.New("unknown migrate command")
+
+// migrateArgs is the parsed command line of core migrate.
+type migrateArgs struct {
+	command string
+	// name and dir are the migration create adds and the directory it goes to.
+	name, dir string
+	// flags configure the other commands, see loadConfig.
+	flags []string
+}
+
+func parseMigrateArgs(args []string) (migrateArgs, error) {
+	if len(args) == 0 {
+		return migrateArgs{}, errUsage
 	}
 
-	logLevel := config.InfoLevel
+	command, args := args[0], args[1:]
 
-	debug := os.Getenv("DEBUG")
-	if debug == "true" {
-		logLevel = config.DebugLevel
+	if command != "create" {
+		if !slices.Contains(app.MigrateCommands, command) {
+			return migrateArgs{}, fmt.Errorf("%w %q", errUsage, command)
+		}
+		return migrateArgs{command: command, flags: args}, nil
 	}
 
-	cfg, err := config.New(
-		address,
-		dbConnectionString,
-		logLevel,
-		int32(maxOpenConns),
-		maxIdleTime,
-		maxLifeTime,
-	)
+	flags := flag.NewFlagSet("migrate create", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	dir := flags.String("dir", migrations.Dir, "directory to create the migration in")
+
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return migrateArgs{}, errors.New("create: migration name is required")
+	}
+
+	name := args[0]
+	if err := flags.Parse(args[1:]); err != nil {
+		return migrateArgs{}, fmt.Errorf("create: %w", err)
+	}
+	if flags.NArg() > 0 {
+		return migrateArgs{}, fmt.Errorf("create: unexpected arguments %v", flags.Args())
+	}
+
+	return migrateArgs{command: command, name: name, dir: *dir}, nil
+}
+
+func loadConfig(flags *flag.FlagSet, args []string) *config.Config {
+	cfg, err := config.Load(flags, args, os.LookupEnv)
 	if err != nil {
-		panic(err)
+		fail(2, "invalid configuration:\n%v", err)
 	}
 
-	app.Run(cfg)
+	return cfg
+}
+
+func printUsage() {
+	fmt.Fprintf(os.Stderr, usage, strings.Join(app.MigrateCommands, "|"))
+}
+
+func fail(code int, format string, args ...any) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(code)
 }
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"subscription-service/internal/migrations"
+)
+
+func TestParseMigrateArgs(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		want      migrateArgs
+		wantUsage bool
+		wantErr   bool
+	}{
+		{"up", []string{"up"}, migrateArgs{command: "up"}, false, false},
+		{"down with flags", []string{"down", "--db-auto-migrate=false"},
+			migrateArgs{command: "down", flags: []string{"--db-auto-migrate=false"}}, false, false},
+		{"status", []string{"status"}, migrateArgs{command: "status"}, false, false},
+		{"version", []string{"version"}, migrateArgs{command: "version"}, false, false},
+		{"redo", []string{"redo"}, migrateArgs{command: "redo"}, false, false},
+		{"create", []string{"create", "add_notes"},
+			migrateArgs{command: "create", name: "add_notes", dir: migrations.Dir}, false, false},
+		{"create in a directory", []string{"create", "add_notes", "--dir", "/tmp/migrations"},
+			migrateArgs{command: "create", name: "add_notes", dir: "/tmp/migrations"}, false, false},
+		{"no command", nil, migrateArgs{}, true, false},
+		{"unknown command", []string{"sideways"}, migrateArgs{}, true, false},
+		{"flag for a command", []string{"--dir", "up"}, migrateArgs{}, true, false},
+		{"create without a name", []string{"create"}, migrateArgs{}, false, true},
+		{"create with a flag for a name", []string{"create", "--dir", "x"}, migrateArgs{}, false, true},
+		{"create with an unknown flag", []string{"create", "add_notes", "--force"}, migrateArgs{}, false, true},
+		{"create with two names", []string{"create", "add_notes", "add_tags"}, migrateArgs{}, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseMigrateArgs(tt.args)
+			if errors.Is(err, errUsage) != tt.wantUsage || (err != nil && !tt.wantUsage) != tt.wantErr {
+				t.Fatalf("parse %v: %v, want usage %v, error %v", tt.args, err, tt.wantUsage, tt.wantErr)
+			}
+			if got.command != tt.want.command || got.name != tt.want.name || got.dir != tt.want.dir ||
+				!slices.Equal(got.flags, tt.want.flags) {
+				t.Errorf("parse %v = %+v, want %+v", tt.args, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+
+	"subscription-service/internal/subctl"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+
+	code := subctl.Run(ctx, os.Args[1:], subctl.Options{
+		Stdin:     os.Stdin,
+		Stdout:    os.Stdout,
+		Stderr:    os.Stderr,
+		LookupEnv: os.LookupEnv,
+	})
+
+	stop()
+	os.Exit(code)
+}
@@ -3,27 +3,26 @@ package db
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
 
 	"subscription-service/internal/config"
 	"subscription-service/internal/migrations"
 )
 
 func NewPostgresPool(ctx context.Context, cfg config.DatabaseConfig) (*pgxpool.Pool, error) {
-	db, err := sql.Open("pgx", cfg.ConnectionString)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open database: %w", err)
-	}
-
-	if err := migrations.Migrate(db); err != nil {
-		return nil, fmt.Errorf("failed to migrate database: %w", err)
-	}
-
-	if err = db.Close(); err != nil {
-		return nil, fmt.Errorf("failed to close database: %w", err)
+	if cfg.AutoMigrate {
+		err := WithMigrations(ctx, cfg.ConnectionString, func(provider *goose.Provider) error {
+			_, err := provider.Up(ctx)
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to migrate database: %w", err)
+		}
 	}
 
 	pgxConfig, err := pgxpool.ParseConfig(cfg.ConnectionString)
@@ -42,3 +41,29 @@ func NewPostgresPool(ctx context.Context, cfg config.DatabaseConfig) (*pgxpool.P
 
 	return pool, nil
 }
+
+// WithMigrations opens a dedicated connection for goose and runs fn with a provider of
+// the embedded migrations.
+func WithMigrations(ctx context.Context, connStr string, fn func(*goose.Provider) error) (err error) {
+	db, err := sql.Open("pgx", connStr)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+
+	defer func() {
+		if cErr := db.Close(); cErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to close database: %w", cErr))
+		}
+	}()
+
+	if err := db.PingContext(ctx); err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	provider, err := migrations.NewProvider(db)
+	if err != nil {
+		return err
+	}
+
+	return fn(provider)
+}
@@ -0,0 +1,229 @@
+package jwtauth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/config"
+	"subscription-service/internal/port"
+)
+
+const leeway = 30 * time.Second
+
+var _ port.TokenVerifier = (*Verifier)(nil)
+
+// Verifier validates HS256 and RS256 bearer tokens. HMAC secrets come from the config
+// or from "oct" keys of a local JWKS file, RSA public keys come from the JWKS file.
+type Verifier struct {
+	hmacKeys map[string][]byte
+	rsaKeys  map[string]*rsa.PublicKey
+	parser   *jwt.Parser
+}
+
+type claims struct {
+	jwt.RegisteredClaims
+
+	UserID   string   `json:"user_id"`
+	TenantID string   `json:"tenant_id"`
+	Role     string   `json:"role"`
+	Roles    []string `json:"roles"`
+}
+
+func NewVerifier(cfg config.JWTConfig) (*Verifier, error) {
+	v := &Verifier{
+		hmacKeys: map[string][]byte{},
+		rsaKeys:  map[string]*rsa.PublicKey{},
+	}
+
+	if cfg.HMACSecret != "" {
+		v.hmacKeys[""] = []byte(cfg.HMACSecret)
+	}
+
+	if cfg.JWKSFile != "" {
+		if err := v.loadJWKS(cfg.JWKSFile); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(v.hmacKeys) == 0 && len(v.rsaKeys) == 0 {
+		return nil, errors.New("jwt verifier has no keys configured")
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg(), jwt.SigningMethodRS256.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(leeway),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+
+	v.parser = jwt.NewParser(opts...)
+
+	return v, nil
+}
+
+func (v *Verifier) Verify(_ context.Context, token string) (entity.Principal, error) {
+	var c claims
+
+	if _, err := v.parser.ParseWithClaims(token, &c, v.key); err != nil {
+		return entity.Principal{}, fmt.Errorf("%w: %w", port.ErrInvalidToken, err)
+	}
+
+	if c.Subject == "" {
+		return entity.Principal{}, fmt.Errorf("%w: sub claim is required", port.ErrInvalidToken)
+	}
+
+	userID := c.UserID
+	if userID == "" {
+		userID = c.Subject
+	}
+	if _, err := uuid.Parse(userID); err != nil {
+		return entity.Principal{}, fmt.Errorf("%w: user_id or sub claim is not a uuid", port.ErrInvalidToken)
+	}
+
+	if c.TenantID != "" {
+		if _, err := uuid.Parse(c.TenantID); err != nil {
+			return entity.Principal{}, fmt.Errorf("%w: tenant_id claim is not a uuid", port.ErrInvalidToken)
+		}
+	}
+
+	roleNames := c.Roles
+	if c.Role != "" {
+		roleNames = append(roleNames, c.Role)
+	}
+
+	roles := make([]entity.Role, 0, len(roleNames))
+	for _, name := range roleNames {
+		switch role := entity.Role(name); role {
+		case entity.RoleUser, entity.RoleAdmin:
+			roles = append(roles, role)
+		default:
+			return entity.Principal{}, fmt.Errorf("%w: unknown role %q", port.ErrInvalidToken, name)
+		}
+	}
+	if len(roles) == 0 {
+		roles = append(roles, entity.RoleUser)
+	}
+
+	return entity.Principal{
+		Subject:  "jwt:" + c.Subject,
+		UserID:   userID,
+		TenantID: c.TenantID,
+		Roles:    roles,
+		Method:   entity.AuthMethodJWT,
+	}, nil
+}
+
+// key picks the verification key by algorithm family first, so an RSA public key can
+// never be used as an HMAC secret.
+func (v *Verifier) key(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		return lookup(v.hmacKeys, kid)
+	case *jwt.SigningMethodRSA:
+		return lookup(v.rsaKeys, kid)
+	default:
+		return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+	}
+}
+
+func lookup[K any](keys map[string]K, kid string) (K, error) {
+	if key, ok := keys[kid]; ok {
+		return key, nil
+	}
+
+	var zero K
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, nil
+		}
+	}
+
+	return zero, fmt.Errorf("no key for kid %q", kid)
+}
+
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	K   string `json:"k"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func (v *Verifier) loadJWKS(path string) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read jwks file: %w", err)
+	}
+
+	var set jwks
+	if err = json.Unmarshal(raw, &set); err != nil {
+		return fmt.Errorf("parse jwks file: %w", err)
+	}
+
+	for _, key := range set.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+
+		switch key.Kty {
+		case "oct":
+			secret, err := base64.RawURLEncoding.DecodeString(key.K)
+			if err != nil {
+				return fmt.Errorf("decode oct key %q: %w", key.Kid, err)
+			}
+			v.hmacKeys[key.Kid] = secret
+		case "RSA":
+			pub, err := rsaPublicKey(key)
+			if err != nil {
+				return fmt.Errorf("decode RSA key %q: %w", key.Kid, err)
+			}
+			v.rsaKeys[key.Kid] = pub
+		default:
+			return fmt.Errorf("unsupported jwk type %q", key.Kty)
+		}
+	}
+
+	return nil
+}
+
+func rsaPublicKey(key jwk) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(key.N)
+	if err != nil {
+		return nil, fmt.Errorf("modulus: %w", err)
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(key.E)
+	if err != nil {
+		return nil, fmt.Errorf("exponent: %w", err)
+	}
+
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() > int64(^uint32(0)>>1) {
+		return nil, errors.New("exponent is too large")
+	}
+
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
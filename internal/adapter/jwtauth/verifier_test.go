@@ -0,0 +1,157 @@
+package jwtauth_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"subscription-service/internal/adapter/jwtauth"
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/config"
+	"subscription-service/internal/port"
+)
+
+const aliceID = "0b6f4e0a-5d6c-4f0e-9a51-2f1c3e1d7a42"
+
+func sign(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signed
+}
+
+func TestVerifyHS256(t *testing.T) {
+	verifier, err := jwtauth.NewVerifier(config.JWTConfig{HMACSecret: "secret", Issuer: "issuer"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token := sign(t, jwt.SigningMethodHS256, []byte("secret"), "", jwt.MapClaims{
+		"sub":   aliceID,
+		"iss":   "issuer",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"roles": []string{"admin"},
+	})
+
+	principal, err := verifier.Verify(context.Background(), token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !principal.IsAdmin() || principal.Method != entity.AuthMethodJWT || principal.UserID != aliceID {
+		t.Errorf("unexpected principal %+v", principal)
+	}
+
+	expired := sign(t, jwt.SigningMethodHS256, []byte("secret"), "", jwt.MapClaims{
+		"sub": aliceID,
+		"iss": "issuer",
+		"exp": time.Now().Add(-time.Hour).Unix(),
+	})
+	if _, err = verifier.Verify(context.Background(), expired); !errors.Is(err, port.ErrInvalidToken) {
+		t.Errorf("expected expired token to be rejected, got %v", err)
+	}
+
+	foreign := sign(t, jwt.SigningMethodHS256, []byte("secret"), "", jwt.MapClaims{
+		"sub": aliceID,
+		"iss": "someone-else",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	if _, err = verifier.Verify(context.Background(), foreign); !errors.Is(err, port.ErrInvalidToken) {
+		t.Errorf("expected token of another issuer to be rejected, got %v", err)
+	}
+}
+
+func TestVerifyRejectsUserWithoutUUID(t *testing.T) {
+	verifier, err := jwtauth.NewVerifier(config.JWTConfig{HMACSecret: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		claims jwt.MapClaims
+	}{
+		{"sub is not a uuid", jwt.MapClaims{"sub": "alice"}},
+		{"user_id is not a uuid", jwt.MapClaims{"sub": aliceID, "user_id": "alice"}},
+		{"sub is missing", jwt.MapClaims{"user_id": aliceID}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.claims["exp"] = time.Now().Add(time.Hour).Unix()
+
+			token := sign(t, jwt.SigningMethodHS256, []byte("secret"), "", tt.claims)
+			if _, err := verifier.Verify(context.Background(), token); !errors.Is(err, port.ErrInvalidToken) {
+				t.Errorf("expected token to be rejected, got %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyRS256FromJWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	set := map[string]any{"keys": []map[string]string{{
+		"kty": "RSA",
+		"kid": "main",
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}}
+
+	raw, err := json.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err = os.WriteFile(path, raw, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	verifier, err := jwtauth.NewVerifier(config.JWTConfig{JWKSFile: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims := jwt.MapClaims{
+		"sub":     "bob",
+		"user_id": "60601fee-2bf1-4721-ae6f-7636e79a0cba",
+		"exp":     time.Now().Add(time.Hour).Unix(),
+	}
+
+	principal, err := verifier.Verify(context.Background(), sign(t, jwt.SigningMethodRS256, key, "main", claims))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if principal.UserID != "60601fee-2bf1-4721-ae6f-7636e79a0cba" || !principal.HasRole(entity.RoleUser) {
+		t.Errorf("unexpected principal %+v", principal)
+	}
+
+	// The RSA public key must never be accepted as an HMAC secret.
+	publicKey := key.N.Bytes()
+	forged := sign(t, jwt.SigningMethodHS256, publicKey, "main", claims)
+	if _, err = verifier.Verify(context.Background(), forged); !errors.Is(err, port.ErrInvalidToken) {
+		t.Errorf("expected HS256 token signed with the public key to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+var _ port.AnalyticsRepo = (*Subscription)(nil)
+
+// MonthlyMetrics follows the query of the Postgres adapter: the MRR of every user in
+// every month, from a month before filter.From, compared with the month before.
+func (r *Subscription) MonthlyMetrics(ctx context.Context, filter entity.MetricsFilter) ([]entity.MonthlyMetrics, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, port.ErrTenantRequired
+	}
+
+	from, to := entity.MonthStart(filter.From), entity.MonthStart(filter.To)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rows := r.matching(tenantID, entity.ListSubscriptionFilter{Title: filter.Title, ServiceID: filter.ServiceID})
+
+	// mrr holds the MRR of the users billed in a month, by month and user.
+	mrr := map[time.Time]map[string]int64{}
+	for month := entity.AddMonths(from, -1); !month.After(to); month = entity.AddMonths(month, 1) {
+		users := map[string]int64{}
+		for _, row := range rows {
+			if price, ok := row.sub.PriceAt(month); ok {
+				users[row.sub.UserID] += price
+			}
+		}
+		mrr[month] = users
+	}
+
+	var metrics []entity.MonthlyMetrics
+	for month := from; !month.After(to); month = entity.AddMonths(month, 1) {
+		current := mrr[month]
+		previous := mrr[entity.AddMonths(month, -1)]
+
+		m := entity.MonthlyMetrics{Month: month, PreviousSubscribers: int64(len(previous))}
+		for user, cost := range current {
+			m.MRR += cost
+			m.Subscribers++
+
+			was, ok := previous[user]
+			switch {
+			case !ok:
+				m.NewMRR += cost
+				m.NewSubscribers++
+			case cost > was:
+				m.ExpansionMRR += cost - was
+			case cost < was:
+				m.ContractionMRR += was - cost
+			}
+		}
+		for user, was := range previous {
+			if _, ok := current[user]; !ok {
+				m.ChurnedMRR += was
+				m.ChurnedSubscribers++
+			}
+		}
+
+		metrics = append(metrics, m)
+	}
+
+	return metrics, nil
+}
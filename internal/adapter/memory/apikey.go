@@ -0,0 +1,96 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+var _ port.APIKeyRepo = (*APIKey)(nil)
+
+// APIKey keeps API keys in memory.
+type APIKey struct {
+	mu   sync.Mutex
+	keys []apiKeyRow
+}
+
+type apiKeyRow struct {
+	key  entity.APIKey
+	hash string
+}
+
+func NewAPIKey() *APIKey {
+	return &APIKey{}
+}
+
+func (r *APIKey) Create(_ context.Context, key entity.APIKey, hash []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, row := range r.keys {
+		if row.key.ID == key.ID || row.hash == string(hash) {
+			return errors.New("api key already exists")
+		}
+	}
+
+	r.keys = append(r.keys, apiKeyRow{key: key, hash: string(hash)})
+
+	return nil
+}
+
+func (r *APIKey) GetByHash(_ context.Context, hash []byte) (*entity.APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, row := range r.keys {
+		if row.hash == string(hash) {
+			key := row.key
+			return &key, nil
+		}
+	}
+
+	return nil, port.ErrNotFound
+}
+
+func (r *APIKey) List(_ context.Context, tenantID *string) ([]entity.APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var keys []entity.APIKey
+
+	for _, row := range r.keys {
+		if inTenant(row.key, tenantID) {
+			keys = append(keys, row.key)
+		}
+	}
+
+	slices.SortStableFunc(keys, func(a, b entity.APIKey) int {
+		return cmp.Compare(a.CreatedAt, b.CreatedAt)
+	})
+
+	return keys, nil
+}
+
+func (r *APIKey) Revoke(_ context.Context, id string, tenantID *string, revokedAt int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, row := range r.keys {
+		if row.key.ID == id && inTenant(row.key, tenantID) && row.key.RevokedAt == nil {
+			r.keys[i].key.RevokedAt = &revokedAt
+			return nil
+		}
+	}
+
+	return port.ErrNotFound
+}
+
+// inTenant reports whether the key belongs to tenantID; every key does for a nil one.
+func inTenant(key entity.APIKey, tenantID *string) bool {
+	return tenantID == nil || key.TenantID != nil && *key.TenantID == *tenantID
+}
@@ -0,0 +1,152 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+var _ port.BudgetRepo = (*Subscription)(nil)
+
+type budgetRow struct {
+	tenantID string
+	budget   entity.Budget
+}
+
+func (r *Subscription) CreateBudget(ctx context.Context, budget entity.Budget) error {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, taken := r.budgets[budget.ID]; taken || r.categoryTaken(tenantID, budget) {
+		return port.ErrBudgetAlreadyExists
+	}
+
+	r.budgets[budget.ID] = budgetRow{tenantID: tenantID, budget: cloneBudget(budget)}
+
+	return nil
+}
+
+func (r *Subscription) GetBudget(ctx context.Context, id string) (*entity.Budget, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row, ok := r.budgets[id]
+	if !ok || row.tenantID != tenantID {
+		return nil, port.ErrNotFound
+	}
+
+	budget := cloneBudget(row.budget)
+
+	return &budget, nil
+}
+
+func (r *Subscription) UpdateBudget(ctx context.Context, budget entity.Budget) error {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row, ok := r.budgets[budget.ID]
+	if !ok || row.tenantID != tenantID {
+		return port.ErrNotFound
+	}
+
+	row.budget.Category = budget.Category
+	row.budget.Services = slices.Clone(budget.Services)
+	row.budget.Limit = budget.Limit
+	row.budget.Strict = budget.Strict
+	row.budget.UpdatedAt = budget.UpdatedAt
+
+	if r.categoryTaken(tenantID, row.budget) {
+		return port.ErrBudgetAlreadyExists
+	}
+
+	r.budgets[budget.ID] = row
+
+	return nil
+}
+
+func (r *Subscription) DeleteBudget(ctx context.Context, id string) error {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row, ok := r.budgets[id]
+	if !ok || row.tenantID != tenantID {
+		return port.ErrNotFound
+	}
+
+	delete(r.budgets, id)
+
+	return nil
+}
+
+func (r *Subscription) ListBudgets(ctx context.Context, userID string) ([]entity.Budget, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.listBudgets(tenantID, userID), nil
+}
+
+// listBudgets returns the budgets of the user. The caller holds the lock.
+func (r *Subscription) listBudgets(tenantID, userID string) []entity.Budget {
+	var budgets []entity.Budget
+	for _, row := range r.budgets {
+		if row.tenantID == tenantID && row.budget.UserID == userID {
+			budgets = append(budgets, cloneBudget(row.budget))
+		}
+	}
+
+	slices.SortFunc(budgets, func(a, b entity.Budget) int {
+		return cmp.Compare(a.Category, b.Category)
+	})
+
+	return budgets
+}
+
+// categoryTaken reports whether the user of budget has another budget for its category,
+// like the unique constraint of the budgets table. The caller holds the lock.
+func (r *Subscription) categoryTaken(tenantID string, budget entity.Budget) bool {
+	for id, row := range r.budgets {
+		if id != budget.ID && row.tenantID == tenantID && row.budget.UserID == budget.UserID &&
+			row.budget.Category == budget.Category {
+			return true
+		}
+	}
+
+	return false
+}
+
+func cloneBudget(b entity.Budget) entity.Budget {
+	b.Services = slices.Clone(b.Services)
+	if len(b.Services) == 0 {
+		b.Services = nil
+	}
+
+	return b
+}
@@ -0,0 +1,126 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+const idempotencySweepEvery = 1024
+
+var _ port.IdempotencyStore = (*Idempotency)(nil)
+
+// Idempotency keeps responses to requests with an Idempotency-Key in memory.
+type Idempotency struct {
+	mu    sync.Mutex
+	keys  map[idempotencyKey]*idempotencyEntry
+	calls int
+	now   func() time.Time
+}
+
+type idempotencyKey struct {
+	scope, key string
+}
+
+type idempotencyEntry struct {
+	fingerprint []byte
+	// token identifies the holder of the lease.
+	token string
+	// resp is nil while the lease holder runs the request.
+	resp      *entity.IdempotentResponse
+	expiresAt time.Time
+}
+
+func NewIdempotency() *Idempotency {
+	return &Idempotency{
+		keys: map[idempotencyKey]*idempotencyEntry{},
+		now:  time.Now,
+	}
+}
+
+func (r *Idempotency) Acquire(
+	_ context.Context,
+	scope, key, token string,
+	fingerprint []byte,
+	lease time.Duration,
+) (*entity.IdempotentResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+
+	r.calls++
+	if r.calls%idempotencySweepEvery == 0 {
+		r.sweep(now)
+	}
+
+	k := idempotencyKey{scope: scope, key: key}
+
+	// A new key, an expired response or an abandoned lease: take the lease.
+	e, ok := r.keys[k]
+	if !ok || !e.expiresAt.After(now) {
+		r.keys[k] = &idempotencyEntry{
+			fingerprint: bytes.Clone(fingerprint),
+			token:       token,
+			expiresAt:   now.Add(lease),
+		}
+		return nil, nil
+	}
+
+	if !bytes.Equal(e.fingerprint, fingerprint) {
+		return nil, port.ErrIdempotencyKeyReused
+	}
+
+	if e.resp == nil {
+		return nil, port.ErrIdempotencyInProgress
+	}
+
+	resp := *e.resp
+
+	return &resp, nil
+}
+
+func (r *Idempotency) Complete(
+	_ context.Context,
+	scope, key, token string,
+	resp entity.IdempotentResponse,
+	ttl time.Duration,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.keys[idempotencyKey{scope: scope, key: key}]
+	if !ok || e.token != token || e.resp != nil {
+		return port.ErrIdempotencyLeaseLost
+	}
+
+	e.resp = &resp
+	e.expiresAt = r.now().Add(ttl)
+
+	return nil
+}
+
+func (r *Idempotency) Release(_ context.Context, scope, key, token string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k := idempotencyKey{scope: scope, key: key}
+	if e, ok := r.keys[k]; ok && e.token == token && e.resp == nil {
+		delete(r.keys, k)
+	}
+
+	return nil
+}
+
+// sweep removes expired responses and abandoned leases. The caller holds the lock.
+func (r *Idempotency) sweep(now time.Time) {
+	for k, e := range r.keys {
+		if e.expiresAt.Before(now) {
+			delete(r.keys, k)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package memory_test
+
+import (
+	"testing"
+
+	"subscription-service/internal/adapter/memory"
+	"subscription-service/internal/port/porttest"
+)
+
+func TestSubscriptionConformance(t *testing.T) {
+	subs := memory.NewSubscription()
+	porttest.SubscriptionRepo(t, subs)
+	porttest.RenewalRepo(t, subs)
+	porttest.ReminderRepo(t, subs)
+	porttest.BudgetRepo(t, subs)
+	porttest.ServiceRepo(t, subs)
+	porttest.AnalyticsRepo(t, subs, subs)
+}
+
+func TestIdempotencyConformance(t *testing.T) {
+	porttest.IdempotencyStore(t, memory.NewIdempotency())
+}
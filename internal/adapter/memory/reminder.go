@@ -0,0 +1,120 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+var _ port.ReminderRepo = (*Subscription)(nil)
+
+// sentReminder identifies a reminder like the primary key of sent_reminders.
+type sentReminder struct {
+	subscriptionID string
+	kind           entity.ReminderKind
+	date           time.Time
+}
+
+func (r *Subscription) GetReminderPreferences(ctx context.Context, userID string) (*entity.ReminderPreferences, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	prefs, ok := r.prefs[[2]string{tenantID, userID}]
+	if !ok {
+		return nil, port.ErrNotFound
+	}
+
+	return &prefs, nil
+}
+
+func (r *Subscription) PutReminderPreferences(ctx context.Context, prefs entity.ReminderPreferences) error {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.prefs[[2]string{tenantID, prefs.UserID}] = prefs
+
+	return nil
+}
+
+func (r *Subscription) ReminderTargets(_ context.Context, since time.Time) ([]entity.ReminderTarget, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var targets []entity.ReminderTarget
+
+	since = toDate(since)
+	for _, row := range r.rows {
+		prefs, ok := r.prefs[[2]string{row.tenantID, row.sub.UserID}]
+		if !ok || !prefs.Renewals && !prefs.Ends && !prefs.PriceChanges {
+			continue
+		}
+		if row.sub.EndDate != nil && row.sub.EndDate.Before(since) {
+			continue
+		}
+
+		targets = append(targets, entity.ReminderTarget{
+			TenantID:     row.tenantID,
+			Preferences:  prefs,
+			Subscription: *clone(row.sub),
+		})
+	}
+
+	slices.SortFunc(targets, func(a, b entity.ReminderTarget) int {
+		return cmp.Or(cmp.Compare(a.TenantID, b.TenantID), cmp.Compare(a.ID, b.ID))
+	})
+
+	return targets, nil
+}
+
+// SendOnce sends one reminder at a time, which is enough within a process.
+func (r *Subscription) SendOnce(
+	ctx context.Context,
+	reminder entity.Reminder,
+	send func(context.Context) error,
+) (bool, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return false, port.ErrTenantRequired
+	}
+
+	r.sending.Lock()
+	defer r.sending.Unlock()
+
+	key := sentReminder{subscriptionID: reminder.SubscriptionID, kind: reminder.Kind, date: toDate(reminder.Date)}
+
+	r.mu.Lock()
+	row, exists := r.rows[reminder.SubscriptionID]
+	_, sent := r.sent[key]
+	r.mu.Unlock()
+
+	if !exists || row.tenantID != tenantID {
+		return false, port.ErrNotFound
+	}
+	if sent {
+		return false, nil
+	}
+
+	if err := send(ctx); err != nil {
+		return false, err
+	}
+
+	r.mu.Lock()
+	r.sent[key] = struct{}{}
+	r.mu.Unlock()
+
+	return true, nil
+}
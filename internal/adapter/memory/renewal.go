@@ -0,0 +1,151 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+var _ port.RenewalRepo = (*Subscription)(nil)
+
+// TryLock guards the renewals of one process; the store is not shared by replicas.
+func (r *Subscription) TryLock(_ context.Context) (func(), bool, error) {
+	if !r.renewal.TryLock() {
+		return nil, false, nil
+	}
+
+	return r.renewal.Unlock, true, nil
+}
+
+func (r *Subscription) DueRenewals(_ context.Context, until time.Time) ([]entity.TenantSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var subs []entity.TenantSubscription
+
+	until = toDate(until)
+	for _, row := range r.rows {
+		s := row.sub
+		if s.Renewal != nil && s.Cancellation == nil && s.EndDate != nil && !s.EndDate.After(until) {
+			subs = append(subs, entity.TenantSubscription{TenantID: row.tenantID, Subscription: *clone(s)})
+		}
+	}
+
+	slices.SortFunc(subs, func(a, b entity.TenantSubscription) int {
+		return cmp.Or(a.EndDate.Compare(*b.EndDate), cmp.Compare(a.ID, b.ID))
+	})
+
+	return subs, nil
+}
+
+func (r *Subscription) Renew(ctx context.Context, event entity.SubscriptionEvent) (bool, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return false, port.ErrTenantRequired
+	}
+	if event.Renewal == nil {
+		return false, errors.New("renew without a renewal event")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row, ok := r.rows[event.SubscriptionID]
+	if !ok || row.tenantID != tenantID {
+		return false, nil
+	}
+
+	s := row.sub
+	if s.Renewal == nil || s.Cancellation != nil || s.EndDate == nil ||
+		!s.EndDate.Equal(toDate(event.Renewal.PreviousEndDate)) {
+		return false, nil
+	}
+
+	end := toDate(event.Renewal.EndDate)
+	row.sub.EndDate = &end
+	row.sub.UpdatedAt = event.CreatedAt
+
+	if r.conflicts(tenantID, row.sub) {
+		return false, port.ErrSubscriptionAlreadyExists
+	}
+
+	renewal := *event.Renewal
+	renewal.PreviousEndDate = toDate(renewal.PreviousEndDate)
+	renewal.EndDate = end
+	event.Renewal = &renewal
+	row.events = append(row.events, event)
+
+	r.rows[event.SubscriptionID] = row
+
+	return true, nil
+}
+
+func (r *Subscription) ListEvents(ctx context.Context, id string) ([]entity.SubscriptionEvent, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row, ok := r.rows[id]
+	if !ok || row.tenantID != tenantID {
+		return nil, port.ErrNotFound
+	}
+
+	events := make([]entity.SubscriptionEvent, len(row.events))
+	for i, e := range row.events {
+		if e.Renewal != nil {
+			renewal := *e.Renewal
+			e.Renewal = &renewal
+		}
+		if e.BudgetAlert != nil {
+			alert := *e.BudgetAlert
+			e.BudgetAlert = &alert
+		}
+		events[i] = e
+	}
+
+	// The Postgres adapter returns no rows as nil.
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	return events, nil
+}
+
+func (r *Subscription) AddEvents(ctx context.Context, events []entity.SubscriptionEvent) error {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, event := range events {
+		if row, ok := r.rows[event.SubscriptionID]; !ok || row.tenantID != tenantID {
+			return port.ErrNotFound
+		}
+	}
+
+	for _, event := range events {
+		if event.BudgetAlert != nil {
+			alert := *event.BudgetAlert
+			alert.Month = toDate(alert.Month)
+			event.BudgetAlert = &alert
+		}
+
+		row := r.rows[event.SubscriptionID]
+		row.events = append(row.events, event)
+		r.rows[event.SubscriptionID] = row
+	}
+
+	return nil
+}
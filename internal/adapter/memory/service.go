@@ -0,0 +1,242 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+var _ port.ServiceRepo = (*Subscription)(nil)
+
+type serviceRow struct {
+	tenantID string
+	service  entity.Service
+}
+
+func (r *Subscription) CreateService(ctx context.Context, service entity.Service) error {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, taken := r.services[service.ID]; taken || r.nameTaken(tenantID, service) {
+		return port.ErrServiceAlreadyExists
+	}
+
+	if err := r.link(tenantID, service); err != nil {
+		return err
+	}
+	r.services[service.ID] = serviceRow{tenantID: tenantID, service: cloneService(service)}
+
+	return nil
+}
+
+func (r *Subscription) GetService(ctx context.Context, id string) (*entity.Service, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row, ok := r.services[id]
+	if !ok || row.tenantID != tenantID {
+		return nil, port.ErrNotFound
+	}
+
+	service := cloneService(row.service)
+
+	return &service, nil
+}
+
+func (r *Subscription) UpdateService(ctx context.Context, service entity.Service) error {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row, ok := r.services[service.ID]
+	if !ok || row.tenantID != tenantID {
+		return port.ErrNotFound
+	}
+
+	// The creation time is not updated.
+	service.CreatedAt = row.service.CreatedAt
+	if r.nameTaken(tenantID, service) {
+		return port.ErrServiceAlreadyExists
+	}
+
+	if err := r.link(tenantID, service); err != nil {
+		return err
+	}
+	r.services[service.ID] = serviceRow{tenantID: tenantID, service: cloneService(service)}
+
+	return nil
+}
+
+// DeleteService unlinks the subscriptions of the service like the ON DELETE SET NULL of
+// the Postgres schema.
+func (r *Subscription) DeleteService(ctx context.Context, id string) error {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row, ok := r.services[id]
+	if !ok || row.tenantID != tenantID {
+		return port.ErrNotFound
+	}
+
+	delete(r.services, id)
+
+	for subID, sub := range r.rows {
+		if sub.tenantID == tenantID && sub.sub.ServiceID != nil && *sub.sub.ServiceID == id {
+			sub.sub.ServiceID = nil
+			r.rows[subID] = sub
+		}
+	}
+
+	return nil
+}
+
+func (r *Subscription) ListServices(ctx context.Context, filter entity.ServiceFilter) ([]entity.Service, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var services []entity.Service
+	for _, row := range r.services {
+		if row.tenantID == tenantID && (filter.Category == nil || row.service.Category == *filter.Category) {
+			services = append(services, cloneService(row.service))
+		}
+	}
+
+	slices.SortFunc(services, func(a, b entity.Service) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.ID, b.ID))
+	})
+
+	return services, nil
+}
+
+func (r *Subscription) FindService(ctx context.Context, name string) (*entity.Service, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.findService(tenantID, name)
+}
+
+// findService returns the service of the tenant with the name or alias. The caller
+// holds the lock.
+func (r *Subscription) findService(tenantID, name string) (*entity.Service, error) {
+	key := entity.ServiceKey(name)
+	for _, row := range r.services {
+		if row.tenantID == tenantID && slices.Contains(serviceKeys(row.service), key) {
+			service := cloneService(row.service)
+			return &service, nil
+		}
+	}
+
+	return nil, port.ErrNotFound
+}
+
+// nameTaken reports whether another service of the tenant has one of the names of
+// service, like the primary key of the service_names table. The caller holds the lock.
+func (r *Subscription) nameTaken(tenantID string, service entity.Service) bool {
+	keys := serviceKeys(service)
+
+	for id, row := range r.services {
+		if id == service.ID || row.tenantID != tenantID {
+			continue
+		}
+		if slices.ContainsFunc(serviceKeys(row.service), func(k string) bool { return slices.Contains(keys, k) }) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// link sets the service of the subscriptions of the tenant without one whose title is a
+// name of service, or links none and returns ErrSubscriptionAlreadyExists when linked
+// subscriptions of a user would overlap. The caller holds the lock.
+func (r *Subscription) link(tenantID string, service entity.Service) error {
+	keys := serviceKeys(service)
+
+	linked := make(map[string]entity.Subscription)
+	for id, row := range r.rows {
+		if row.tenantID != tenantID || row.sub.ServiceID != nil || !slices.Contains(keys, entity.ServiceKey(row.sub.Title)) {
+			continue
+		}
+
+		sub := row.sub
+		sub.ServiceID = &service.ID
+		linked[id] = sub
+	}
+
+	for id, sub := range linked {
+		for otherID, row := range r.rows {
+			other, ok := linked[otherID]
+			if !ok {
+				other = row.sub
+			}
+			if otherID != id && row.tenantID == tenantID && overlap(sub, other) {
+				return port.ErrSubscriptionAlreadyExists
+			}
+		}
+	}
+
+	for id := range linked {
+		row := r.rows[id]
+		serviceID := service.ID
+		row.sub.ServiceID = &serviceID
+		r.rows[id] = row
+	}
+
+	return nil
+}
+
+func serviceKeys(s entity.Service) []string {
+	names := s.Names()
+
+	keys := make([]string, len(names))
+	for i, name := range names {
+		keys[i] = entity.ServiceKey(name)
+	}
+
+	return keys
+}
+
+func cloneService(s entity.Service) entity.Service {
+	s.Aliases = slices.Clone(s.Aliases)
+	if len(s.Aliases) == 0 {
+		s.Aliases = nil
+	}
+	if s.DefaultPrice != nil {
+		price := *s.DefaultPrice
+		s.DefaultPrice = &price
+	}
+
+	return s
+}
@@ -0,0 +1,614 @@
+// Package memory implements the storage ports in process memory. It mirrors the
+// Postgres adapters in internal/adapter/repo closely enough to run the service without a
+// database, and both are checked against the same suite in internal/port/porttest.
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+var _ port.SubscriptionRepo = (*Subscription)(nil)
+
+// Subscription keeps subscriptions of all tenants in memory.
+type Subscription struct {
+	mu   sync.Mutex
+	rows map[string]subscriptionRow
+	seq  uint64
+	// renewal is the lock of RenewalRepo.TryLock.
+	renewal sync.Mutex
+	// prefs are the reminder preferences of users by tenant and user id.
+	prefs map[[2]string]entity.ReminderPreferences
+	// sending is held by ReminderRepo.SendOnce while it sends and records a reminder.
+	sending sync.Mutex
+	sent    map[sentReminder]struct{}
+	// budgets are the budgets of users by id.
+	budgets map[string]budgetRow
+	// services are the service catalogs of all tenants by id.
+	services map[string]serviceRow
+}
+
+type subscriptionRow struct {
+	tenantID string
+	sub      entity.Subscription
+	// events are the events of the subscription, oldest first; they go with the row.
+	events []entity.SubscriptionEvent
+	// seq orders List like the insertion order of a heap table.
+	seq uint64
+}
+
+func NewSubscription() *Subscription {
+	return &Subscription{
+		rows:     map[string]subscriptionRow{},
+		prefs:    map[[2]string]entity.ReminderPreferences{},
+		sent:     map[sentReminder]struct{}{},
+		budgets:  map[string]budgetRow{},
+		services: map[string]serviceRow{},
+	}
+}
+
+func (r *Subscription) GetSubscription(ctx context.Context, id string) (*entity.Subscription, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row, ok := r.rows[id]
+	if !ok || row.tenantID != tenantID {
+		return nil, port.ErrNotFound
+	}
+
+	return clone(row.sub), nil
+}
+
+// Create fails with ErrSubscriptionAlreadyExists for the same reasons the Postgres
+// adapter does: a taken id or a period that overlaps another subscription of the user to
+// the same service. An unknown service is ErrServiceNotFound.
+func (r *Subscription) Create(ctx context.Context, post entity.CreateSubscriptionRequest) error {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.create(tenantID, post)
+}
+
+// CreateChecked holds the lock of the store from the check to the insert.
+func (r *Subscription) CreateChecked(
+	ctx context.Context,
+	post entity.CreateSubscriptionRequest,
+	check func(port.CheckReader) error,
+) error {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := check(checkReader{subs: r, tenantID: tenantID}); err != nil {
+		return err
+	}
+
+	return r.create(tenantID, post)
+}
+
+// create stores a new subscription. The caller holds the lock.
+func (r *Subscription) create(tenantID string, post entity.CreateSubscriptionRequest) error {
+	if _, taken := r.rows[post.ID]; taken {
+		return port.ErrSubscriptionAlreadyExists
+	}
+	if !r.serviceExists(post.ServiceID) {
+		return port.ErrServiceNotFound
+	}
+
+	sub := entity.Subscription(post)
+	sub.Pauses = nil
+	sub.Cancellation = nil
+	normalize(&sub)
+
+	if r.conflicts(tenantID, sub) {
+		return port.ErrSubscriptionAlreadyExists
+	}
+
+	r.seq++
+	r.rows[post.ID] = subscriptionRow{tenantID: tenantID, sub: sub, seq: r.seq}
+
+	return nil
+}
+
+// Update is a no-op for an unknown id, like an UPDATE that matches no rows.
+func (r *Subscription) Update(ctx context.Context, post entity.UpdateSubscriptionRequest) error {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row, ok := r.rows[post.ID]
+	if !ok || row.tenantID != tenantID {
+		return nil
+	}
+
+	return r.update(tenantID, row, post)
+}
+
+// UpdateChecked holds the lock of the store from the read of the subscription to the
+// update.
+func (r *Subscription) UpdateChecked(
+	ctx context.Context,
+	post entity.UpdateSubscriptionRequest,
+	check func(entity.Subscription, port.CheckReader) error,
+) error {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row, ok := r.rows[post.ID]
+	if !ok || row.tenantID != tenantID {
+		return port.ErrNotFound
+	}
+	if err := check(*clone(row.sub), checkReader{subs: r, tenantID: tenantID}); err != nil {
+		return err
+	}
+
+	return r.update(tenantID, row, post)
+}
+
+// update stores the changes of post to the subscription of row. The caller holds the
+// lock.
+func (r *Subscription) update(tenantID string, row subscriptionRow, post entity.UpdateSubscriptionRequest) error {
+	if !r.serviceExists(post.ServiceID) {
+		return port.ErrServiceNotFound
+	}
+
+	// The user and the creation time are not updated.
+	row.sub.Title = post.Title
+	row.sub.ServiceID = post.ServiceID
+	row.sub.Price = post.Price
+	row.sub.StartDate = post.StartDate
+	row.sub.EndDate = post.EndDate
+	row.sub.Phases = post.Phases
+	row.sub.Renewal = post.Renewal
+	row.sub.UpdatedAt = post.UpdatedAt
+	normalize(&row.sub)
+
+	if r.conflicts(tenantID, row.sub) {
+		return port.ErrSubscriptionAlreadyExists
+	}
+
+	r.rows[post.ID] = row
+
+	return nil
+}
+
+// serviceExists reports whether a subscription may refer to the service of id, which
+// like the foreign key in Postgres is any service in the catalog.
+func (r *Subscription) serviceExists(id *string) bool {
+	if id == nil {
+		return true
+	}
+	_, ok := r.services[*id]
+
+	return ok
+}
+
+func (r *Subscription) UpdatePauses(
+	ctx context.Context,
+	id string,
+	updatedAt int64,
+	modify func(entity.Subscription) ([]entity.Pause, error),
+) (*entity.Subscription, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row, ok := r.rows[id]
+	if !ok || row.tenantID != tenantID {
+		return nil, port.ErrNotFound
+	}
+
+	pauses, err := modify(*clone(row.sub))
+	if err != nil {
+		return nil, err
+	}
+
+	row.sub.Pauses = pauses
+	row.sub.UpdatedAt = updatedAt
+	normalize(&row.sub)
+
+	r.rows[id] = row
+
+	return clone(row.sub), nil
+}
+
+func (r *Subscription) Cancel(
+	ctx context.Context,
+	id string,
+	cancel func(entity.Subscription) (entity.Subscription, error),
+) (*entity.Subscription, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row, ok := r.rows[id]
+	if !ok || row.tenantID != tenantID {
+		return nil, port.ErrNotFound
+	}
+
+	sub, err := cancel(*clone(row.sub))
+	if err != nil {
+		return nil, err
+	}
+	if sub.Cancellation == nil {
+		return nil, errors.New("cancel returned no cancellation")
+	}
+
+	// Only the columns the Postgres adapter writes change.
+	row.sub.EndDate = sub.EndDate
+	row.sub.Phases = sub.Phases
+	row.sub.Pauses = sub.Pauses
+	row.sub.Cancellation = sub.Cancellation
+	row.sub.UpdatedAt = sub.UpdatedAt
+	normalize(&row.sub)
+
+	r.rows[id] = row
+
+	return clone(row.sub), nil
+}
+
+// CountCancellations groups the cancelled subscriptions like the GROUP BY of the
+// Postgres adapter.
+func (r *Subscription) CountCancellations(
+	ctx context.Context,
+	filter entity.CancellationFilter,
+) ([]entity.CancellationCount, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	type group struct {
+		month         time.Time
+		title, reason string
+	}
+	counts := map[group]int64{}
+
+	for _, row := range r.matching(tenantID, entity.ListSubscriptionFilter{
+		Title: filter.Title, ServiceID: filter.ServiceID, UserID: filter.UserID,
+	}) {
+		c := row.sub.Cancellation
+		switch {
+		case c == nil:
+		case filter.From != nil && c.EffectiveDate.Before(toDate(*filter.From)):
+		case filter.To != nil && c.EffectiveDate.After(toDate(*filter.To)):
+		default:
+			counts[group{c.EffectiveDate, row.sub.Title, c.Reason}]++
+		}
+	}
+
+	var result []entity.CancellationCount
+	for g, n := range counts {
+		result = append(result, entity.CancellationCount{Month: g.month, Title: g.title, Reason: g.reason, Count: n})
+	}
+
+	slices.SortFunc(result, func(a, b entity.CancellationCount) int {
+		return cmp.Or(a.Month.Compare(b.Month), cmp.Compare(a.Title, b.Title), cmp.Compare(a.Reason, b.Reason))
+	})
+
+	return result, nil
+}
+
+func (r *Subscription) Delete(ctx context.Context, id string) error {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	row, ok := r.rows[id]
+	if !ok || row.tenantID != tenantID {
+		return port.ErrNotFound
+	}
+
+	delete(r.rows, id)
+
+	return nil
+}
+
+func (r *Subscription) List(ctx context.Context, filter entity.ListSubscriptionFilter) ([]entity.Subscription, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rows := r.matching(tenantID, filter)
+	if filter.Price != nil {
+		rows = slices.DeleteFunc(rows, func(row subscriptionRow) bool {
+			return row.sub.Price != *filter.Price
+		})
+	}
+
+	slices.SortFunc(rows, func(a, b subscriptionRow) int {
+		return cmp.Compare(a.seq, b.seq)
+	})
+
+	if filter.Offset != nil {
+		rows = rows[min(max(*filter.Offset, 0), len(rows)):]
+	}
+	if filter.Limit != nil {
+		rows = rows[:min(max(*filter.Limit, 0), len(rows))]
+	}
+
+	var subs []entity.Subscription
+	for _, row := range rows {
+		subs = append(subs, *clone(row.sub))
+	}
+
+	return subs, nil
+}
+
+// Sum ignores the price, limit and offset of the filter, as the Postgres adapter does,
+// and adds up the billed months within its dates, see entity.Subscription.Cost.
+func (r *Subscription) Sum(ctx context.Context, filter entity.ListSubscriptionFilter) (int64, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return 0, port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	byOwner := entity.ListSubscriptionFilter{Title: filter.Title, ServiceID: filter.ServiceID, UserID: filter.UserID}
+
+	var sum int64
+	for _, row := range r.matching(tenantID, byOwner) {
+		sum += row.sub.Cost(filter.StartDate, filter.EndDate)
+	}
+
+	return sum, nil
+}
+
+func (r *Subscription) ListActive(ctx context.Context, filter entity.PeriodFilter) ([]entity.Subscription, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, port.ErrTenantRequired
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.listActive(tenantID, filter), nil
+}
+
+// listActive returns the subscriptions of the tenant active in the period. The caller
+// holds the lock.
+func (r *Subscription) listActive(tenantID string, filter entity.PeriodFilter) []entity.Subscription {
+	var subs []entity.Subscription
+
+	from, to := toDate(filter.From), toDate(filter.To)
+	byOwner := entity.ListSubscriptionFilter{Title: filter.Title, ServiceID: filter.ServiceID, UserID: filter.UserID}
+	for _, row := range r.matching(tenantID, byOwner) {
+		start, end := period(row.sub)
+		if !start.After(to) && !end.Before(from) {
+			subs = append(subs, *clone(row.sub))
+		}
+	}
+
+	slices.SortFunc(subs, func(a, b entity.Subscription) int {
+		return cmp.Or(a.StartDate.Compare(b.StartDate), cmp.Compare(a.ID, b.ID))
+	})
+
+	return subs
+}
+
+// checkReader reads the store for the check of a write while the caller holds the lock.
+type checkReader struct {
+	subs     *Subscription
+	tenantID string
+}
+
+func (c checkReader) ListBudgets(_ context.Context, userID string) ([]entity.Budget, error) {
+	return c.subs.listBudgets(c.tenantID, userID), nil
+}
+
+func (c checkReader) ListActive(_ context.Context, filter entity.PeriodFilter) ([]entity.Subscription, error) {
+	return c.subs.listActive(c.tenantID, filter), nil
+}
+
+func (c checkReader) FindService(_ context.Context, name string) (*entity.Service, error) {
+	return c.subs.findService(c.tenantID, name)
+}
+
+// matching returns the rows of the tenant that pass the title, service, user and date
+// filters.
+// The caller holds the lock.
+func (r *Subscription) matching(tenantID string, filter entity.ListSubscriptionFilter) []subscriptionRow {
+	var rows []subscriptionRow
+
+	for _, row := range r.rows {
+		s := row.sub
+
+		switch {
+		case row.tenantID != tenantID:
+		case filter.Title != nil && s.Title != *filter.Title:
+		case filter.ServiceID != nil && (s.ServiceID == nil || *s.ServiceID != *filter.ServiceID):
+		case filter.UserID != nil && s.UserID != *filter.UserID:
+		case filter.StartDate != nil && s.StartDate.Before(toDate(*filter.StartDate)):
+		// A NULL end date never passes end_date <= $1.
+		case filter.EndDate != nil && (s.EndDate == nil || s.EndDate.After(toDate(*filter.EndDate))):
+		default:
+			rows = append(rows, row)
+		}
+	}
+
+	return rows
+}
+
+// conflicts reports whether the exclusion constraint of the table rejects sub for an
+// overlapping period of the same user and service. The caller holds the lock.
+func (r *Subscription) conflicts(tenantID string, sub entity.Subscription) bool {
+	for id, row := range r.rows {
+		if id != sub.ID && row.tenantID == tenantID && overlap(sub, row.sub) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// overlap reports whether two subscriptions of a user to one service, or without one to
+// one title, have a month in common, like the subscriptions_no_overlap constraint.
+func overlap(a, b entity.Subscription) bool {
+	if a.UserID != b.UserID || overlapKey(a) != overlapKey(b) {
+		return false
+	}
+
+	aStart, aEnd := period(a)
+	bStart, bEnd := period(b)
+
+	return !aStart.After(bEnd) && !bStart.After(aEnd)
+}
+
+// overlapKey is the service of the subscription, or its title as a service key.
+func overlapKey(s entity.Subscription) string {
+	if s.ServiceID != nil {
+		return *s.ServiceID
+	}
+
+	return entity.ServiceKey(s.Title)
+}
+
+// infinity stands for an open end date.
+var infinity = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+func period(s entity.Subscription) (time.Time, time.Time) {
+	if s.EndDate == nil {
+		return s.StartDate, infinity
+	}
+
+	return s.StartDate, *s.EndDate
+}
+
+// normalize truncates the dates of s like a DATE column does. Phases and pauses are
+// copied, so the caller's slices are not shared with the store.
+func normalize(s *entity.Subscription) {
+	s.StartDate = toDate(s.StartDate)
+	if s.EndDate != nil {
+		end := toDate(*s.EndDate)
+		s.EndDate = &end
+	}
+
+	phases := make([]entity.Phase, len(s.Phases))
+	for i, p := range s.Phases {
+		phases[i] = entity.Phase{StartDate: toDate(p.StartDate), EndDate: toDate(p.EndDate), Price: p.Price}
+	}
+	// The Postgres adapter reads an empty list back as nil.
+	s.Phases = nil
+	if len(phases) > 0 {
+		s.Phases = phases
+	}
+
+	var pauses []entity.Pause
+	for _, p := range s.Pauses {
+		pause := entity.Pause{StartDate: toDate(p.StartDate)}
+		if p.EndDate != nil {
+			end := toDate(*p.EndDate)
+			pause.EndDate = &end
+		}
+		pauses = append(pauses, pause)
+	}
+	s.Pauses = pauses
+
+	if s.Cancellation != nil {
+		c := *s.Cancellation
+		c.EffectiveDate = toDate(c.EffectiveDate)
+		s.Cancellation = &c
+	}
+
+	if s.Renewal != nil {
+		r := *s.Renewal
+		s.Renewal = &r
+	}
+
+	if s.ServiceID != nil {
+		serviceID := *s.ServiceID
+		s.ServiceID = &serviceID
+	}
+}
+
+func toDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func clone(s entity.Subscription) *entity.Subscription {
+	if s.ServiceID != nil {
+		serviceID := *s.ServiceID
+		s.ServiceID = &serviceID
+	}
+	if s.EndDate != nil {
+		end := *s.EndDate
+		s.EndDate = &end
+	}
+	s.Phases = slices.Clone(s.Phases)
+
+	if s.Pauses != nil {
+		pauses := make([]entity.Pause, len(s.Pauses))
+		for i, p := range s.Pauses {
+			pauses[i] = entity.Pause{StartDate: p.StartDate}
+			if p.EndDate != nil {
+				end := *p.EndDate
+				pauses[i].EndDate = &end
+			}
+		}
+		s.Pauses = pauses
+	}
+
+	if s.Cancellation != nil {
+		c := *s.Cancellation
+		s.Cancellation = &c
+	}
+
+	if s.Renewal != nil {
+		r := *s.Renewal
+		s.Renewal = &r
+	}
+
+	return &s
+}
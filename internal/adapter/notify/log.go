@@ -0,0 +1,37 @@
+package notify
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/pkg/logctx"
+	"subscription-service/internal/port"
+)
+
+var _ port.Notifier = (*Log)(nil)
+
+// Log writes reminders to the log instead of delivering them, for development and
+// deployments that pick reminders up from the logs.
+type Log struct {
+	logger *zap.Logger
+}
+
+func NewLog(logger *zap.Logger) *Log {
+	return &Log{logger: logger}
+}
+
+func (n *Log) Notify(ctx context.Context, r entity.Reminder) error {
+	subject, _ := message(r)
+
+	logctx.Logger(ctx, n.logger).Info("reminder",
+		zap.String("tenant_id", r.TenantID),
+		zap.String("subscription_id", r.SubscriptionID),
+		zap.String("user_id", r.UserID),
+		zap.String("kind", string(r.Kind)),
+		zap.String("date", r.Date.Format(monthLayout)),
+		zap.String("subject", subject))
+
+	return nil
+}
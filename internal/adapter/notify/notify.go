@@ -0,0 +1,31 @@
+// Package notify implements port.Notifier: reminders by email, to a webhook or to the
+// log.
+package notify
+
+import (
+	"fmt"
+
+	"subscription-service/internal/app/entity"
+)
+
+const monthLayout = "01-2006"
+
+// message returns the subject and the plain text of a reminder.
+func message(r entity.Reminder) (string, string) {
+	month := r.Date.Format(monthLayout)
+
+	switch r.Kind {
+	case entity.ReminderRenewal:
+		return fmt.Sprintf("%s renews in %s", r.ServiceName, month),
+			fmt.Sprintf("Your %s subscription renews automatically in %s at %d RUB a month.\n", r.ServiceName, month, r.Price)
+	case entity.ReminderEnd:
+		return fmt.Sprintf("%s ends", r.ServiceName),
+			fmt.Sprintf("Your %s subscription ends: %s is the first month it is no longer active.\n", r.ServiceName, month)
+	case entity.ReminderPriceChange:
+		return fmt.Sprintf("%s price changes in %s", r.ServiceName, month),
+			fmt.Sprintf("From %s your %s subscription costs %d RUB a month.\n", month, r.ServiceName, r.Price)
+	default:
+		return fmt.Sprintf("%s: %s", r.ServiceName, r.Kind),
+			fmt.Sprintf("Your %s subscription changes in %s.\n", r.ServiceName, month)
+	}
+}
@@ -0,0 +1,212 @@
+package notify_test
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"subscription-service/internal/adapter/notify"
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/config"
+	"subscription-service/internal/port"
+)
+
+var reminder = entity.Reminder{
+	TenantID:       "6b3f1c1e-4a53-4c59-a1f4-0f0e5d2b8a11",
+	SubscriptionID: "2d0d1b8e-3c1f-4f0e-9b7a-5a2f6e1c9d40",
+	UserID:         "60601fee-2bf1-4721-ae6f-7636e79a0cba",
+	Email:          "user@example.com",
+	ServiceName:    "Yandex Plus",
+	Kind:           entity.ReminderRenewal,
+	Date:           time.Date(2026, time.May, 1, 0, 0, 0, 0, time.UTC),
+	Price:          400,
+}
+
+// smtpSession is what the SMTP stand-in received in one session.
+type smtpSession struct {
+	auth, from, to, data string
+}
+
+// serveSMTP accepts one session on a local port, speaking just enough SMTP for
+// net/smtp, and returns the address and the session once it is over.
+func serveSMTP(t *testing.T) (string, <-chan smtpSession) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	sessions := make(chan smtpSession, 1)
+
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var s smtpSession
+		r, w := bufio.NewReader(conn), bufio.NewWriter(conn)
+		reply := func(line string) {
+			w.WriteString(line + "\r\n")
+			w.Flush()
+		}
+
+		reply("220 localhost ESMTP stand-in")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimRight(line, "\r\n")
+
+			switch cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0]); cmd {
+			case "EHLO":
+				reply("250-localhost")
+				reply("250 AUTH PLAIN")
+			case "AUTH":
+				s.auth = line
+				reply("235 2.7.0 Authentication successful")
+			case "MAIL":
+				s.from = line
+				reply("250 OK")
+			case "RCPT":
+				s.to = line
+				reply("250 OK")
+			case "DATA":
+				reply("354 End data with <CR><LF>.<CR><LF>")
+				var data strings.Builder
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if l == ".\r\n" {
+						break
+					}
+					data.WriteString(l)
+				}
+				s.data = data.String()
+				reply("250 OK")
+			case "QUIT":
+				reply("221 Bye")
+				sessions <- s
+				return
+			default:
+				reply("502 Command not implemented")
+			}
+		}
+	}()
+
+	return ln.Addr().String(), sessions
+}
+
+func TestSMTP(t *testing.T) {
+	addr, sessions := serveSMTP(t)
+
+	n, err := notify.NewSMTP(config.SMTPConfig{
+		Address:  addr,
+		From:     "Subscriptions <noreply@example.com>",
+		Username: "mailer",
+		Password: "secret",
+		Timeout:  5 * time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := n.Notify(context.Background(), reminder); err != nil {
+		t.Fatalf("notify: %v", err)
+	}
+
+	s := <-sessions
+
+	if want := "AUTH PLAIN " + base64.StdEncoding.EncodeToString([]byte("\x00mailer\x00secret")); s.auth != want {
+		t.Errorf("auth = %q, want %q", s.auth, want)
+	}
+	if s.from != "MAIL FROM:<noreply@example.com>" || !strings.HasPrefix(s.to, "RCPT TO:<user@example.com>") {
+		t.Errorf("envelope from %q to %q", s.from, s.to)
+	}
+	for _, want := range []string{
+		"To: <user@example.com>\r\n",
+		"Subject: Yandex Plus renews in 05-2026\r\n",
+		"Content-Type: text/plain; charset=utf-8\r\n",
+		"renews automatically in 05-2026 at 400 RUB a month",
+	} {
+		if !strings.Contains(s.data, want) {
+			t.Errorf("message lacks %q:\n%s", want, s.data)
+		}
+	}
+}
+
+func TestSMTPWithoutEmail(t *testing.T) {
+	n, err := notify.NewSMTP(config.SMTPConfig{Address: "127.0.0.1:1", From: "noreply@example.com", Timeout: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := reminder
+	r.Email = ""
+	if err := n.Notify(context.Background(), r); !errors.Is(err, port.ErrNoRecipient) {
+		t.Errorf("expected %v, got %v", port.ErrNoRecipient, err)
+	}
+}
+
+func TestWebhook(t *testing.T) {
+	var (
+		body      []byte
+		signature string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		signature = r.Header.Get("X-Signature")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	n := notify.NewWebhook(config.WebhookConfig{URL: srv.URL, Secret: "hook-secret", Timeout: 5 * time.Second})
+	if err := n.Notify(context.Background(), reminder); err != nil {
+		t.Fatalf("notify: %v", err)
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatalf("payload %s: %v", body, err)
+	}
+	if payload["kind"] != "renewal" || payload["date"] != "05-2026" || payload["price"] != 400.0 ||
+		payload["subscription_id"] != reminder.SubscriptionID || payload["tenant_id"] != reminder.TenantID {
+		t.Errorf("unexpected payload %s", body)
+	}
+
+	mac := hmac.New(sha256.New, []byte("hook-secret"))
+	mac.Write(body)
+	if want := "sha256=" + hex.EncodeToString(mac.Sum(nil)); signature != want {
+		t.Errorf("signature = %q, want %q", signature, want)
+	}
+}
+
+func TestWebhookFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	n := notify.NewWebhook(config.WebhookConfig{URL: srv.URL, Timeout: 5 * time.Second})
+	if err := n.Notify(context.Background(), reminder); err == nil || !strings.Contains(err.Error(), "502") {
+		t.Errorf("expected the status in the error, got %v", err)
+	}
+}
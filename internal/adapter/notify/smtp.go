@@ -0,0 +1,128 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/config"
+	"subscription-service/internal/port"
+)
+
+var _ port.Notifier = (*SMTP)(nil)
+
+// SMTP emails reminders to the address in the preferences of the user. It upgrades the
+// connection with STARTTLS when the server offers it and authenticates with PLAIN when a
+// username is configured.
+type SMTP struct {
+	cfg  config.SMTPConfig
+	host string
+	from mail.Address
+}
+
+func NewSMTP(cfg config.SMTPConfig) (*SMTP, error) {
+	host, _, err := net.SplitHostPort(cfg.Address)
+	if err != nil {
+		return nil, fmt.Errorf("smtp address: %w", err)
+	}
+
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("smtp from: %w", err)
+	}
+
+	return &SMTP{cfg: cfg, host: host, from: *from}, nil
+}
+
+func (n *SMTP) Notify(ctx context.Context, r entity.Reminder) error {
+	if r.Email == "" {
+		return fmt.Errorf("%w: user %s has no email address", port.ErrNoRecipient, r.UserID)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, n.cfg.Timeout)
+	defer cancel()
+
+	conn, err := new(net.Dialer).DialContext(ctx, "tcp", n.cfg.Address)
+	if err != nil {
+		return err
+	}
+
+	deadline, _ := ctx.Deadline()
+	if err = conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return err
+	}
+
+	c, err := smtp.NewClient(conn, n.host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if err = n.send(c, r); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
+
+func (n *SMTP) send(c *smtp.Client, r entity.Reminder) error {
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: n.host, MinVersion: tls.VersionTLS12}); err != nil {
+			return err
+		}
+	}
+
+	if n.cfg.Username != "" {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("smtp: server doesn't support AUTH")
+		}
+		if err := c.Auth(smtp.PlainAuth("", n.cfg.Username, n.cfg.Password, n.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(n.from.Address); err != nil {
+		return err
+	}
+	if err := c.Rcpt(r.Email); err != nil {
+		return err
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(n.compose(r)); err != nil {
+		return err
+	}
+
+	return w.Close()
+}
+
+// compose returns the email of a reminder as a plain text UTF-8 message.
+func (n *SMTP) compose(r entity.Reminder) []byte {
+	subject, body := message(r)
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", n.from.String())
+	fmt.Fprintf(&msg, "To: %s\r\n", (&mail.Address{Address: r.Email}).String())
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	msg.WriteString("\r\n")
+	msg.Write(bytes.ReplaceAll([]byte(body), []byte("\n"), []byte("\r\n")))
+
+	return msg.Bytes()
+}
@@ -0,0 +1,96 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/config"
+	"subscription-service/internal/port"
+)
+
+var _ port.Notifier = (*Webhook)(nil)
+
+// signatureHeader carries "sha256=" and the hex HMAC-SHA256 of the body when a secret
+// is configured.
+const signatureHeader = "X-Signature"
+
+// Webhook posts reminders as JSON to a URL. Any response but 2xx fails the reminder,
+// so it is sent again by a later run.
+type Webhook struct {
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+func NewWebhook(cfg config.WebhookConfig) *Webhook {
+	return &Webhook{url: cfg.URL, secret: []byte(cfg.Secret), client: &http.Client{Timeout: cfg.Timeout}}
+}
+
+// webhookPayload is the body of a webhook request.
+type webhookPayload struct {
+	TenantID       string `json:"tenant_id"`
+	SubscriptionID string `json:"subscription_id"`
+	UserID         string `json:"user_id"`
+	Email          string `json:"email,omitempty"`
+	ServiceName    string `json:"service_name"`
+	Kind           string `json:"kind"`
+	Date           string `json:"date"`
+	Price          int64  `json:"price"`
+	Subject        string `json:"subject"`
+	Text           string `json:"text"`
+}
+
+func (n *Webhook) Notify(ctx context.Context, r entity.Reminder) error {
+	subject, text := message(r)
+
+	body, err := json.Marshal(webhookPayload{
+		TenantID:       r.TenantID,
+		SubscriptionID: r.SubscriptionID,
+		UserID:         r.UserID,
+		Email:          r.Email,
+		ServiceName:    r.ServiceName,
+		Kind:           string(r.Kind),
+		Date:           r.Date.Format(monthLayout),
+		Price:          r.Price,
+		Subject:        subject,
+		Text:           text,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if len(n.secret) > 0 {
+		mac := hmac.New(sha256.New, n.secret)
+		mac.Write(body)
+		req.Header.Set(signatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Drained so that the connection is reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,7 @@
+package ratelimit
+
+import "time"
+
+func (m *Memory) SetClock(now func() time.Time) {
+	m.now = now
+}
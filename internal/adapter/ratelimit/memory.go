@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+const sweepEvery = 1024
+
+var _ port.RateLimiter = (*Memory)(nil)
+
+// Memory keeps token buckets in process memory. Limits are per replica.
+type Memory struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	calls   int
+	now     func() time.Time
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	limit   entity.RateLimit
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+func (m *Memory) Allow(_ context.Context, key string, limit entity.RateLimit) (entity.RateLimitDecision, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+
+	m.calls++
+	if m.calls%sweepEvery == 0 {
+		m.sweep(now)
+	}
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updated: now}
+		m.buckets[key] = b
+	}
+	b.limit = limit
+
+	b.tokens = refill(b.tokens, now.Sub(b.updated), limit)
+	b.updated = now
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+
+	return entity.NewRateLimitDecision(limit, allowed, b.tokens), nil
+}
+
+// sweep drops buckets that have been idle long enough to be full again: a missing
+// bucket behaves exactly like a full one.
+func (m *Memory) sweep(now time.Time) {
+	for key, b := range m.buckets {
+		if refill(b.tokens, now.Sub(b.updated), b.limit) >= float64(b.limit.Burst) {
+			delete(m.buckets, key)
+		}
+	}
+}
+
+func refill(tokens float64, elapsed time.Duration, limit entity.RateLimit) float64 {
+	if elapsed > 0 {
+		tokens += elapsed.Seconds() * limit.Rate()
+	}
+
+	return min(tokens, float64(limit.Burst))
+}
@@ -0,0 +1,56 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"subscription-service/internal/adapter/ratelimit"
+	"subscription-service/internal/app/entity"
+)
+
+func TestMemoryTokenBucket(t *testing.T) {
+	now := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+
+	limiter := ratelimit.NewMemory()
+	limiter.SetClock(func() time.Time { return now })
+
+	limit := entity.RateLimit{Burst: 2, Period: 10 * time.Second}
+	ctx := context.Background()
+
+	steps := []struct {
+		advance    time.Duration
+		allowed    bool
+		remaining  int
+		retryAfter time.Duration
+	}{
+		{0, true, 1, 0},
+		{0, true, 0, 5 * time.Second},
+		{0, false, 0, 5 * time.Second},
+		{2 * time.Second, false, 0, 3 * time.Second},
+		{3 * time.Second, true, 0, 5 * time.Second},
+		{time.Minute, true, 1, 0},
+	}
+
+	for i, step := range steps {
+		now = now.Add(step.advance)
+
+		d, err := limiter.Allow(ctx, "key", limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if d.Allowed != step.allowed || d.Remaining != step.remaining || d.RetryAfter != step.retryAfter {
+			t.Errorf("step %d: expected allowed=%v remaining=%d retry=%s, got %+v",
+				i, step.allowed, step.remaining, step.retryAfter, d)
+		}
+	}
+
+	d, err := limiter.Allow(ctx, "another-key", limit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d.Allowed || d.Remaining != 1 {
+		t.Errorf("expected buckets to be independent, got %+v", d)
+	}
+}
@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/pkg/logctx"
+	"subscription-service/internal/port"
+)
+
+const (
+	cleanupEvery   = 4096
+	cleanupAge     = 24 * time.Hour
+	cleanupTimeout = 5 * time.Second
+)
+
+var _ port.RateLimiter = (*Postgres)(nil)
+
+// Postgres keeps token buckets in the rate_limit_buckets table, so limits hold across
+// replicas. Every decision is a single upsert that refills and takes a token atomically.
+type Postgres struct {
+	pool   *pgxpool.Pool
+	logger *zap.Logger
+	calls  atomic.Uint64
+}
+
+func NewPostgres(pool *pgxpool.Pool, logger *zap.Logger) (*Postgres, error) {
+	return &Postgres{pool: pool, logger: logger}, nil
+}
+
+// The clock of the database is used so that replicas with skewed clocks share one
+// timeline; EXCLUDED.updated_at is that "now". The refilled amount is computed inline
+// because ON CONFLICT DO UPDATE can't reference values computed in the same SET list.
+const allowQuery = `
+INSERT INTO rate_limit_buckets AS b (key, tokens, allowed, updated_at)
+VALUES ($1, $2::float8 - 1, true, (extract(epoch FROM clock_timestamp()) * 1000)::bigint)
+ON CONFLICT (key) DO UPDATE SET
+    tokens = CASE
+        WHEN LEAST($2::float8, b.tokens + GREATEST(EXCLUDED.updated_at - b.updated_at, 0) * $3::float8) >= 1
+            THEN LEAST($2::float8, b.tokens + GREATEST(EXCLUDED.updated_at - b.updated_at, 0) * $3::float8) - 1
+        ELSE LEAST($2::float8, b.tokens + GREATEST(EXCLUDED.updated_at - b.updated_at, 0) * $3::float8)
+    END,
+    allowed = LEAST($2::float8, b.tokens + GREATEST(EXCLUDED.updated_at - b.updated_at, 0) * $3::float8) >= 1,
+    updated_at = GREATEST(EXCLUDED.updated_at, b.updated_at)
+RETURNING tokens, allowed
+`
+
+func (r *Postgres) Allow(ctx context.Context, key string, limit entity.RateLimit) (entity.RateLimitDecision, error) {
+	ratePerMilli := limit.Rate() / float64(time.Second/time.Millisecond)
+
+	var (
+		tokens  float64
+		allowed bool
+	)
+
+	err := r.pool.QueryRow(ctx, allowQuery, key, float64(limit.Burst), ratePerMilli).Scan(&tokens, &allowed)
+	if err != nil {
+		logctx.Logger(ctx, r.logger).Debug("take rate limit token", zap.Error(err))
+		return entity.RateLimitDecision{}, err
+	}
+
+	if r.calls.Add(1)%cleanupEvery == 0 {
+		go r.cleanup()
+	}
+
+	return entity.NewRateLimitDecision(limit, allowed, tokens), nil
+}
+
+// cleanup removes buckets that were not touched for a day. Limits refill within minutes,
+// so such a bucket is full, and a missing bucket is created full as well.
+func (r *Postgres) cleanup() {
+	ctx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+	defer cancel()
+
+	_, err := r.pool.Exec(ctx,
+		"DELETE FROM rate_limit_buckets"+
+			" WHERE updated_at < (extract(epoch FROM clock_timestamp()) * 1000)::bigint - $1",
+		cleanupAge.Milliseconds(),
+	)
+	if err != nil {
+		r.logger.Warn("clean up rate limit buckets", zap.Error(err))
+	}
+}
@@ -0,0 +1,133 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+var _ port.AnalyticsRepo = (*Analytics)(nil)
+
+// Analytics computes the metrics of the subscriptions table in SQL.
+type Analytics struct {
+	pool   *pgxpool.Pool
+	logger *zap.Logger
+}
+
+func NewAnalytics(pool *pgxpool.Pool, logger *zap.Logger) (*Analytics, error) {
+	return &Analytics{pool: pool, logger: logger}, nil
+}
+
+func (r *Analytics) MonthlyMetrics(ctx context.Context, filter entity.MetricsFilter) ([]entity.MonthlyMetrics, error) {
+	var metrics []entity.MonthlyMetrics
+
+	err := inTenant(ctx, r.pool, r.logger, func(tx pgx.Tx, tenantID string) error {
+		queryString, args := metricsQuery(tenantID, filter)
+
+		res, err := tx.Query(ctx, queryString, args...)
+		if err != nil {
+			return err
+		}
+
+		defer res.Close()
+
+		for res.Next() {
+			var m entity.MonthlyMetrics
+			if err := res.Scan(
+				&m.Month, &m.MRR, &m.NewMRR, &m.ChurnedMRR, &m.ExpansionMRR, &m.ContractionMRR,
+				&m.Subscribers, &m.PreviousSubscribers, &m.NewSubscribers, &m.ChurnedSubscribers,
+			); err != nil {
+				return err
+			}
+			metrics = append(metrics, m)
+		}
+
+		return res.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// metricsBilled selects the user, month and price of every month a subscription is
+// billed in, as entity.Subscription.PriceAt has it: within its dates, not paused, at the
+// price of the phase covering the month or else the regular one. The months start a month
+// before the first one reported so that the first one has a month to compare with.
+const metricsBilled = `WITH months AS (
+    SELECT month::date FROM generate_series($2::date, $3::date, interval '1 month') AS month
+),
+billed AS (
+    SELECT s.user_id, m.month, COALESCE((
+        SELECT (p->>'price')::bigint FROM jsonb_array_elements(s.phases) AS p
+        WHERE (p->>'start_date')::date <= m.month AND (p->>'end_date')::date >= m.month
+        LIMIT 1
+    ), s.price) AS price
+    FROM subscriptions AS s
+    JOIN months AS m ON s.start_date <= m.month AND (s.end_date IS NULL OR s.end_date >= m.month)
+    WHERE s.tenant_id = $1 AND NOT EXISTS (
+        SELECT 1 FROM jsonb_array_elements(s.pauses) AS pause
+        WHERE (pause->>'start_date')::date <= m.month
+            AND (pause->>'end_date' IS NULL OR (pause->>'end_date')::date >= m.month)
+    )`
+
+// metricsChanges sums the MRR of every user and month and, over a grid of all users and
+// months, compares it with the month before with LAG.
+const metricsChanges = `
+),
+users AS (
+    SELECT user_id, month, SUM(price)::bigint AS mrr FROM billed GROUP BY user_id, month
+),
+grid AS (
+    SELECT u.user_id, m.month, x.mrr IS NOT NULL AS active, COALESCE(x.mrr, 0) AS mrr
+    FROM (SELECT DISTINCT user_id FROM users) AS u
+    CROSS JOIN months AS m
+    LEFT JOIN users AS x ON x.user_id = u.user_id AND x.month = m.month
+),
+changes AS (
+    SELECT month, active, mrr,
+        LAG(active, 1, false) OVER w AS was_active,
+        LAG(mrr, 1, 0::bigint) OVER w AS previous_mrr
+    FROM grid
+    WINDOW w AS (PARTITION BY user_id ORDER BY month)
+)
+SELECT m.month,
+    COALESCE(SUM(c.mrr), 0)::bigint,
+    COALESCE(SUM(c.mrr) FILTER (WHERE c.active AND NOT c.was_active), 0)::bigint,
+    COALESCE(SUM(c.previous_mrr) FILTER (WHERE c.was_active AND NOT c.active), 0)::bigint,
+    COALESCE(SUM(c.mrr - c.previous_mrr) FILTER (WHERE c.active AND c.was_active AND c.mrr > c.previous_mrr), 0)::bigint,
+    COALESCE(SUM(c.previous_mrr - c.mrr) FILTER (WHERE c.active AND c.was_active AND c.mrr < c.previous_mrr), 0)::bigint,
+    COUNT(c.month) FILTER (WHERE c.active),
+    COUNT(c.month) FILTER (WHERE c.was_active),
+    COUNT(c.month) FILTER (WHERE c.active AND NOT c.was_active),
+    COUNT(c.month) FILTER (WHERE c.was_active AND NOT c.active)
+FROM months AS m
+LEFT JOIN changes AS c ON c.month = m.month
+WHERE m.month >= $4::date
+GROUP BY m.month
+ORDER BY m.month`
+
+// metricsQuery builds the query of MonthlyMetrics.
+func metricsQuery(tenantID string, filter entity.MetricsFilter) (string, []any) {
+	from := entity.MonthStart(filter.From)
+	args := []any{tenantID, entity.AddMonths(from, -1), entity.MonthStart(filter.To), from}
+
+	query := metricsBilled
+	if filter.Title != nil {
+		args = append(args, *filter.Title)
+		query += fmt.Sprintf(" AND s.title = $%d", len(args))
+	}
+	if filter.ServiceID != nil {
+		args = append(args, *filter.ServiceID)
+		query += fmt.Sprintf(" AND s.service_id = $%d", len(args))
+	}
+
+	return query + metricsChanges, args
+}
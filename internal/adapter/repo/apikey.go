@@ -0,0 +1,134 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/pkg/logctx"
+	"subscription-service/internal/port"
+)
+
+var _ port.APIKeyRepo = (*APIKey)(nil)
+
+type APIKey struct {
+	pool   *pgxpool.Pool
+	logger *zap.Logger
+}
+
+func NewAPIKey(pool *pgxpool.Pool, logger *zap.Logger) (*APIKey, error) {
+	return &APIKey{pool: pool, logger: logger}, nil
+}
+
+func (r *APIKey) Create(ctx context.Context, key entity.APIKey, hash []byte) error {
+	_, err := r.pool.Exec(ctx,
+		"INSERT INTO api_keys (id, name, prefix, key_hash, user_id, tenant_id, role, created_at)"+
+			" VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		key.ID,
+		key.Name,
+		key.Prefix,
+		hash,
+		key.UserID,
+		key.TenantID,
+		string(key.Role),
+		key.CreatedAt,
+	)
+	if err != nil {
+		logctx.Logger(ctx, r.logger).Debug("insert api key", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *APIKey) GetByHash(ctx context.Context, hash []byte) (*entity.APIKey, error) {
+	var (
+		key  entity.APIKey
+		role string
+	)
+
+	err := r.pool.QueryRow(ctx, `
+    SELECT id, name, prefix, user_id, tenant_id, role, created_at, revoked_at
+    FROM api_keys
+    WHERE key_hash = $1
+`, hash).Scan(
+		&key.ID,
+		&key.Name,
+		&key.Prefix,
+		&key.UserID,
+		&key.TenantID,
+		&role,
+		&key.CreatedAt,
+		&key.RevokedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, port.ErrNotFound
+		}
+		return nil, err
+	}
+
+	key.Role = entity.Role(role)
+
+	return &key, nil
+}
+
+func (r *APIKey) List(ctx context.Context, tenantID *string) ([]entity.APIKey, error) {
+	rows, err := r.pool.Query(ctx, `
+    SELECT id, name, prefix, user_id, tenant_id, role, created_at, revoked_at
+    FROM api_keys
+    WHERE $1::uuid IS NULL OR tenant_id = $1
+    ORDER BY created_at
+`, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var keys []entity.APIKey
+
+	for rows.Next() {
+		var (
+			key  entity.APIKey
+			role string
+		)
+		if err := rows.Scan(
+			&key.ID, &key.Name, &key.Prefix, &key.UserID,
+			&key.TenantID, &role, &key.CreatedAt, &key.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		key.Role = entity.Role(role)
+		keys = append(keys, key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (r *APIKey) Revoke(ctx context.Context, id string, tenantID *string, revokedAt int64) error {
+	tag, err := r.pool.Exec(ctx,
+		"UPDATE api_keys SET revoked_at = $3"+
+			" WHERE id = $1 AND ($2::uuid IS NULL OR tenant_id = $2) AND revoked_at IS NULL",
+		id,
+		tenantID,
+		revokedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return port.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/pkg/logctx"
+	"subscription-service/internal/port"
+)
+
+var _ port.BudgetRepo = (*Subscription)(nil)
+
+const budgetColumns = "id, user_id, category, services, monthly_limit, strict, created_at, updated_at"
+
+// uniqueViolation is the SQLSTATE of a duplicate key.
+const uniqueViolation = "23505"
+
+func (r *Subscription) CreateBudget(ctx context.Context, budget entity.Budget) error {
+	err := r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		_, err := tx.Exec(ctx,
+			"INSERT INTO budgets (tenant_id, "+budgetColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+			tenantID, budget.ID, budget.UserID, budget.Category, services(budget.Services), budget.Limit,
+			budget.Strict, budget.CreatedAt, budget.UpdatedAt)
+		return err
+	})
+
+	return r.budgetError(ctx, "insert budget", err)
+}
+
+func (r *Subscription) GetBudget(ctx context.Context, id string) (*entity.Budget, error) {
+	var budget entity.Budget
+
+	err := r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		return scanBudget(tx.QueryRow(ctx,
+			"SELECT "+budgetColumns+" FROM budgets WHERE id = $1 AND tenant_id = $2", id, tenantID), &budget)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, port.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &budget, nil
+}
+
+func (r *Subscription) UpdateBudget(ctx context.Context, budget entity.Budget) error {
+	err := r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		tag, err := tx.Exec(ctx,
+			"UPDATE budgets SET category = $3, services = $4, monthly_limit = $5, strict = $6, updated_at = $7 "+
+				"WHERE id = $1 AND tenant_id = $2",
+			budget.ID, tenantID, budget.Category, services(budget.Services), budget.Limit, budget.Strict,
+			budget.UpdatedAt)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() == 0 {
+			return port.ErrNotFound
+		}
+
+		return nil
+	})
+
+	return r.budgetError(ctx, "update budget", err)
+}
+
+func (r *Subscription) DeleteBudget(ctx context.Context, id string) error {
+	return r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		tag, err := tx.Exec(ctx, "DELETE FROM budgets WHERE id = $1 AND tenant_id = $2", id, tenantID)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() == 0 {
+			return port.ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+func (r *Subscription) ListBudgets(ctx context.Context, userID string) ([]entity.Budget, error) {
+	var budgets []entity.Budget
+
+	err := r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		var err error
+		budgets, err = listBudgets(ctx, tx, tenantID, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return budgets, nil
+}
+
+func listBudgets(ctx context.Context, tx pgx.Tx, tenantID, userID string) ([]entity.Budget, error) {
+	res, err := tx.Query(ctx,
+		"SELECT "+budgetColumns+" FROM budgets WHERE tenant_id = $1 AND user_id = $2 ORDER BY category",
+		tenantID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Close()
+
+	var budgets []entity.Budget
+	for res.Next() {
+		var budget entity.Budget
+		if err := scanBudget(res, &budget); err != nil {
+			return nil, err
+		}
+		budgets = append(budgets, budget)
+	}
+
+	return budgets, res.Err()
+}
+
+// budgetError turns the duplicate key of a budget write into ErrBudgetAlreadyExists.
+func (r *Subscription) budgetError(ctx context.Context, op string, err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		logctx.Logger(ctx, r.logger).Debug(op, zap.Error(err))
+		return port.ErrBudgetAlreadyExists
+	}
+
+	return err
+}
+
+// services stores the services of a budget without a category as an empty array
+// rather than NULL.
+func services(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+
+	return s
+}
+
+func scanBudget(row pgx.Row, budget *entity.Budget) error {
+	if err := row.Scan(
+		&budget.ID, &budget.UserID, &budget.Category, &budget.Services, &budget.Limit, &budget.Strict,
+		&budget.CreatedAt, &budget.UpdatedAt,
+	); err != nil {
+		return err
+	}
+
+	if len(budget.Services) == 0 {
+		budget.Services = nil
+	}
+
+	return nil
+}
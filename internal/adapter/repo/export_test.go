@@ -0,0 +1,12 @@
+package repo
+
+var (
+	ListQuery   = listQuery
+	SumQuery    = sumQuery
+	ActiveQuery = activeQuery
+
+	CancellationsQuery = cancellationsQuery
+	MetricsQuery       = metricsQuery
+
+	SubscriptionError = (*Subscription).subscriptionError
+)
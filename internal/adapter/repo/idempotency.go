@@ -0,0 +1,163 @@
+package repo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/pkg/logctx"
+	"subscription-service/internal/port"
+)
+
+const (
+	idempotencyCleanupEvery   = 1024
+	idempotencyCleanupTimeout = 5 * time.Second
+)
+
+var _ port.IdempotencyStore = (*Idempotency)(nil)
+
+// Idempotency stores responses to requests with an Idempotency-Key header.
+type Idempotency struct {
+	pool   *pgxpool.Pool
+	logger *zap.Logger
+	calls  atomic.Uint64
+}
+
+func NewIdempotency(pool *pgxpool.Pool, logger *zap.Logger) (*Idempotency, error) {
+	return &Idempotency{pool: pool, logger: logger}, nil
+}
+
+// Acquire locks the row of the key with SELECT FOR UPDATE, so concurrent duplicates
+// are serialized: the first one takes the lease, the rest see it in progress.
+func (r *Idempotency) Acquire(
+	ctx context.Context,
+	scope, key, token string,
+	fingerprint []byte,
+	lease time.Duration,
+) (*entity.IdempotentResponse, error) {
+	now := time.Now().UnixMilli()
+
+	if r.calls.Add(1)%idempotencyCleanupEvery == 0 {
+		go r.cleanup(now)
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if rErr := tx.Rollback(ctx); rErr != nil && !errors.Is(rErr, pgx.ErrTxClosed) {
+			logctx.Logger(ctx, r.logger).Error("rollback idempotency transaction", zap.Error(rErr))
+		}
+	}()
+
+	tag, err := tx.Exec(ctx,
+		"INSERT INTO idempotency_keys (scope, key, fingerprint, lease_token, created_at, expires_at)"+
+			" VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT DO NOTHING",
+		scope, key, fingerprint, token, now, now+lease.Milliseconds(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if tag.RowsAffected() == 1 {
+		return nil, tx.Commit(ctx)
+	}
+
+	var (
+		stored      []byte
+		statusCode  *int
+		contentType *string
+		body        []byte
+		expiresAt   int64
+	)
+
+	err = tx.QueryRow(ctx, `
+    SELECT fingerprint, status_code, content_type, body, expires_at
+    FROM idempotency_keys
+    WHERE scope = $1 AND key = $2
+    FOR UPDATE
+`, scope, key).Scan(&stored, &statusCode, &contentType, &body, &expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// An expired response or an abandoned lease: start over as if the key were new.
+	if expiresAt <= now {
+		_, err = tx.Exec(ctx, `
+    UPDATE idempotency_keys
+    SET fingerprint = $3, lease_token = $4, status_code = NULL, content_type = NULL, body = NULL,
+        created_at = $5, expires_at = $6
+    WHERE scope = $1 AND key = $2
+`, scope, key, fingerprint, token, now, now+lease.Milliseconds())
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, tx.Commit(ctx)
+	}
+
+	if !bytes.Equal(stored, fingerprint) {
+		return nil, port.ErrIdempotencyKeyReused
+	}
+
+	if statusCode == nil {
+		return nil, port.ErrIdempotencyInProgress
+	}
+
+	resp := &entity.IdempotentResponse{StatusCode: *statusCode, Body: body}
+	if contentType != nil {
+		resp.ContentType = *contentType
+	}
+
+	return resp, nil
+}
+
+func (r *Idempotency) Complete(
+	ctx context.Context,
+	scope, key, token string,
+	resp entity.IdempotentResponse,
+	ttl time.Duration,
+) error {
+	tag, err := r.pool.Exec(ctx, `
+    UPDATE idempotency_keys
+    SET status_code = $4, content_type = $5, body = $6, expires_at = $7
+    WHERE scope = $1 AND key = $2 AND lease_token = $3 AND status_code IS NULL
+`, scope, key, token, resp.StatusCode, resp.ContentType, resp.Body, time.Now().Add(ttl).UnixMilli())
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return port.ErrIdempotencyLeaseLost
+	}
+
+	return nil
+}
+
+func (r *Idempotency) Release(ctx context.Context, scope, key, token string) error {
+	_, err := r.pool.Exec(ctx,
+		"DELETE FROM idempotency_keys WHERE scope = $1 AND key = $2 AND lease_token = $3 AND status_code IS NULL",
+		scope, key, token,
+	)
+
+	return err
+}
+
+// cleanup removes expired responses and abandoned leases.
+func (r *Idempotency) cleanup(now int64) {
+	ctx, cancel := context.WithTimeout(context.Background(), idempotencyCleanupTimeout)
+	defer cancel()
+
+	if _, err := r.pool.Exec(ctx, "DELETE FROM idempotency_keys WHERE expires_at < $1", now); err != nil {
+		r.logger.Warn("clean up idempotency keys", zap.Error(err))
+	}
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./analytics.go
+
+// Package repo is a generated GoMock package.
+package repo
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "subscription-service/internal/app/entity"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAnalyticsRepo is a mock of AnalyticsRepo interface.
+type MockAnalyticsRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnalyticsRepoMockRecorder
+}
+
+// MockAnalyticsRepoMockRecorder is the mock recorder for MockAnalyticsRepo.
+type MockAnalyticsRepoMockRecorder struct {
+	mock *MockAnalyticsRepo
+}
+
+// NewMockAnalyticsRepo creates a new mock instance.
+func NewMockAnalyticsRepo(ctrl *gomock.Controller) *MockAnalyticsRepo {
+	mock := &MockAnalyticsRepo{ctrl: ctrl}
+	mock.recorder = &MockAnalyticsRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnalyticsRepo) EXPECT() *MockAnalyticsRepoMockRecorder {
+	return m.recorder
+}
+
+// MonthlyMetrics mocks base method.
+func (m *MockAnalyticsRepo) MonthlyMetrics(ctx context.Context, filter entity.MetricsFilter) ([]entity.MonthlyMetrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MonthlyMetrics", ctx, filter)
+	ret0, _ := ret[0].([]entity.MonthlyMetrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MonthlyMetrics indicates an expected call of MonthlyMetrics.
+func (mr *MockAnalyticsRepoMockRecorder) MonthlyMetrics(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MonthlyMetrics", reflect.TypeOf((*MockAnalyticsRepo)(nil).MonthlyMetrics), ctx, filter)
+}
@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./budget.go
+
+// Package repo is a generated GoMock package.
+package repo
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "subscription-service/internal/app/entity"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBudgetRepo is a mock of BudgetRepo interface.
+type MockBudgetRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockBudgetRepoMockRecorder
+}
+
+// MockBudgetRepoMockRecorder is the mock recorder for MockBudgetRepo.
+type MockBudgetRepoMockRecorder struct {
+	mock *MockBudgetRepo
+}
+
+// NewMockBudgetRepo creates a new mock instance.
+func NewMockBudgetRepo(ctrl *gomock.Controller) *MockBudgetRepo {
+	mock := &MockBudgetRepo{ctrl: ctrl}
+	mock.recorder = &MockBudgetRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBudgetRepo) EXPECT() *MockBudgetRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateBudget mocks base method.
+func (m *MockBudgetRepo) CreateBudget(ctx context.Context, budget entity.Budget) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBudget", ctx, budget)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBudget indicates an expected call of CreateBudget.
+func (mr *MockBudgetRepoMockRecorder) CreateBudget(ctx, budget interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBudget", reflect.TypeOf((*MockBudgetRepo)(nil).CreateBudget), ctx, budget)
+}
+
+// DeleteBudget mocks base method.
+func (m *MockBudgetRepo) DeleteBudget(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBudget", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBudget indicates an expected call of DeleteBudget.
+func (mr *MockBudgetRepoMockRecorder) DeleteBudget(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudget", reflect.TypeOf((*MockBudgetRepo)(nil).DeleteBudget), ctx, id)
+}
+
+// GetBudget mocks base method.
+func (m *MockBudgetRepo) GetBudget(ctx context.Context, id string) (*entity.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBudget", ctx, id)
+	ret0, _ := ret[0].(*entity.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBudget indicates an expected call of GetBudget.
+func (mr *MockBudgetRepoMockRecorder) GetBudget(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudget", reflect.TypeOf((*MockBudgetRepo)(nil).GetBudget), ctx, id)
+}
+
+// ListBudgets mocks base method.
+func (m *MockBudgetRepo) ListBudgets(ctx context.Context, userID string) ([]entity.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBudgets", ctx, userID)
+	ret0, _ := ret[0].([]entity.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBudgets indicates an expected call of ListBudgets.
+func (mr *MockBudgetRepoMockRecorder) ListBudgets(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBudgets", reflect.TypeOf((*MockBudgetRepo)(nil).ListBudgets), ctx, userID)
+}
+
+// UpdateBudget mocks base method.
+func (m *MockBudgetRepo) UpdateBudget(ctx context.Context, budget entity.Budget) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBudget", ctx, budget)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBudget indicates an expected call of UpdateBudget.
+func (mr *MockBudgetRepoMockRecorder) UpdateBudget(ctx, budget interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBudget", reflect.TypeOf((*MockBudgetRepo)(nil).UpdateBudget), ctx, budget)
+}
@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./reminder.go
+
+// Package repo is a generated GoMock package.
+package repo
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "subscription-service/internal/app/entity"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReminderRepo is a mock of ReminderRepo interface.
+type MockReminderRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderRepoMockRecorder
+}
+
+// MockReminderRepoMockRecorder is the mock recorder for MockReminderRepo.
+type MockReminderRepoMockRecorder struct {
+	mock *MockReminderRepo
+}
+
+// NewMockReminderRepo creates a new mock instance.
+func NewMockReminderRepo(ctrl *gomock.Controller) *MockReminderRepo {
+	mock := &MockReminderRepo{ctrl: ctrl}
+	mock.recorder = &MockReminderRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderRepo) EXPECT() *MockReminderRepoMockRecorder {
+	return m.recorder
+}
+
+// GetReminderPreferences mocks base method.
+func (m *MockReminderRepo) GetReminderPreferences(ctx context.Context, userID string) (*entity.ReminderPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReminderPreferences", ctx, userID)
+	ret0, _ := ret[0].(*entity.ReminderPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReminderPreferences indicates an expected call of GetReminderPreferences.
+func (mr *MockReminderRepoMockRecorder) GetReminderPreferences(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminderPreferences", reflect.TypeOf((*MockReminderRepo)(nil).GetReminderPreferences), ctx, userID)
+}
+
+// PutReminderPreferences mocks base method.
+func (m *MockReminderRepo) PutReminderPreferences(ctx context.Context, prefs entity.ReminderPreferences) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutReminderPreferences", ctx, prefs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutReminderPreferences indicates an expected call of PutReminderPreferences.
+func (mr *MockReminderRepoMockRecorder) PutReminderPreferences(ctx, prefs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutReminderPreferences", reflect.TypeOf((*MockReminderRepo)(nil).PutReminderPreferences), ctx, prefs)
+}
+
+// ReminderTargets mocks base method.
+func (m *MockReminderRepo) ReminderTargets(ctx context.Context, since time.Time) ([]entity.ReminderTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReminderTargets", ctx, since)
+	ret0, _ := ret[0].([]entity.ReminderTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReminderTargets indicates an expected call of ReminderTargets.
+func (mr *MockReminderRepoMockRecorder) ReminderTargets(ctx, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReminderTargets", reflect.TypeOf((*MockReminderRepo)(nil).ReminderTargets), ctx, since)
+}
+
+// SendOnce mocks base method.
+func (m *MockReminderRepo) SendOnce(ctx context.Context, reminder entity.Reminder, send func(context.Context) error) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendOnce", ctx, reminder, send)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendOnce indicates an expected call of SendOnce.
+func (mr *MockReminderRepoMockRecorder) SendOnce(ctx, reminder, send interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendOnce", reflect.TypeOf((*MockReminderRepo)(nil).SendOnce), ctx, reminder, send)
+}
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotifier) Notify(ctx context.Context, reminder entity.Reminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, reminder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotifierMockRecorder) Notify(ctx, reminder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), ctx, reminder)
+}
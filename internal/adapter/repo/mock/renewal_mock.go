@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./renewal.go
+
+// Package repo is a generated GoMock package.
+package repo
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "subscription-service/internal/app/entity"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRenewalRepo is a mock of RenewalRepo interface.
+type MockRenewalRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockRenewalRepoMockRecorder
+}
+
+// MockRenewalRepoMockRecorder is the mock recorder for MockRenewalRepo.
+type MockRenewalRepoMockRecorder struct {
+	mock *MockRenewalRepo
+}
+
+// NewMockRenewalRepo creates a new mock instance.
+func NewMockRenewalRepo(ctrl *gomock.Controller) *MockRenewalRepo {
+	mock := &MockRenewalRepo{ctrl: ctrl}
+	mock.recorder = &MockRenewalRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRenewalRepo) EXPECT() *MockRenewalRepoMockRecorder {
+	return m.recorder
+}
+
+// DueRenewals mocks base method.
+func (m *MockRenewalRepo) DueRenewals(ctx context.Context, until time.Time) ([]entity.TenantSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DueRenewals", ctx, until)
+	ret0, _ := ret[0].([]entity.TenantSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DueRenewals indicates an expected call of DueRenewals.
+func (mr *MockRenewalRepoMockRecorder) DueRenewals(ctx, until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DueRenewals", reflect.TypeOf((*MockRenewalRepo)(nil).DueRenewals), ctx, until)
+}
+
+// Renew mocks base method.
+func (m *MockRenewalRepo) Renew(ctx context.Context, event entity.SubscriptionEvent) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Renew", ctx, event)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Renew indicates an expected call of Renew.
+func (mr *MockRenewalRepoMockRecorder) Renew(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Renew", reflect.TypeOf((*MockRenewalRepo)(nil).Renew), ctx, event)
+}
+
+// TryLock mocks base method.
+func (m *MockRenewalRepo) TryLock(ctx context.Context) (func(), bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TryLock", ctx)
+	ret0, _ := ret[0].(func())
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TryLock indicates an expected call of TryLock.
+func (mr *MockRenewalRepoMockRecorder) TryLock(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryLock", reflect.TypeOf((*MockRenewalRepo)(nil).TryLock), ctx)
+}
@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service.go
+
+// Package repo is a generated GoMock package.
+package repo
+
+import (
+	context "context"
+	reflect "reflect"
+	entity "subscription-service/internal/app/entity"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockServiceRepo is a mock of ServiceRepo interface.
+type MockServiceRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceRepoMockRecorder
+}
+
+// MockServiceRepoMockRecorder is the mock recorder for MockServiceRepo.
+type MockServiceRepoMockRecorder struct {
+	mock *MockServiceRepo
+}
+
+// NewMockServiceRepo creates a new mock instance.
+func NewMockServiceRepo(ctrl *gomock.Controller) *MockServiceRepo {
+	mock := &MockServiceRepo{ctrl: ctrl}
+	mock.recorder = &MockServiceRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceRepo) EXPECT() *MockServiceRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateService mocks base method.
+func (m *MockServiceRepo) CreateService(ctx context.Context, service entity.Service) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateService", ctx, service)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateService indicates an expected call of CreateService.
+func (mr *MockServiceRepoMockRecorder) CreateService(ctx, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateService", reflect.TypeOf((*MockServiceRepo)(nil).CreateService), ctx, service)
+}
+
+// DeleteService mocks base method.
+func (m *MockServiceRepo) DeleteService(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteService", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteService indicates an expected call of DeleteService.
+func (mr *MockServiceRepoMockRecorder) DeleteService(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteService", reflect.TypeOf((*MockServiceRepo)(nil).DeleteService), ctx, id)
+}
+
+// FindService mocks base method.
+func (m *MockServiceRepo) FindService(ctx context.Context, name string) (*entity.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindService", ctx, name)
+	ret0, _ := ret[0].(*entity.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindService indicates an expected call of FindService.
+func (mr *MockServiceRepoMockRecorder) FindService(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindService", reflect.TypeOf((*MockServiceRepo)(nil).FindService), ctx, name)
+}
+
+// GetService mocks base method.
+func (m *MockServiceRepo) GetService(ctx context.Context, id string) (*entity.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetService", ctx, id)
+	ret0, _ := ret[0].(*entity.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetService indicates an expected call of GetService.
+func (mr *MockServiceRepoMockRecorder) GetService(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetService", reflect.TypeOf((*MockServiceRepo)(nil).GetService), ctx, id)
+}
+
+// ListServices mocks base method.
+func (m *MockServiceRepo) ListServices(ctx context.Context, filter entity.ServiceFilter) ([]entity.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServices", ctx, filter)
+	ret0, _ := ret[0].([]entity.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServices indicates an expected call of ListServices.
+func (mr *MockServiceRepoMockRecorder) ListServices(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockServiceRepo)(nil).ListServices), ctx, filter)
+}
+
+// UpdateService mocks base method.
+func (m *MockServiceRepo) UpdateService(ctx context.Context, service entity.Service) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateService", ctx, service)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateService indicates an expected call of UpdateService.
+func (mr *MockServiceRepoMockRecorder) UpdateService(ctx, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateService", reflect.TypeOf((*MockServiceRepo)(nil).UpdateService), ctx, service)
+}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 	entity "subscription-service/internal/app/entity"
+	port "subscription-service/internal/port"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,6 +36,50 @@ func (m *MockSubscriptionRepo) EXPECT() *MockSubscriptionRepoMockRecorder {
 	return m.recorder
 }
 
+// AddEvents mocks base method.
+func (m *MockSubscriptionRepo) AddEvents(ctx context.Context, events []entity.SubscriptionEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEvents", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddEvents indicates an expected call of AddEvents.
+func (mr *MockSubscriptionRepoMockRecorder) AddEvents(ctx, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEvents", reflect.TypeOf((*MockSubscriptionRepo)(nil).AddEvents), ctx, events)
+}
+
+// Cancel mocks base method.
+func (m *MockSubscriptionRepo) Cancel(ctx context.Context, id string, cancel func(entity.Subscription) (entity.Subscription, error)) (*entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, id, cancel)
+	ret0, _ := ret[0].(*entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockSubscriptionRepoMockRecorder) Cancel(ctx, id, cancel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockSubscriptionRepo)(nil).Cancel), ctx, id, cancel)
+}
+
+// CountCancellations mocks base method.
+func (m *MockSubscriptionRepo) CountCancellations(ctx context.Context, filter entity.CancellationFilter) ([]entity.CancellationCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCancellations", ctx, filter)
+	ret0, _ := ret[0].([]entity.CancellationCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCancellations indicates an expected call of CountCancellations.
+func (mr *MockSubscriptionRepoMockRecorder) CountCancellations(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCancellations", reflect.TypeOf((*MockSubscriptionRepo)(nil).CountCancellations), ctx, filter)
+}
+
 // Create mocks base method.
 func (m *MockSubscriptionRepo) Create(ctx context.Context, post entity.CreateSubscriptionRequest) error {
 	m.ctrl.T.Helper()
@@ -49,6 +94,20 @@ func (mr *MockSubscriptionRepoMockRecorder) Create(ctx, post interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSubscriptionRepo)(nil).Create), ctx, post)
 }
 
+// CreateChecked mocks base method.
+func (m *MockSubscriptionRepo) CreateChecked(ctx context.Context, post entity.CreateSubscriptionRequest, check func(port.CheckReader) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChecked", ctx, post, check)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateChecked indicates an expected call of CreateChecked.
+func (mr *MockSubscriptionRepoMockRecorder) CreateChecked(ctx, post, check interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChecked", reflect.TypeOf((*MockSubscriptionRepo)(nil).CreateChecked), ctx, post, check)
+}
+
 // Delete mocks base method.
 func (m *MockSubscriptionRepo) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -93,6 +152,36 @@ func (mr *MockSubscriptionRepoMockRecorder) List(ctx, filter interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSubscriptionRepo)(nil).List), ctx, filter)
 }
 
+// ListActive mocks base method.
+func (m *MockSubscriptionRepo) ListActive(ctx context.Context, filter entity.PeriodFilter) ([]entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActive", ctx, filter)
+	ret0, _ := ret[0].([]entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActive indicates an expected call of ListActive.
+func (mr *MockSubscriptionRepoMockRecorder) ListActive(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActive", reflect.TypeOf((*MockSubscriptionRepo)(nil).ListActive), ctx, filter)
+}
+
+// ListEvents mocks base method.
+func (m *MockSubscriptionRepo) ListEvents(ctx context.Context, id string) ([]entity.SubscriptionEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, id)
+	ret0, _ := ret[0].([]entity.SubscriptionEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockSubscriptionRepoMockRecorder) ListEvents(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockSubscriptionRepo)(nil).ListEvents), ctx, id)
+}
+
 // Sum mocks base method.
 func (m *MockSubscriptionRepo) Sum(ctx context.Context, filter entity.ListSubscriptionFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -121,3 +210,100 @@ func (mr *MockSubscriptionRepoMockRecorder) Update(ctx, post interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSubscriptionRepo)(nil).Update), ctx, post)
 }
+
+// UpdateChecked mocks base method.
+func (m *MockSubscriptionRepo) UpdateChecked(ctx context.Context, post entity.UpdateSubscriptionRequest, check func(entity.Subscription, port.CheckReader) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChecked", ctx, post, check)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChecked indicates an expected call of UpdateChecked.
+func (mr *MockSubscriptionRepoMockRecorder) UpdateChecked(ctx, post, check interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChecked", reflect.TypeOf((*MockSubscriptionRepo)(nil).UpdateChecked), ctx, post, check)
+}
+
+// UpdatePauses mocks base method.
+func (m *MockSubscriptionRepo) UpdatePauses(ctx context.Context, id string, updatedAt int64, modify func(entity.Subscription) ([]entity.Pause, error)) (*entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePauses", ctx, id, updatedAt, modify)
+	ret0, _ := ret[0].(*entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePauses indicates an expected call of UpdatePauses.
+func (mr *MockSubscriptionRepoMockRecorder) UpdatePauses(ctx, id, updatedAt, modify interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePauses", reflect.TypeOf((*MockSubscriptionRepo)(nil).UpdatePauses), ctx, id, updatedAt, modify)
+}
+
+// MockCheckReader is a mock of CheckReader interface.
+type MockCheckReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockCheckReaderMockRecorder
+}
+
+// MockCheckReaderMockRecorder is the mock recorder for MockCheckReader.
+type MockCheckReaderMockRecorder struct {
+	mock *MockCheckReader
+}
+
+// NewMockCheckReader creates a new mock instance.
+func NewMockCheckReader(ctrl *gomock.Controller) *MockCheckReader {
+	mock := &MockCheckReader{ctrl: ctrl}
+	mock.recorder = &MockCheckReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCheckReader) EXPECT() *MockCheckReaderMockRecorder {
+	return m.recorder
+}
+
+// FindService mocks base method.
+func (m *MockCheckReader) FindService(ctx context.Context, name string) (*entity.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindService", ctx, name)
+	ret0, _ := ret[0].(*entity.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindService indicates an expected call of FindService.
+func (mr *MockCheckReaderMockRecorder) FindService(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindService", reflect.TypeOf((*MockCheckReader)(nil).FindService), ctx, name)
+}
+
+// ListActive mocks base method.
+func (m *MockCheckReader) ListActive(ctx context.Context, filter entity.PeriodFilter) ([]entity.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActive", ctx, filter)
+	ret0, _ := ret[0].([]entity.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActive indicates an expected call of ListActive.
+func (mr *MockCheckReaderMockRecorder) ListActive(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActive", reflect.TypeOf((*MockCheckReader)(nil).ListActive), ctx, filter)
+}
+
+// ListBudgets mocks base method.
+func (m *MockCheckReader) ListBudgets(ctx context.Context, userID string) ([]entity.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBudgets", ctx, userID)
+	ret0, _ := ret[0].([]entity.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBudgets indicates an expected call of ListBudgets.
+func (mr *MockCheckReaderMockRecorder) ListBudgets(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBudgets", reflect.TypeOf((*MockCheckReader)(nil).ListBudgets), ctx, userID)
+}
@@ -0,0 +1,192 @@
+package repo_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"subscription-service/internal/adapter/repo"
+	"subscription-service/internal/app/entity"
+)
+
+const tenantID = "6b3f1c1e-4a53-4c59-a1f4-0f0e5d2b8a11"
+
+func fullFilter() entity.ListSubscriptionFilter {
+	title := "Yandex Plus"
+	userID := "60601fee-2bf1-4721-ae6f-7636e79a0cba"
+	price := int64(400)
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, time.December, 1, 0, 0, 0, 0, time.UTC)
+	limit, offset := 50, 100
+
+	return entity.ListSubscriptionFilter{
+		Title:     &title,
+		UserID:    &userID,
+		Price:     &price,
+		StartDate: &start,
+		EndDate:   &end,
+		Limit:     &limit,
+		Offset:    &offset,
+	}
+}
+
+func TestListQuery(t *testing.T) {
+	query, args := repo.ListQuery(tenantID, entity.ListSubscriptionFilter{})
+
+	want := "SELECT id, title, service_id, price, user_id, start_date, end_date, phases, pauses, " +
+		"cancel_reason, cancel_note, cancel_effective_date, cancelled_at, renewal_term_months, created_at, updated_at " +
+		"FROM subscriptions WHERE tenant_id = $1"
+	if query != want || !reflect.DeepEqual(args, []any{tenantID}) {
+		t.Errorf("without filters: %q %v\nwant %q", query, args, want)
+	}
+
+	filter := fullFilter()
+	query, args = repo.ListQuery(tenantID, filter)
+
+	want = "SELECT id, title, service_id, price, user_id, start_date, end_date, phases, pauses, " +
+		"cancel_reason, cancel_note, cancel_effective_date, cancelled_at, renewal_term_months, created_at, updated_at " +
+		"FROM subscriptions WHERE tenant_id = $1 AND title = $2 AND user_id = $3 AND price = $4 " +
+		"AND start_date >= $5 AND end_date <= $6 LIMIT $7 OFFSET $8"
+	wantArgs := []any{tenantID, *filter.Title, *filter.UserID, *filter.Price, *filter.StartDate, *filter.EndDate, 50, 100}
+	if query != want || !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("with all filters: %q %v\nwant %q %v", query, args, want, wantArgs)
+	}
+}
+
+func TestSumQuery(t *testing.T) {
+	filter := fullFilter()
+	query, args := repo.SumQuery(tenantID, filter)
+
+	// The dates bound the billed months each subscription is expanded into, less the
+	// paused ones.
+	prefix := "SELECT COALESCE(SUM(months.price), 0) FROM subscriptions CROSS JOIN LATERAL ("
+	months := "FROM generate_series(GREATEST(subscriptions.start_date, $1::date), " +
+		"LEAST(subscriptions.end_date, $2::date), interval '1 month') AS month"
+	pauses := ") AS months WHERE tenant_id = $3 AND NOT EXISTS (" +
+		"\n    SELECT 1 FROM jsonb_array_elements(subscriptions.pauses) AS pause"
+	suffix := ") AND title = $4 AND user_id = $5"
+	wantArgs := []any{*filter.StartDate, *filter.EndDate, tenantID, *filter.Title, *filter.UserID}
+	if !strings.HasPrefix(query, prefix) || !strings.Contains(query, months) || !strings.Contains(query, pauses) ||
+		!strings.HasSuffix(query, suffix) || !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("%q %v\nwant %q ... %q ... %q ... %q %v", query, args, prefix, months, pauses, suffix, wantArgs)
+	}
+
+	// Without dates the months are those of the subscriptions.
+	query, _ = repo.SumQuery(tenantID, entity.ListSubscriptionFilter{})
+	if months := "generate_series(subscriptions.start_date, subscriptions.end_date, interval '1 month')"; !strings.Contains(query, months) {
+		t.Errorf("without dates: %q\nwant %q", query, months)
+	}
+}
+
+func TestActiveQuery(t *testing.T) {
+	filter := fullFilter()
+	period := entity.PeriodFilter{Title: filter.Title, From: *filter.StartDate, To: *filter.EndDate}
+	query, args := repo.ActiveQuery(tenantID, period)
+
+	want := "SELECT id, title, service_id, price, user_id, start_date, end_date, phases, pauses, " +
+		"cancel_reason, cancel_note, cancel_effective_date, cancelled_at, renewal_term_months, created_at, updated_at " +
+		"FROM subscriptions WHERE tenant_id = $1 AND start_date <= $2 AND (end_date IS NULL OR end_date >= $3) " +
+		"AND title = $4 ORDER BY start_date, id"
+	wantArgs := []any{tenantID, period.To, period.From, *period.Title}
+	if query != want || !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("%q %v\nwant %q %v", query, args, want, wantArgs)
+	}
+}
+
+func TestCancellationsQuery(t *testing.T) {
+	query, args := repo.CancellationsQuery(tenantID, entity.CancellationFilter{})
+
+	want := "SELECT cancel_effective_date, title, cancel_reason, COUNT(*) FROM subscriptions " +
+		"WHERE tenant_id = $1 AND cancel_reason IS NOT NULL " +
+		"GROUP BY cancel_effective_date, title, cancel_reason ORDER BY cancel_effective_date, title, cancel_reason"
+	if query != want || !reflect.DeepEqual(args, []any{tenantID}) {
+		t.Errorf("without filters: %q %v\nwant %q", query, args, want)
+	}
+
+	full := fullFilter()
+	serviceID := "plus"
+	filter := entity.CancellationFilter{
+		Title: full.Title, ServiceID: &serviceID, UserID: full.UserID, From: full.StartDate, To: full.EndDate,
+	}
+	query, args = repo.CancellationsQuery(tenantID, filter)
+
+	want = "SELECT cancel_effective_date, title, cancel_reason, COUNT(*) FROM subscriptions " +
+		"WHERE tenant_id = $1 AND cancel_reason IS NOT NULL AND title = $2 AND service_id = $3 AND user_id = $4 " +
+		"AND cancel_effective_date >= $5 AND cancel_effective_date <= $6 " +
+		"GROUP BY cancel_effective_date, title, cancel_reason ORDER BY cancel_effective_date, title, cancel_reason"
+	wantArgs := []any{tenantID, *filter.Title, serviceID, *filter.UserID, *filter.From, *filter.To}
+	if query != want || !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("with all filters: %q %v\nwant %q %v", query, args, want, wantArgs)
+	}
+}
+
+func TestMetricsQuery(t *testing.T) {
+	filter := entity.MetricsFilter{
+		From: time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC),
+	}
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	query, args := repo.MetricsQuery(tenantID, filter)
+
+	// The months start a month early for LAG to compare the first one with.
+	wantArgs := []any{tenantID, march.AddDate(0, -1, 0), filter.To, march}
+	if strings.Contains(query, "s.title") || !strings.Contains(query, "OVER w") || !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("without a service: %q %v\nwant %v", query, args, wantArgs)
+	}
+
+	title := "Okko"
+	filter.Title = &title
+	query, args = repo.MetricsQuery(tenantID, filter)
+
+	wantArgs = append(wantArgs, title)
+	if !strings.Contains(query, "WHERE s.tenant_id = $1 AND NOT EXISTS") || !strings.Contains(query, ") AND s.title = $5\n)") ||
+		!reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("with a service: %q %v\nwant %v", query, args, wantArgs)
+	}
+
+	serviceID := "plus"
+	filter.Title, filter.ServiceID = nil, &serviceID
+	query, args = repo.MetricsQuery(tenantID, filter)
+
+	wantArgs[len(wantArgs)-1] = serviceID
+	if strings.Contains(query, "s.title") || !strings.Contains(query, ") AND s.service_id = $5\n)") ||
+		!reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("with a service id: %q %v\nwant %v", query, args, wantArgs)
+	}
+}
+
+func BenchmarkListQuery(b *testing.B) {
+	for _, bc := range []struct {
+		name   string
+		filter entity.ListSubscriptionFilter
+	}{
+		{"no filters", entity.ListSubscriptionFilter{}},
+		{"all filters", fullFilter()},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				repo.ListQuery(tenantID, bc.filter)
+			}
+		})
+	}
+}
+
+func BenchmarkSumQuery(b *testing.B) {
+	for _, bc := range []struct {
+		name   string
+		filter entity.ListSubscriptionFilter
+	}{
+		{"period only", entity.ListSubscriptionFilter{StartDate: fullFilter().StartDate, EndDate: fullFilter().EndDate}},
+		{"all filters", fullFilter()},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				repo.SumQuery(tenantID, bc.filter)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+var _ port.ReminderRepo = (*Subscription)(nil)
+
+const reminderPreferencesColumns = "user_id, days_before, renewals, ends, price_changes, email, updated_at"
+
+func (r *Subscription) GetReminderPreferences(ctx context.Context, userID string) (*entity.ReminderPreferences, error) {
+	var prefs entity.ReminderPreferences
+
+	err := r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		return scanReminderPreferences(tx.QueryRow(ctx,
+			"SELECT "+reminderPreferencesColumns+" FROM reminder_preferences WHERE tenant_id = $1 AND user_id = $2",
+			tenantID, userID), &prefs)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, port.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &prefs, nil
+}
+
+func (r *Subscription) PutReminderPreferences(ctx context.Context, prefs entity.ReminderPreferences) error {
+	return r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		_, err := tx.Exec(ctx,
+			"INSERT INTO reminder_preferences (tenant_id, "+reminderPreferencesColumns+") "+
+				"VALUES ($1, $2, $3, $4, $5, $6, $7, $8) "+
+				"ON CONFLICT (tenant_id, user_id) DO UPDATE SET days_before = EXCLUDED.days_before, "+
+				"renewals = EXCLUDED.renewals, ends = EXCLUDED.ends, price_changes = EXCLUDED.price_changes, "+
+				"email = EXCLUDED.email, updated_at = EXCLUDED.updated_at",
+			tenantID, prefs.UserID, prefs.DaysBefore, prefs.Renewals, prefs.Ends, prefs.PriceChanges,
+			prefs.Email, prefs.UpdatedAt)
+		return err
+	})
+}
+
+// ReminderTargets reads every tenant through reminder_targets, which runs as the one
+// role the scan policies let do so.
+func (r *Subscription) ReminderTargets(ctx context.Context, since time.Time) ([]entity.ReminderTarget, error) {
+	columns := make([]string, len(subscriptionColumns))
+	for i, c := range subscriptionColumns {
+		columns[i] = "(t.subscription)." + c
+	}
+
+	res, err := r.pool.Query(ctx,
+		"SELECT (t.subscription).tenant_id, (t.preferences).days_before, (t.preferences).renewals, "+
+			"(t.preferences).ends, (t.preferences).price_changes, (t.preferences).email, (t.preferences).updated_at, "+
+			strings.Join(columns, ", ")+" FROM reminder_targets($1) t "+
+			"ORDER BY (t.subscription).tenant_id, (t.subscription).id",
+		since)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Close()
+
+	var targets []entity.ReminderTarget
+	for res.Next() {
+		var t entity.ReminderTarget
+		if t.Subscription, err = scanSubscription(reminderTargetRow{Row: res, target: &t}); err != nil {
+			return nil, err
+		}
+		t.Preferences.UserID = t.UserID
+		targets = append(targets, t)
+	}
+
+	if err = res.Err(); err != nil {
+		return nil, err
+	}
+
+	return targets, nil
+}
+
+// reminderTargetRow reads the tenant and the preferences selected before
+// subscriptionColumns.
+type reminderTargetRow struct {
+	pgx.Row
+	target *entity.ReminderTarget
+}
+
+func (r reminderTargetRow) Scan(dest ...any) error {
+	p := &r.target.Preferences
+
+	return r.Row.Scan(append([]any{
+		&r.target.TenantID, &p.DaysBefore, &p.Renewals, &p.Ends, &p.PriceChanges, &p.Email, &p.UpdatedAt,
+	}, dest...)...)
+}
+
+// SendOnce holds the row of the reminder in sent_reminders, uncommitted, while send
+// runs: a concurrent insert of the same reminder waits for the transaction and then
+// finds it sent, or takes over when it was rolled back.
+func (r *Subscription) SendOnce(
+	ctx context.Context,
+	reminder entity.Reminder,
+	send func(context.Context) error,
+) (bool, error) {
+	var sent bool
+
+	err := r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		tag, err := tx.Exec(ctx,
+			"INSERT INTO sent_reminders (subscription_id, kind, date, tenant_id, sent_at) "+
+				"VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING",
+			reminder.SubscriptionID, string(reminder.Kind), reminder.Date, tenantID, time.Now().UnixMilli())
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() == 0 {
+			return nil
+		}
+
+		if err = send(ctx); err != nil {
+			return err
+		}
+		sent = true
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return sent, nil
+}
+
+func scanReminderPreferences(row pgx.Row, prefs *entity.ReminderPreferences) error {
+	return row.Scan(
+		&prefs.UserID, &prefs.DaysBefore, &prefs.Renewals, &prefs.Ends, &prefs.PriceChanges,
+		&prefs.Email, &prefs.UpdatedAt,
+	)
+}
@@ -0,0 +1,314 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/pkg/logctx"
+	"subscription-service/internal/port"
+)
+
+var _ port.RenewalRepo = (*Subscription)(nil)
+
+// renewalLockKey is the key of the session-level advisory lock of the renewal job.
+const renewalLockKey int64 = 0x72656e6577616c // "renewal"
+
+// TryLock holds the advisory lock on a connection taken from the pool until unlock, so
+// the lock is released by Postgres as well when the connection or the process dies.
+func (r *Subscription) TryLock(ctx context.Context) (func(), bool, error) {
+	conn, err := r.pool.Acquire(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var ok bool
+	if err = conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", renewalLockKey).Scan(&ok); err != nil || !ok {
+		conn.Release()
+		return nil, false, err
+	}
+
+	unlock := func() {
+		ctx := context.WithoutCancel(ctx)
+		if _, err := conn.Exec(ctx, "SELECT pg_advisory_unlock($1)", renewalLockKey); err != nil {
+			logctx.Logger(ctx, r.logger).Error("release renewal lock", zap.Error(err))
+			// A closed connection is not returned to the pool, and its session with it.
+			if cErr := conn.Conn().Close(ctx); cErr != nil {
+				logctx.Logger(ctx, r.logger).Error("close renewal lock connection", zap.Error(cErr))
+			}
+		}
+		conn.Release()
+	}
+
+	return unlock, true, nil
+}
+
+// DueRenewals reads every tenant through renewal_due_subscriptions, which runs as the
+// one role the subscriptions_scan policy lets do so.
+func (r *Subscription) DueRenewals(ctx context.Context, until time.Time) ([]entity.TenantSubscription, error) {
+	res, err := r.pool.Query(ctx,
+		"SELECT tenant_id, "+strings.Join(subscriptionColumns, ", ")+" FROM renewal_due_subscriptions($1) "+
+			"ORDER BY end_date, id",
+		until)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Close()
+
+	var subs []entity.TenantSubscription
+	for res.Next() {
+		var s entity.TenantSubscription
+		if s.Subscription, err = scanSubscription(tenantRow{Row: res, tenantID: &s.TenantID}); err != nil {
+			return nil, err
+		}
+		subs = append(subs, s)
+	}
+
+	if err = res.Err(); err != nil {
+		return nil, err
+	}
+
+	return subs, nil
+}
+
+// tenantRow reads the tenant_id column selected before subscriptionColumns.
+type tenantRow struct {
+	pgx.Row
+	tenantID *string
+}
+
+func (r tenantRow) Scan(dest ...any) error {
+	return r.Row.Scan(append([]any{r.tenantID}, dest...)...)
+}
+
+func (r *Subscription) Renew(ctx context.Context, event entity.SubscriptionEvent) (bool, error) {
+	if event.Renewal == nil {
+		return false, errors.New("renew without a renewal event")
+	}
+
+	data, err := encodeEventData(event)
+	if err != nil {
+		return false, err
+	}
+
+	var renewed bool
+
+	err = r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		tag, err := tx.Exec(ctx,
+			"UPDATE subscriptions SET end_date = $4, updated_at = $5 "+
+				"WHERE id = $1 AND tenant_id = $2 AND end_date = $3 "+
+				"AND renewal_term_months IS NOT NULL AND cancel_reason IS NULL",
+			event.SubscriptionID, tenantID, event.Renewal.PreviousEndDate, event.Renewal.EndDate, event.CreatedAt)
+		if err != nil {
+			logctx.Logger(ctx, r.logger).Debug("renew subscription", zap.Error(err))
+			return port.ErrSubscriptionAlreadyExists
+		}
+
+		if tag.RowsAffected() == 0 {
+			return nil
+		}
+		renewed = true
+
+		_, err = tx.Exec(ctx,
+			"INSERT INTO subscription_events (id, tenant_id, subscription_id, type, data, created_at) "+
+				"VALUES ($1, $2, $3, $4, $5, $6)",
+			event.ID, tenantID, event.SubscriptionID, string(event.Type), data, event.CreatedAt)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return renewed, nil
+}
+
+func (r *Subscription) ListEvents(ctx context.Context, id string) ([]entity.SubscriptionEvent, error) {
+	var events []entity.SubscriptionEvent
+
+	err := r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		var exists bool
+		if err := tx.QueryRow(ctx,
+			"SELECT EXISTS (SELECT 1 FROM subscriptions WHERE id = $1 AND tenant_id = $2)",
+			id, tenantID).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return sql.ErrNoRows
+		}
+
+		res, err := tx.Query(ctx,
+			"SELECT id, type, data, created_at FROM subscription_events "+
+				"WHERE subscription_id = $1 AND tenant_id = $2 ORDER BY seq",
+			id, tenantID)
+		if err != nil {
+			return err
+		}
+
+		defer res.Close()
+
+		for res.Next() {
+			event := entity.SubscriptionEvent{SubscriptionID: id}
+			var data []byte
+			if err := res.Scan(&event.ID, &event.Type, &data, &event.CreatedAt); err != nil {
+				return err
+			}
+			if err := decodeEventData(&event, data); err != nil {
+				return err
+			}
+			events = append(events, event)
+		}
+
+		return res.Err()
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, port.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *Subscription) AddEvents(ctx context.Context, events []entity.SubscriptionEvent) error {
+	return r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		for _, event := range events {
+			data, err := encodeEventData(event)
+			if err != nil {
+				return err
+			}
+
+			// The subscription is selected rather than referenced, so that one of another
+			// tenant, hidden by its policy, is reported as missing too.
+			tag, err := tx.Exec(ctx,
+				"INSERT INTO subscription_events (id, tenant_id, subscription_id, type, data, created_at) "+
+					"SELECT $1, $2, id, $4, $5, $6 FROM subscriptions WHERE id = $3 AND tenant_id = $2",
+				event.ID, tenantID, event.SubscriptionID, string(event.Type), data, event.CreatedAt)
+			if err != nil {
+				return err
+			}
+
+			if tag.RowsAffected() == 0 {
+				return port.ErrNotFound
+			}
+		}
+
+		return nil
+	})
+}
+
+// renewalJSON is the data column of a renewed event.
+type renewalJSON struct {
+	PreviousEndDate string `json:"previous_end_date"`
+	EndDate         string `json:"end_date"`
+	TermMonths      int    `json:"term_months"`
+}
+
+// budgetAlertJSON is the data column of a budget_exceeded event.
+type budgetAlertJSON struct {
+	BudgetID string `json:"budget_id"`
+	Category string `json:"category,omitempty"`
+	Month    string `json:"month"`
+	Limit    int64  `json:"limit"`
+	Cost     int64  `json:"cost"`
+}
+
+func encodeEventData(event entity.SubscriptionEvent) (string, error) {
+	var data any = struct{}{}
+	switch {
+	case event.Renewal != nil:
+		r := event.Renewal
+		data = renewalJSON{
+			PreviousEndDate: r.PreviousEndDate.Format(dateLayout),
+			EndDate:         r.EndDate.Format(dateLayout),
+			TermMonths:      r.TermMonths,
+		}
+	case event.BudgetAlert != nil:
+		a := event.BudgetAlert
+		data = budgetAlertJSON{
+			BudgetID: a.BudgetID,
+			Category: a.Category,
+			Month:    a.Month.Format(dateLayout),
+			Limit:    a.Limit,
+			Cost:     a.Cost,
+		}
+	}
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return "", fmt.Errorf("encode event: %w", err)
+	}
+
+	return string(encoded), nil
+}
+
+func decodeEventData(event *entity.SubscriptionEvent, data []byte) error {
+	switch event.Type {
+	case entity.EventRenewed:
+		return decodeRenewal(event, data)
+	case entity.EventBudgetExceeded:
+		return decodeBudgetAlert(event, data)
+	default:
+		return nil
+	}
+}
+
+func decodeRenewal(event *entity.SubscriptionEvent, data []byte) error {
+	var row renewalJSON
+	if err := json.Unmarshal(data, &row); err != nil {
+		return fmt.Errorf("decode event: %w", err)
+	}
+
+	previous, err := time.Parse(dateLayout, row.PreviousEndDate)
+	if err != nil {
+		return fmt.Errorf("decode event: %w", err)
+	}
+	end, err := time.Parse(dateLayout, row.EndDate)
+	if err != nil {
+		return fmt.Errorf("decode event: %w", err)
+	}
+
+	event.Renewal = &entity.RenewalEvent{PreviousEndDate: previous, EndDate: end, TermMonths: row.TermMonths}
+
+	return nil
+}
+
+func decodeBudgetAlert(event *entity.SubscriptionEvent, data []byte) error {
+	var row budgetAlertJSON
+	if err := json.Unmarshal(data, &row); err != nil {
+		return fmt.Errorf("decode event: %w", err)
+	}
+
+	month, err := time.Parse(dateLayout, row.Month)
+	if err != nil {
+		return fmt.Errorf("decode event: %w", err)
+	}
+
+	event.BudgetAlert = &entity.BudgetAlert{
+		BudgetID: row.BudgetID,
+		Category: row.Category,
+		Month:    month,
+		Limit:    row.Limit,
+		Cost:     row.Cost,
+	}
+
+	return nil
+}
+
+// renewalTermMonths is the renewal_term_months column of a subscription with renewal.
+func renewalTermMonths(renewal *entity.Renewal) *int {
+	if renewal == nil {
+		return nil
+	}
+
+	return &renewal.TermMonths
+}
@@ -0,0 +1,229 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/pkg/logctx"
+	"subscription-service/internal/port"
+)
+
+var _ port.ServiceRepo = (*Subscription)(nil)
+
+const serviceColumns = "id, name, aliases, category, vendor_url, default_price, currency, created_at, updated_at"
+
+func (r *Subscription) CreateService(ctx context.Context, service entity.Service) error {
+	err := r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		_, err := tx.Exec(ctx,
+			"INSERT INTO services (tenant_id, "+serviceColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+			tenantID, service.ID, service.Name, aliases(service.Aliases), service.Category, service.VendorURL,
+			service.DefaultPrice, service.Currency, service.CreatedAt, service.UpdatedAt)
+		if err != nil {
+			return err
+		}
+
+		return writeServiceNames(ctx, tx, tenantID, service)
+	})
+
+	return r.serviceError(ctx, "insert service", err)
+}
+
+func (r *Subscription) GetService(ctx context.Context, id string) (*entity.Service, error) {
+	var service entity.Service
+
+	err := r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		return scanService(tx.QueryRow(ctx,
+			"SELECT "+serviceColumns+" FROM services WHERE id = $1 AND tenant_id = $2", id, tenantID), &service)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, port.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &service, nil
+}
+
+func (r *Subscription) UpdateService(ctx context.Context, service entity.Service) error {
+	err := r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		tag, err := tx.Exec(ctx,
+			"UPDATE services SET name = $3, aliases = $4, category = $5, vendor_url = $6, default_price = $7, "+
+				"currency = $8, updated_at = $9 WHERE id = $1 AND tenant_id = $2",
+			service.ID, tenantID, service.Name, aliases(service.Aliases), service.Category, service.VendorURL,
+			service.DefaultPrice, service.Currency, service.UpdatedAt)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() == 0 {
+			return port.ErrNotFound
+		}
+
+		if _, err = tx.Exec(ctx,
+			"DELETE FROM service_names WHERE service_id = $1 AND tenant_id = $2", service.ID, tenantID); err != nil {
+			return err
+		}
+
+		return writeServiceNames(ctx, tx, tenantID, service)
+	})
+
+	return r.serviceError(ctx, "update service", err)
+}
+
+// DeleteService relies on the foreign keys to remove the names of the service and
+// unlink its subscriptions.
+func (r *Subscription) DeleteService(ctx context.Context, id string) error {
+	return r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		tag, err := tx.Exec(ctx, "DELETE FROM services WHERE id = $1 AND tenant_id = $2", id, tenantID)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() == 0 {
+			return port.ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+func (r *Subscription) ListServices(ctx context.Context, filter entity.ServiceFilter) ([]entity.Service, error) {
+	var services []entity.Service
+
+	err := r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		res, err := tx.Query(ctx,
+			"SELECT "+serviceColumns+" FROM services "+
+				"WHERE tenant_id = $1 AND ($2::text IS NULL OR category = $2) ORDER BY name, id",
+			tenantID, filter.Category)
+		if err != nil {
+			return err
+		}
+
+		defer res.Close()
+
+		for res.Next() {
+			var service entity.Service
+			if err := scanService(res, &service); err != nil {
+				return err
+			}
+			services = append(services, service)
+		}
+
+		return res.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return services, nil
+}
+
+func (r *Subscription) FindService(ctx context.Context, name string) (*entity.Service, error) {
+	var service *entity.Service
+
+	err := r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		var err error
+		service, err = findService(ctx, tx, tenantID, name)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+func findService(ctx context.Context, tx pgx.Tx, tenantID, name string) (*entity.Service, error) {
+	var service entity.Service
+
+	err := scanService(tx.QueryRow(ctx,
+		"SELECT "+serviceColumns+" FROM services WHERE tenant_id = $1 AND id = "+
+			"(SELECT service_id FROM service_names WHERE tenant_id = $1 AND name = $2)",
+		tenantID, entity.ServiceKey(name)), &service)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, port.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &service, nil
+}
+
+// writeServiceNames stores the names of the service in service_names, where a name taken
+// by another service violates the primary key, and links the subscriptions without a
+// service whose title is one of them, which the no-overlap constraint on services then
+// checks.
+func writeServiceNames(ctx context.Context, tx pgx.Tx, tenantID string, service entity.Service) error {
+	var keys []string
+	for _, name := range service.Names() {
+		if key := entity.ServiceKey(name); !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+
+	if _, err := tx.Exec(ctx,
+		"INSERT INTO service_names (tenant_id, name, service_id) SELECT $1, unnest($2::text[]), $3",
+		tenantID, keys, service.ID); err != nil {
+		return err
+	}
+
+	_, err := tx.Exec(ctx,
+		"UPDATE subscriptions SET service_id = $3 "+
+			"WHERE tenant_id = $1 AND service_id IS NULL AND lower(btrim(title)) = ANY($2::text[])",
+		tenantID, keys, service.ID)
+
+	return err
+}
+
+// serviceError turns the duplicate key of a service write into ErrServiceAlreadyExists,
+// and the subscriptions it links that overlap into ErrSubscriptionAlreadyExists.
+func (r *Subscription) serviceError(ctx context.Context, op string, err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case uniqueViolation:
+		logctx.Logger(ctx, r.logger).Debug(op, zap.Error(err))
+		return port.ErrServiceAlreadyExists
+	case exclusionViolation:
+		logctx.Logger(ctx, r.logger).Debug(op, zap.Error(err))
+		return port.ErrSubscriptionAlreadyExists
+	}
+
+	return err
+}
+
+// aliases stores a service without aliases with an empty array rather than NULL.
+func aliases(a []string) []string {
+	if a == nil {
+		return []string{}
+	}
+
+	return a
+}
+
+func scanService(row pgx.Row, service *entity.Service) error {
+	if err := row.Scan(
+		&service.ID, &service.Name, &service.Aliases, &service.Category, &service.VendorURL,
+		&service.DefaultPrice, &service.Currency, &service.CreatedAt, &service.UpdatedAt,
+	); err != nil {
+		return err
+	}
+
+	if len(service.Aliases) == 0 {
+		service.Aliases = nil
+	}
+
+	return nil
+}
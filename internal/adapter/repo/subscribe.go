@@ -3,13 +3,20 @@ package repo
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/huandu/go-sqlbuilder"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 
 	"subscription-service/internal/app/entity"
+	"subscription-service/internal/pkg/logctx"
 	"subscription-service/internal/port"
 )
 
@@ -24,23 +31,56 @@ func NewSubscription(pool *pgxpool.Pool, logger *zap.Logger) (*Subscription, err
 	return &Subscription{pool: pool, logger: logger}, nil
 }
 
+// inTenant runs fn in a transaction bound to the tenant from ctx. The tenant is both
+// passed to fn for explicit filtering and set as app.tenant_id for row-level security.
+func (r *Subscription) inTenant(ctx context.Context, fn func(tx pgx.Tx, tenantID string) error) error {
+	return inTenant(ctx, r.pool, r.logger, fn)
+}
+
+func inTenant(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	logger *zap.Logger,
+	fn func(tx pgx.Tx, tenantID string) error,
+) error {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return port.ErrTenantRequired
+	}
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if rErr := tx.Rollback(ctx); rErr != nil && !errors.Is(rErr, pgx.ErrTxClosed) {
+			logctx.Logger(ctx, logger).Error("rollback tenant transaction", zap.Error(rErr))
+		}
+	}()
+
+	// set_config with is_local = true is SET LOCAL that accepts a bind parameter.
+	if _, err = tx.Exec(ctx, "SELECT set_config('app.tenant_id', $1, true)", tenantID); err != nil {
+		return err
+	}
+
+	if err = fn(tx, tenantID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
 func (r *Subscription) GetSubscription(ctx context.Context, id string) (*entity.Subscription, error) {
 	var sub entity.Subscription
 
-	err := r.pool.QueryRow(ctx, `
-    SELECT id, title, price, user_id, start_date, end_date, created_at, updated_at 
-    FROM subscriptions 
-    WHERE id = $1
-`, id).Scan(
-		&sub.ID,
-		&sub.Title,
-		&sub.Price,
-		&sub.UserID,
-		&sub.StartDate,
-		&sub.EndDate,
-		&sub.CreatedAt,
-		&sub.UpdatedAt,
-	)
+	err := r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		var err error
+		sub, err = scanSubscription(tx.QueryRow(ctx,
+			"SELECT "+strings.Join(subscriptionColumns, ", ")+" FROM subscriptions WHERE id = $1 AND tenant_id = $2",
+			id, tenantID))
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, port.ErrNotFound
@@ -52,74 +92,451 @@ func (r *Subscription) GetSubscription(ctx context.Context, id string) (*entity.
 }
 
 func (r *Subscription) Create(ctx context.Context, post entity.CreateSubscriptionRequest) error {
-	_, err := r.pool.Exec(
+	phases, err := encodePhases(post.Phases)
+	if err != nil {
+		return err
+	}
+
+	err = r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		return insertSubscription(ctx, tx, tenantID, post, phases)
+	})
+
+	return r.subscriptionError(ctx, "insert subscription", err)
+}
+
+func (r *Subscription) CreateChecked(
+	ctx context.Context,
+	post entity.CreateSubscriptionRequest,
+	check func(port.CheckReader) error,
+) error {
+	phases, err := encodePhases(post.Phases)
+	if err != nil {
+		return err
+	}
+
+	err = r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		if err := lockUser(ctx, tx, tenantID, post.UserID); err != nil {
+			return err
+		}
+		if err := check(checkReader{tx: tx, tenantID: tenantID}); err != nil {
+			return err
+		}
+
+		return insertSubscription(ctx, tx, tenantID, post, phases)
+	})
+
+	return r.subscriptionError(ctx, "insert subscription", err)
+}
+
+func insertSubscription(
+	ctx context.Context,
+	tx pgx.Tx,
+	tenantID string,
+	post entity.CreateSubscriptionRequest,
+	phases string,
+) error {
+	_, err := tx.Exec(
 		ctx, "INSERT INTO subscriptions"+
-			"(id, title, price, user_id, start_date, end_date, created_at, updated_at)"+
-			" VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+			"(id, tenant_id, title, service_id, price, user_id, start_date, end_date, phases, renewal_term_months,"+
+			" created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
 		&post.ID,
+		tenantID,
 		&post.Title,
+		post.ServiceID,
 		&post.Price,
 		&post.UserID,
 		&post.StartDate,
 		&post.EndDate,
+		phases,
+		renewalTermMonths(post.Renewal),
 		&post.CreatedAt,
 		&post.UpdatedAt)
+
+	return err
+}
+
+// lockUser makes the checked writes of the subscriptions of a user wait for each other
+// until the transaction ends.
+func lockUser(ctx context.Context, tx pgx.Tx, tenantID, userID string) error {
+	_, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtextextended('subscriptions:' || $1 || ':' || $2, 0))",
+		tenantID, userID)
+
+	return err
+}
+
+// checkReader reads in the transaction of a checked write.
+type checkReader struct {
+	tx       pgx.Tx
+	tenantID string
+}
+
+func (c checkReader) ListBudgets(ctx context.Context, userID string) ([]entity.Budget, error) {
+	return listBudgets(ctx, c.tx, c.tenantID, userID)
+}
+
+func (c checkReader) ListActive(ctx context.Context, filter entity.PeriodFilter) ([]entity.Subscription, error) {
+	queryString, args := activeQuery(c.tenantID, filter)
+
+	return querySubscriptions(ctx, c.tx, queryString, args)
+}
+
+func (c checkReader) FindService(ctx context.Context, name string) (*entity.Service, error) {
+	return findService(ctx, c.tx, c.tenantID, name)
+}
+
+func (r *Subscription) Update(ctx context.Context, post entity.UpdateSubscriptionRequest) error {
+	phases, err := encodePhases(post.Phases)
 	if err != nil {
-		return port.ErrSubscriptionAlreadyExists
+		return err
 	}
-	return nil
+
+	err = r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		return updateSubscription(ctx, tx, tenantID, post, phases)
+	})
+
+	return r.subscriptionError(ctx, "update subscription", err)
 }
 
-func (r *Subscription) Update(ctx context.Context, post entity.UpdateSubscriptionRequest) error {
-	tag, err := r.pool.Exec(ctx,
+func (r *Subscription) UpdateChecked(
+	ctx context.Context,
+	post entity.UpdateSubscriptionRequest,
+	check func(entity.Subscription, port.CheckReader) error,
+) error {
+	phases, err := encodePhases(post.Phases)
+	if err != nil {
+		return err
+	}
+
+	err = r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		current, err := scanSubscription(tx.QueryRow(ctx,
+			"SELECT "+strings.Join(subscriptionColumns, ", ")+
+				" FROM subscriptions WHERE id = $1 AND tenant_id = $2 FOR UPDATE",
+			post.ID, tenantID))
+		if err != nil {
+			return err
+		}
+
+		if err := lockUser(ctx, tx, tenantID, current.UserID); err != nil {
+			return err
+		}
+		if err := check(current, checkReader{tx: tx, tenantID: tenantID}); err != nil {
+			return err
+		}
+
+		return updateSubscription(ctx, tx, tenantID, post, phases)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return port.ErrNotFound
+	}
+
+	return r.subscriptionError(ctx, "update subscription", err)
+}
+
+// updateSubscription writes the columns of post an update changes: the user and the
+// creation time stay.
+func updateSubscription(
+	ctx context.Context,
+	tx pgx.Tx,
+	tenantID string,
+	post entity.UpdateSubscriptionRequest,
+	phases string,
+) error {
+	_, err := tx.Exec(ctx,
 		"UPDATE subscriptions "+
-			"SET title = $2, price = $3, start_date = $4, end_date= $5, updated_at = $6 "+
-			"WHERE id = $1",
+			"SET title = $3, service_id = $4, price = $5, start_date = $6, end_date= $7, phases = $8, "+
+			"renewal_term_months = $9, updated_at = $10 "+
+			"WHERE id = $1 AND tenant_id = $2",
 		post.ID,
+		tenantID,
 		post.Title,
+		post.ServiceID,
 		post.Price,
 		post.StartDate,
 		post.EndDate,
+		phases,
+		renewalTermMonths(post.Renewal),
 		post.UpdatedAt)
-	if err != nil {
+
+	return err
+}
+
+// The SQLSTATEs of the constraints a subscription write breaks besides uniqueViolation.
+const (
+	// exclusionViolation is an overlap with another subscription of the user.
+	exclusionViolation = "23P01"
+	// foreignKeyViolation is a service_id that is not in the catalog.
+	foreignKeyViolation = "23503"
+)
+
+// subscriptionError turns the constraint violations of a subscription write into the
+// errors of the port: a taken id or an overlapping period into
+// ErrSubscriptionAlreadyExists and an unknown service into ErrServiceNotFound. Other
+// errors, such as the invalid range of a period that ends before it starts, are returned
+// as they are.
+func (r *Subscription) subscriptionError(ctx context.Context, op string, err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case uniqueViolation, exclusionViolation:
+		logctx.Logger(ctx, r.logger).Debug(op, zap.Error(err))
 		return port.ErrSubscriptionAlreadyExists
+	case foreignKeyViolation:
+		logctx.Logger(ctx, r.logger).Debug(op, zap.Error(err))
+		return port.ErrServiceNotFound
+	default:
+		return err
 	}
-	if tag.RowsAffected() == 0 {
-		return nil
+}
+
+func (r *Subscription) UpdatePauses(
+	ctx context.Context,
+	id string,
+	updatedAt int64,
+	modify func(entity.Subscription) ([]entity.Pause, error),
+) (*entity.Subscription, error) {
+	var sub entity.Subscription
+
+	err := r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		var err error
+		sub, err = scanSubscription(tx.QueryRow(ctx,
+			"SELECT "+strings.Join(subscriptionColumns, ", ")+
+				" FROM subscriptions WHERE id = $1 AND tenant_id = $2 FOR UPDATE",
+			id, tenantID))
+		if err != nil {
+			return err
+		}
+
+		if sub.Pauses, err = modify(sub); err != nil {
+			return err
+		}
+		sub.UpdatedAt = updatedAt
+
+		pauses, err := encodePauses(sub.Pauses)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx,
+			"UPDATE subscriptions SET pauses = $3, updated_at = $4 WHERE id = $1 AND tenant_id = $2",
+			id, tenantID, pauses, updatedAt)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, port.ErrNotFound
+		}
+		return nil, err
 	}
 
-	return nil
+	return &sub, nil
 }
 
-func (r *Subscription) Delete(ctx context.Context, id string) error {
-	tag, err := r.pool.Exec(ctx, "DELETE FROM subscriptions WHERE id = $1", id)
-	if err != nil {
+func (r *Subscription) Cancel(
+	ctx context.Context,
+	id string,
+	cancel func(entity.Subscription) (entity.Subscription, error),
+) (*entity.Subscription, error) {
+	var sub entity.Subscription
+
+	err := r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		stored, err := scanSubscription(tx.QueryRow(ctx,
+			"SELECT "+strings.Join(subscriptionColumns, ", ")+
+				" FROM subscriptions WHERE id = $1 AND tenant_id = $2 FOR UPDATE",
+			id, tenantID))
+		if err != nil {
+			return err
+		}
+
+		cancelled, err := cancel(stored)
+		if err != nil {
+			return err
+		}
+		if cancelled.Cancellation == nil {
+			return errors.New("cancel returned no cancellation")
+		}
+
+		sub = stored
+		sub.EndDate = cancelled.EndDate
+		sub.Phases = cancelled.Phases
+		sub.Pauses = cancelled.Pauses
+		sub.Cancellation = cancelled.Cancellation
+		sub.UpdatedAt = cancelled.UpdatedAt
+
+		phases, err := encodePhases(sub.Phases)
+		if err != nil {
+			return err
+		}
+		pauses, err := encodePauses(sub.Pauses)
+		if err != nil {
+			return err
+		}
+
+		c := sub.Cancellation
+		_, err = tx.Exec(ctx,
+			"UPDATE subscriptions SET end_date = $3, phases = $4, pauses = $5, cancel_reason = $6, "+
+				"cancel_note = $7, cancel_effective_date = $8, cancelled_at = $9, updated_at = $10 "+
+				"WHERE id = $1 AND tenant_id = $2",
+			id, tenantID, sub.EndDate, phases, pauses, c.Reason, c.Note, c.EffectiveDate, c.CancelledAt, sub.UpdatedAt)
 		return err
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, port.ErrNotFound
+		}
+		return nil, err
 	}
 
-	if tag.RowsAffected() == 0 {
-		return port.ErrNotFound
+	return &sub, nil
+}
+
+func (r *Subscription) CountCancellations(
+	ctx context.Context,
+	filter entity.CancellationFilter,
+) ([]entity.CancellationCount, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, port.ErrTenantRequired
+	}
+
+	queryString, args := cancellationsQuery(tenantID, filter)
+
+	var counts []entity.CancellationCount
+
+	err := r.inTenant(ctx, func(tx pgx.Tx, _ string) error {
+		res, err := tx.Query(ctx, queryString, args...)
+		if err != nil {
+			return err
+		}
+
+		defer res.Close()
+
+		for res.Next() {
+			var c entity.CancellationCount
+			if err := res.Scan(&c.Month, &c.Title, &c.Reason, &c.Count); err != nil {
+				return err
+			}
+			counts = append(counts, c)
+		}
+
+		return res.Err()
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return counts, nil
+}
+
+func (r *Subscription) Delete(ctx context.Context, id string) error {
+	return r.inTenant(ctx, func(tx pgx.Tx, tenantID string) error {
+		tag, err := tx.Exec(ctx, "DELETE FROM subscriptions WHERE id = $1 AND tenant_id = $2", id, tenantID)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() == 0 {
+			return port.ErrNotFound
+		}
+
+		return nil
+	})
 }
 
 func (r *Subscription) List(ctx context.Context, filter entity.ListSubscriptionFilter) ([]entity.Subscription, error) {
-	query := sqlbuilder.Select(
-		"id",
-		"title",
-		"price",
-		"user_id",
-		"start_date",
-		"end_date", "created_at",
-		"updated_at",
-	).From("subscriptions")
-
-	var and []string
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, port.ErrTenantRequired
+	}
+
+	queryString, args := listQuery(tenantID, filter)
+
+	return r.query(ctx, queryString, args)
+}
+
+func (r *Subscription) ListActive(ctx context.Context, filter entity.PeriodFilter) ([]entity.Subscription, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return nil, port.ErrTenantRequired
+	}
+
+	queryString, args := activeQuery(tenantID, filter)
+
+	return r.query(ctx, queryString, args)
+}
+
+// query runs a query selecting subscriptionColumns.
+func (r *Subscription) query(ctx context.Context, queryString string, args []any) ([]entity.Subscription, error) {
+	var subs []entity.Subscription
+
+	err := r.inTenant(ctx, func(tx pgx.Tx, _ string) error {
+		var err error
+		subs, err = querySubscriptions(ctx, tx, queryString, args)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subs, nil
+}
+
+func querySubscriptions(ctx context.Context, tx pgx.Tx, queryString string, args []any) ([]entity.Subscription, error) {
+	res, err := tx.Query(ctx, queryString, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Close()
+
+	var subs []entity.Subscription
+	for res.Next() {
+		s, err := scanSubscription(res)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, s)
+	}
+
+	return subs, res.Err()
+}
+
+func (r *Subscription) Sum(ctx context.Context, filter entity.ListSubscriptionFilter) (int64, error) {
+	tenantID, ok := entity.TenantFromContext(ctx)
+	if !ok {
+		return 0, port.ErrTenantRequired
+	}
+
+	queryString, args := sumQuery(tenantID, filter)
+
+	var sum int64
+	err := r.inTenant(ctx, func(tx pgx.Tx, _ string) error {
+		return tx.QueryRow(ctx, queryString, args...).Scan(&sum)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return sum, nil
+}
+
+// listQuery builds the query of List. Filters left nil don't restrict the result.
+func listQuery(tenantID string, filter entity.ListSubscriptionFilter) (string, []any) {
+	query := sqlbuilder.Select(subscriptionColumns...).From("subscriptions")
+
+	and := []string{query.EQ("tenant_id", tenantID)}
 
 	if filter.Title != nil {
 		and = append(and, query.EQ("title", *filter.Title))
 	}
+	if filter.ServiceID != nil {
+		and = append(and, query.EQ("service_id", *filter.ServiceID))
+	}
 	if filter.UserID != nil {
 		and = append(and, query.EQ("user_id", *filter.UserID))
 	}
@@ -141,73 +558,273 @@ func (r *Subscription) List(ctx context.Context, filter entity.ListSubscriptionF
 		query.Offset(*filter.Offset)
 	}
 
-	queryString, args := query.Where(and...).BuildWithFlavor(sqlbuilder.PostgreSQL)
-	res, err := r.pool.Query(ctx, queryString, args...)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, nil
-		}
-		return nil, err
+	return query.Where(and...).BuildWithFlavor(sqlbuilder.PostgreSQL)
+}
+
+// billedMonths expands every subscription into the months between the two bounds it is
+// formatted with, each with its price as entity.Subscription.PriceAt has it: that of the
+// phase covering the month or else the regular one. A NULL end, of an open-ended
+// subscription without an end date in the filter, expands into no months.
+const billedMonths = `subscriptions CROSS JOIN LATERAL (
+    SELECT month::date AS month, COALESCE((
+        SELECT (p->>'price')::bigint FROM jsonb_array_elements(subscriptions.phases) AS p
+        WHERE (p->>'start_date')::date <= month::date AND (p->>'end_date')::date >= month::date
+        LIMIT 1
+    ), subscriptions.price) AS price
+    FROM generate_series(%s, %s, interval '1 month') AS month
+) AS months`
+
+// notPausedIn leaves out the months a pause covers, as entity.Subscription.PausedIn.
+const notPausedIn = `NOT EXISTS (
+    SELECT 1 FROM jsonb_array_elements(subscriptions.pauses) AS pause
+    WHERE (pause->>'start_date')::date <= months.month
+        AND (pause->>'end_date' IS NULL OR (pause->>'end_date')::date >= months.month)
+)`
+
+// sumQuery builds the query of Sum. Price, limit and offset of the filter are ignored,
+// and the dates bound the months counted rather than the subscriptions.
+func sumQuery(tenantID string, filter entity.ListSubscriptionFilter) (string, []any) {
+	query := sqlbuilder.Select("COALESCE(SUM(months.price), 0)")
+
+	from, to := "subscriptions.start_date", "subscriptions.end_date"
+	if filter.StartDate != nil {
+		from = "GREATEST(subscriptions.start_date, " + query.Var(*filter.StartDate) + "::date)"
+	}
+	if filter.EndDate != nil {
+		// LEAST ignores the NULL end date of an open-ended subscription.
+		to = "LEAST(subscriptions.end_date, " + query.Var(*filter.EndDate) + "::date)"
 	}
+	query.From(fmt.Sprintf(billedMonths, from, to))
 
-	defer res.Close()
+	and := []string{query.EQ("tenant_id", tenantID), notPausedIn}
 
-	if !res.Next() {
-		return nil, nil
+	if filter.Title != nil {
+		and = append(and, query.EQ("title", *filter.Title))
+	}
+	if filter.ServiceID != nil {
+		and = append(and, query.EQ("service_id", *filter.ServiceID))
+	}
+	if filter.UserID != nil {
+		and = append(and, query.EQ("user_id", *filter.UserID))
 	}
 
-	var subs []entity.Subscription
+	return query.Where(and...).BuildWithFlavor(sqlbuilder.PostgreSQL)
+}
 
-	for {
-		var s entity.Subscription
-		if err := res.Scan(
-			&s.ID, &s.Title, &s.Price, &s.UserID,
-			&s.StartDate, &s.EndDate, &s.CreatedAt, &s.UpdatedAt,
-		); err != nil {
-			return nil, err
-		}
-		subs = append(subs, s)
+// activeQuery builds the query of ListActive.
+func activeQuery(tenantID string, filter entity.PeriodFilter) (string, []any) {
+	query := sqlbuilder.Select(subscriptionColumns...).From("subscriptions")
 
-		if !res.Next() {
-			break
-		}
+	and := []string{
+		query.EQ("tenant_id", tenantID),
+		query.LE("start_date", filter.To),
+		query.Or(query.IsNull("end_date"), query.GE("end_date", filter.From)),
 	}
 
-	if err := res.Err(); err != nil {
-		return nil, err
+	if filter.Title != nil {
+		and = append(and, query.EQ("title", *filter.Title))
+	}
+	if filter.ServiceID != nil {
+		and = append(and, query.EQ("service_id", *filter.ServiceID))
+	}
+	if filter.UserID != nil {
+		and = append(and, query.EQ("user_id", *filter.UserID))
 	}
 
-	return subs, nil
+	return query.Where(and...).OrderBy("start_date", "id").BuildWithFlavor(sqlbuilder.PostgreSQL)
 }
 
-func (r *Subscription) Sum(ctx context.Context, filter entity.ListSubscriptionFilter) (int64, error) {
-	query := sqlbuilder.Select("SUM(price)").From("subscriptions")
+// cancellationsQuery builds the query of CountCancellations.
+func cancellationsQuery(tenantID string, filter entity.CancellationFilter) (string, []any) {
+	query := sqlbuilder.Select("cancel_effective_date", "title", "cancel_reason", "COUNT(*)").From("subscriptions")
 
-	var and []string
+	and := []string{query.EQ("tenant_id", tenantID), query.IsNotNull("cancel_reason")}
 
 	if filter.Title != nil {
 		and = append(and, query.EQ("title", *filter.Title))
 	}
+	if filter.ServiceID != nil {
+		and = append(and, query.EQ("service_id", *filter.ServiceID))
+	}
 	if filter.UserID != nil {
 		and = append(and, query.EQ("user_id", *filter.UserID))
 	}
-	if filter.StartDate != nil {
-		and = append(and, query.GE("start_date", *filter.StartDate))
+	if filter.From != nil {
+		and = append(and, query.GE("cancel_effective_date", *filter.From))
 	}
-	if filter.EndDate != nil {
-		and = append(and, query.LE("end_date", *filter.EndDate))
+	if filter.To != nil {
+		and = append(and, query.LE("cancel_effective_date", *filter.To))
 	}
 
-	queryString, args := query.Where(and...).BuildWithFlavor(sqlbuilder.PostgreSQL)
+	return query.Where(and...).
+		GroupBy("cancel_effective_date", "title", "cancel_reason").
+		OrderBy("cancel_effective_date", "title", "cancel_reason").
+		BuildWithFlavor(sqlbuilder.PostgreSQL)
+}
 
-	var sum int64
-	err := r.pool.QueryRow(ctx, queryString, args...).Scan(&sum)
+// subscriptionColumns are the columns scanSubscription reads, in order.
+var subscriptionColumns = []string{
+	"id",
+	"title",
+	"service_id",
+	"price",
+	"user_id",
+	"start_date",
+	"end_date",
+	"phases",
+	"pauses",
+	"cancel_reason",
+	"cancel_note",
+	"cancel_effective_date",
+	"cancelled_at",
+	"renewal_term_months",
+	"created_at",
+	"updated_at",
+}
+
+func scanSubscription(row pgx.Row) (entity.Subscription, error) {
+	var (
+		s               entity.Subscription
+		phases, pauses  []byte
+		cancelReason    *string
+		cancellation    entity.Cancellation
+		cancelEffective *time.Time
+		cancelledAt     *int64
+		termMonths      *int
+	)
+
+	if err := row.Scan(
+		&s.ID, &s.Title, &s.ServiceID, &s.Price, &s.UserID, &s.StartDate, &s.EndDate, &phases, &pauses,
+		&cancelReason, &cancellation.Note, &cancelEffective, &cancelledAt, &termMonths,
+		&s.CreatedAt, &s.UpdatedAt,
+	); err != nil {
+		return entity.Subscription{}, err
+	}
+
+	if cancelReason != nil && cancelEffective != nil && cancelledAt != nil {
+		cancellation.Reason = *cancelReason
+		cancellation.EffectiveDate = *cancelEffective
+		cancellation.CancelledAt = *cancelledAt
+		s.Cancellation = &cancellation
+	}
+
+	if termMonths != nil {
+		s.Renewal = &entity.Renewal{TermMonths: *termMonths}
+	}
+
+	var err error
+	if s.Phases, err = decodePhases(phases); err != nil {
+		return entity.Subscription{}, err
+	}
+	s.Pauses, err = decodePauses(pauses)
+
+	return s, err
+}
+
+// phaseJSON is a phase in the phases column.
+type phaseJSON struct {
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	Price     int64  `json:"price"`
+}
+
+const dateLayout = "2006-01-02"
+
+func encodePhases(phases []entity.Phase) (string, error) {
+	rows := make([]phaseJSON, len(phases))
+	for i, p := range phases {
+		rows[i] = phaseJSON{
+			StartDate: p.StartDate.Format(dateLayout),
+			EndDate:   p.EndDate.Format(dateLayout),
+			Price:     p.Price,
+		}
+	}
+
+	data, err := json.Marshal(rows)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return 0, nil
+		return "", fmt.Errorf("encode phases: %w", err)
+	}
+
+	return string(data), nil
+}
+
+func decodePhases(data []byte) ([]entity.Phase, error) {
+	var rows []phaseJSON
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, fmt.Errorf("decode phases: %w", err)
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	phases := make([]entity.Phase, len(rows))
+	for i, row := range rows {
+		start, err := time.Parse(dateLayout, row.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("decode phases: %w", err)
 		}
-		return 0, err
+		end, err := time.Parse(dateLayout, row.EndDate)
+		if err != nil {
+			return nil, fmt.Errorf("decode phases: %w", err)
+		}
+
+		phases[i] = entity.Phase{StartDate: start, EndDate: end, Price: row.Price}
 	}
 
-	return sum, nil
+	return phases, nil
+}
+
+// pauseJSON is a pause in the pauses column.
+type pauseJSON struct {
+	StartDate string  `json:"start_date"`
+	EndDate   *string `json:"end_date"`
+}
+
+func encodePauses(pauses []entity.Pause) (string, error) {
+	rows := make([]pauseJSON, len(pauses))
+	for i, p := range pauses {
+		rows[i].StartDate = p.StartDate.Format(dateLayout)
+		if p.EndDate != nil {
+			end := p.EndDate.Format(dateLayout)
+			rows[i].EndDate = &end
+		}
+	}
+
+	data, err := json.Marshal(rows)
+	if err != nil {
+		return "", fmt.Errorf("encode pauses: %w", err)
+	}
+
+	return string(data), nil
+}
+
+func decodePauses(data []byte) ([]entity.Pause, error) {
+	var rows []pauseJSON
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, fmt.Errorf("decode pauses: %w", err)
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	pauses := make([]entity.Pause, len(rows))
+	for i, row := range rows {
+		start, err := time.Parse(dateLayout, row.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("decode pauses: %w", err)
+		}
+		pauses[i].StartDate = start
+
+		if row.EndDate != nil {
+			end, err := time.Parse(dateLayout, *row.EndDate)
+			if err != nil {
+				return nil, fmt.Errorf("decode pauses: %w", err)
+			}
+			pauses[i].EndDate = &end
+		}
+	}
+
+	return pauses, nil
 }
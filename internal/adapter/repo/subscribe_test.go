@@ -0,0 +1,109 @@
+package repo_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pressly/goose/v3"
+	"go.uber.org/zap"
+
+	"subscription-service/internal/adapter/db"
+	"subscription-service/internal/adapter/repo"
+	"subscription-service/internal/config"
+	"subscription-service/internal/port"
+	"subscription-service/internal/port/porttest"
+)
+
+// TestSubscriptionConformance runs against the database of TEST_DATABASE_URL, migrating
+// it first. Every subtest works in a new tenant, so the database may be reused.
+func TestSubscriptionConformance(t *testing.T) {
+	connStr := os.Getenv("TEST_DATABASE_URL")
+	if connStr == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	err := db.WithMigrations(ctx, connStr, func(provider *goose.Provider) error {
+		_, err := provider.Up(ctx)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := config.Default().DBConfig
+	cfg.ConnectionString = connStr
+
+	pool, err := db.NewPostgresPool(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Close()
+
+	subs, err := repo.NewSubscription(pool, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	porttest.SubscriptionRepo(t, subs)
+	porttest.RenewalRepo(t, subs)
+	porttest.ReminderRepo(t, subs)
+	porttest.BudgetRepo(t, subs)
+	porttest.ServiceRepo(t, subs)
+
+	analytics, err := repo.NewAnalytics(pool, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	porttest.AnalyticsRepo(t, subs, analytics)
+
+	idempotency, err := repo.NewIdempotency(pool, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	porttest.IdempotencyStore(t, idempotency)
+}
+
+func TestSubscriptionErrors(t *testing.T) {
+	subs, err := repo.NewSubscription(nil, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	failure := errors.New("connection reset")
+	checkViolation := &pgconn.PgError{Code: "23514"}
+	invalidRange := &pgconn.PgError{Code: "22000"}
+
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"taken id", &pgconn.PgError{Code: "23505"}, port.ErrSubscriptionAlreadyExists},
+		{"overlap", fmt.Errorf("exec: %w", &pgconn.PgError{Code: "23P01"}), port.ErrSubscriptionAlreadyExists},
+		{"end before the start", invalidRange, invalidRange},
+		{"unknown service", &pgconn.PgError{Code: "23503"}, port.ErrServiceNotFound},
+		{"other constraint", checkViolation, checkViolation},
+		{"not a database error", failure, failure},
+		{"no tenant", port.ErrTenantRequired, port.ErrTenantRequired},
+		{"no error", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := repo.SubscriptionError(subs, context.Background(), "insert subscription", tt.err)
+			if !errors.Is(got, tt.want) || (got == nil) != (tt.want == nil) {
+				t.Errorf("error = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,27 +2,31 @@ package app
 
 import (
 	"context"
-	"time"
+	"fmt"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
 	"subscription-service/internal/adapter/db"
+	"subscription-service/internal/adapter/jwtauth"
+	"subscription-service/internal/adapter/memory"
+	"subscription-service/internal/adapter/notify"
+	"subscription-service/internal/adapter/ratelimit"
 	"subscription-service/internal/adapter/repo"
 	"subscription-service/internal/app/usecase"
 	"subscription-service/internal/config"
 	handler "subscription-service/internal/controller/http"
-)
-
-const (
-	MaxOpenConns = 25
-	MaxIdleTime  = 10 * time.Minute
-	MaxLifetime  = 5 * time.Minute
+	"subscription-service/internal/port"
 )
 
 func Run(cfg *config.Config) {
 	loggerConfig := zap.NewProductionConfig()
-	loggerConfig.Level.SetLevel(zapcore.Level(cfg.Log))
+	loggerConfig.Level.SetLevel(zapcore.Level(cfg.Log.Level))
+	loggerConfig.Encoding = cfg.Log.Format
+	if cfg.Log.Format == config.LogFormatConsole {
+		loggerConfig.EncoderConfig = zap.NewDevelopmentEncoderConfig()
+	}
 	loggerConfig.EncoderConfig.EncodeTime = zapcore.RFC3339NanoTimeEncoder
 	logger, err := loggerConfig.Build(zap.AddStacktrace(zapcore.ErrorLevel), zap.AddCaller())
 	if err != nil {
@@ -37,22 +41,182 @@ func Run(cfg *config.Config) {
 		}
 	}()
 
-	pool, err := db.NewPostgresPool(context.Background(), cfg.DBConfig)
+	service, err := NewService(context.Background(), cfg, logger)
 	if err != nil {
 		panic(err)
 	}
 
-	subRepo, err := repo.NewSubscription(pool, logger.Named("subscription-repo"))
+	if cfg.Renewal.Interval > 0 {
+		go renewPeriodically(context.Background(), service.Renewal, cfg.Renewal.Interval, logger.Named("renewal"))
+	}
+
+	if cfg.Reminders.Interval > 0 {
+		go remindPeriodically(context.Background(), service.Reminder, cfg.Reminders.Interval, logger.Named("reminders"))
+	}
+
+	service.Server.Start(service.RouterOptions...)
+}
+
+// Service is the HTTP server with the router options the configuration asks for, and
+// the use cases of the background jobs, which share its storage.
+type Service struct {
+	Server        *handler.Server
+	RouterOptions []handler.RouterOption
+	Renewal       *usecase.Renewal
+	Reminder      *usecase.Reminder
+}
+
+// NewService wires the adapters of the configured storage and the use cases into the
+// HTTP server and the background jobs.
+func NewService(ctx context.Context, cfg *config.Config, logger *zap.Logger) (*Service, error) {
+	var (
+		err error
+
+		pool             *pgxpool.Pool
+		subRepo          port.SubscriptionRepo
+		renewalRepo      port.RenewalRepo
+		reminderRepo     port.ReminderRepo
+		budgetRepo       port.BudgetRepo
+		serviceRepo      port.ServiceRepo
+		analyticsRepo    port.AnalyticsRepo
+		apiKeyRepo       port.APIKeyRepo
+		idempotencyStore port.IdempotencyStore
+	)
+
+	switch cfg.Storage {
+	case config.StorageMemory:
+		logger.Warn("storage is in memory, data is lost on restart")
+
+		memorySubs := memory.NewSubscription()
+		subRepo = memorySubs
+		renewalRepo = memorySubs
+		reminderRepo = memorySubs
+		budgetRepo = memorySubs
+		serviceRepo = memorySubs
+		analyticsRepo = memorySubs
+		apiKeyRepo = memory.NewAPIKey()
+		idempotencyStore = memory.NewIdempotency()
+	case config.StoragePostgres:
+		pool, err = db.NewPostgresPool(ctx, cfg.DBConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		subs, err := repo.NewSubscription(pool, logger.Named("subscription-repo"))
+		if err != nil {
+			return nil, err
+		}
+		subRepo = subs
+		renewalRepo = subs
+		reminderRepo = subs
+		budgetRepo = subs
+		serviceRepo = subs
+
+		analyticsRepo, err = repo.NewAnalytics(pool, logger.Named("analytics-repo"))
+		if err != nil {
+			return nil, err
+		}
+
+		apiKeyRepo, err = repo.NewAPIKey(pool, logger.Named("api-key-repo"))
+		if err != nil {
+			return nil, err
+		}
+
+		idempotencyStore, err = repo.NewIdempotency(pool, logger.Named("idempotency-repo"))
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown storage %q", cfg.Storage)
+	}
+
+	subUsecase, err := usecase.NewSubscription(
+		subRepo, budgetRepo, serviceRepo, cfg.Cancellation.Reasons,
+		logger.Named("subscription-usecase"))
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
-	transactionController := repo.NewTransactionSQL(pool, logger.Named("transaction-ctrl"))
+	budgetUsecase, err := usecase.NewBudget(budgetRepo, subRepo, serviceRepo, logger.Named("budget-usecase"))
+	if err != nil {
+		return nil, err
+	}
 
-	subUsecase, err := usecase.NewSubscription(subRepo, transactionController, logger.Named("subscription-usecase"))
+	catalogUsecase, err := usecase.NewCatalog(serviceRepo, logger.Named("catalog-usecase"))
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+
+	analyticsUsecase, err := usecase.NewAnalytics(analyticsRepo, subRepo, serviceRepo, nil, logger.Named("analytics-usecase"))
+	if err != nil {
+		return nil, err
+	}
+
+	var tokenVerifier port.TokenVerifier
+	if cfg.Auth.JWT.Enabled() {
+		tokenVerifier, err = jwtauth.NewVerifier(cfg.Auth.JWT)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	authUsecase, err := usecase.NewAuth(apiKeyRepo, tokenVerifier, cfg.Auth.AdminAPIKey, logger.Named("auth-usecase"))
+	if err != nil {
+		return nil, err
+	}
+
+	var routerOpts []handler.RouterOption
+
+	switch cfg.RateLimit.Backend {
+	case config.RateLimitBackendMemory:
+		routerOpts = append(routerOpts, handler.WithRateLimit(ratelimit.NewMemory(), cfg.RateLimit))
+	case config.RateLimitBackendPostgres:
+		limiter, err := ratelimit.NewPostgres(pool, logger.Named("rate-limiter"))
+		if err != nil {
+			return nil, err
+		}
+		routerOpts = append(routerOpts, handler.WithRateLimit(limiter, cfg.RateLimit))
+	case config.RateLimitBackendOff:
+	default:
+		return nil, fmt.Errorf("unknown rate limit backend %q", cfg.RateLimit.Backend)
 	}
 
-	handler.NewServer(cfg.Address, subUsecase, pool, logger.Named("http")).Start()
+	routerOpts = append(routerOpts, handler.WithIdempotency(idempotencyStore, cfg.Idempotency))
+
+	renewalUsecase, err := usecase.NewRenewal(renewalRepo, nil, logger.Named("renewal-usecase"))
+	if err != nil {
+		return nil, err
+	}
+
+	notifier, err := newNotifier(cfg.Reminders, logger.Named("notifier"))
+	if err != nil {
+		return nil, err
+	}
+
+	reminderUsecase, err := usecase.NewReminder(reminderRepo, notifier, nil, logger.Named("reminder-usecase"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Service{
+		Server: handler.NewServer(
+			cfg.HTTP, subUsecase, authUsecase, reminderUsecase, budgetUsecase, analyticsUsecase, catalogUsecase,
+			pool, logger.Named("http")),
+		RouterOptions: routerOpts,
+		Renewal:       renewalUsecase,
+		Reminder:      reminderUsecase,
+	}, nil
+}
+
+func newNotifier(cfg config.RemindersConfig, logger *zap.Logger) (port.Notifier, error) {
+	switch cfg.Notifier {
+	case config.NotifierLog:
+		return notify.NewLog(logger), nil
+	case config.NotifierSMTP:
+		return notify.NewSMTP(cfg.SMTP)
+	case config.NotifierWebhook:
+		return notify.NewWebhook(cfg.Webhook), nil
+	default:
+		return nil, fmt.Errorf("unknown notifier %q", cfg.Notifier)
+	}
 }
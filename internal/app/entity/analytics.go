@@ -0,0 +1,83 @@
+package entity
+
+import "time"
+
+// MetricsFilter selects the months from From to To, both inclusive, and optionally the
+// subscriptions to one service.
+type MetricsFilter struct {
+	Title     *string
+	ServiceID *string
+	From      time.Time
+	To        time.Time
+}
+
+// MonthlyMetrics are the revenue and churn of a month across all users of a tenant.
+//
+// A user is a subscriber in a month when at least one of their subscriptions is billed
+// in it, and their MRR is the sum of the prices in effect, see Subscription.PriceAt.
+// Compared with the month before, a user who starts being a subscriber brings new MRR, one
+// who stops takes churned MRR, and one who stays with a higher or lower MRR makes up
+// expansion or contraction. So MRR is the MRR of the month before plus NewMRR and
+// ExpansionMRR, less ChurnedMRR and ContractionMRR.
+type MonthlyMetrics struct {
+	Month          time.Time
+	MRR            int64
+	NewMRR         int64
+	ChurnedMRR     int64
+	ExpansionMRR   int64
+	ContractionMRR int64
+	Subscribers    int64
+	// PreviousSubscribers are the subscribers of the month before.
+	PreviousSubscribers int64
+	NewSubscribers      int64
+	ChurnedSubscribers  int64
+}
+
+// ARR is the MRR of the month over a year.
+func (m MonthlyMetrics) ARR() int64 {
+	return m.MRR * 12
+}
+
+// LogoChurnRate is the share of the subscribers of the month before who are no longer
+// subscribers in the month, zero without any.
+func (m MonthlyMetrics) LogoChurnRate() float64 {
+	if m.PreviousSubscribers == 0 {
+		return 0
+	}
+
+	return float64(m.ChurnedSubscribers) / float64(m.PreviousSubscribers)
+}
+
+// CohortFilter selects the subscriptions starting from From to To, both inclusive, and
+// optionally those to one service.
+type CohortFilter struct {
+	Title     *string
+	ServiceID *string
+	From      time.Time
+	To        time.Time
+}
+
+// CohortReport is the retention of the cohorts of a period as of the month AsOf.
+type CohortReport struct {
+	AsOf    time.Time
+	Cohorts []Cohort
+}
+
+// Cohort is the subscriptions starting in a month.
+type Cohort struct {
+	Month time.Time
+	Size  int64
+	// Retained are the subscriptions of the cohort still running 1, 2, ... months after
+	// Month, up to the month of the report, so later cohorts have fewer of them.
+	Retained []int64
+}
+
+// Retention is the share of the cohort each of Retained is.
+func (c Cohort) Retention() []float64 {
+	shares := make([]float64, len(c.Retained))
+	for i, n := range c.Retained {
+		shares[i] = float64(n) / float64(c.Size)
+	}
+
+	return shares
+}
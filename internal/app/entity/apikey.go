@@ -0,0 +1,19 @@
+package entity
+
+type APIKey struct {
+	ID        string
+	Name      string
+	Prefix    string
+	UserID    *string
+	TenantID  *string
+	Role      Role
+	CreatedAt int64
+	RevokedAt *int64
+}
+
+type CreateAPIKeyRequest struct {
+	Name     string
+	UserID   *string
+	TenantID *string
+	Role     Role
+}
@@ -0,0 +1,151 @@
+package entity
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// Budget is a monthly spending limit of a user: on all of their subscriptions or, with a
+// Category, on the subscriptions to the services of the category.
+type Budget struct {
+	ID     string
+	UserID string
+	// Category is empty for the budget of all subscriptions of the user.
+	Category string
+	// Services are the titles of the subscriptions of the category, compared ignoring
+	// case.
+	Services []string
+	// ServiceIDs are the services of the catalog named in Services. They are resolved
+	// by the use cases rather than stored, and cover the subscriptions to the services
+	// under any title.
+	ServiceIDs []string
+	Limit      int64
+	// Strict budgets reject the changes of subscriptions that take a month over the
+	// limit; the others let them through with an alert.
+	Strict    bool
+	CreatedAt int64
+	UpdatedAt int64
+}
+
+// BudgetStatus is the spending against a budget in a month.
+type BudgetStatus struct {
+	Budget
+	Month time.Time
+	Cost  int64
+}
+
+// Exceeded reports whether the month costs more than the limit.
+func (s BudgetStatus) Exceeded() bool {
+	return s.Cost > s.Limit
+}
+
+// BudgetAlert is the first month a change of a subscription takes over a budget, with
+// the cost of that month after the change.
+type BudgetAlert struct {
+	BudgetID string
+	Category string
+	Month    time.Time
+	Limit    int64
+	Cost     int64
+}
+
+// Covers reports whether the budget applies to the subscription.
+func (b Budget) Covers(s Subscription) bool {
+	if b.Category == "" {
+		return true
+	}
+	if s.ServiceID != nil && slices.Contains(b.ServiceIDs, *s.ServiceID) {
+		return true
+	}
+
+	return slices.ContainsFunc(b.Services, func(service string) bool {
+		return strings.EqualFold(service, s.Title)
+	})
+}
+
+// CostAt returns the cost in month of the subscriptions the budget covers.
+func (b Budget) CostAt(subs []Subscription, month time.Time) int64 {
+	var cost int64
+	for _, s := range subs {
+		if !b.Covers(s) {
+			continue
+		}
+		if price, ok := s.PriceAt(month); ok {
+			cost += price
+		}
+	}
+
+	return cost
+}
+
+// Overspend finds the first month in which after, replacing before, takes the budget
+// over its limit: the month costs more than the limit and more than it did before. subs
+// are the other subscriptions of the user and before is nil for a new subscription.
+// Only the months after is billed in can cost more, and past the last change of any of
+// the subscriptions every month costs the same, so those are the months looked at.
+func (b Budget) Overspend(subs []Subscription, before *Subscription, after Subscription) (BudgetAlert, bool) {
+	if !b.Covers(after) {
+		return BudgetAlert{}, false
+	}
+
+	from := MonthStart(after.StartDate)
+
+	to := from
+	if after.EndDate != nil {
+		to = MonthStart(*after.EndDate)
+	} else {
+		for _, s := range append(slices.Clip(subs), after) {
+			to = laterMonth(to, s.lastChange())
+		}
+		if before != nil {
+			to = laterMonth(to, before.lastChange())
+		}
+	}
+
+	previous := subs
+	if before != nil {
+		previous = append(slices.Clip(subs), *before)
+	}
+	next := append(slices.Clip(subs), after)
+
+	for month := from; !month.After(to); month = AddMonths(month, 1) {
+		if _, billed := after.PriceAt(month); !billed {
+			continue
+		}
+
+		cost := b.CostAt(next, month)
+		if cost > b.Limit && cost > b.CostAt(previous, month) {
+			return BudgetAlert{BudgetID: b.ID, Category: b.Category, Month: month, Limit: b.Limit, Cost: cost}, true
+		}
+	}
+
+	return BudgetAlert{}, false
+}
+
+// lastChange returns the month from which the price of the subscription stays the same.
+func (s Subscription) lastChange() time.Time {
+	last := MonthStart(s.StartDate)
+	if s.EndDate != nil {
+		last = laterMonth(last, AddMonths(*s.EndDate, 1))
+	}
+	for _, p := range s.Phases {
+		last = laterMonth(last, AddMonths(p.EndDate, 1))
+	}
+	for _, p := range s.Pauses {
+		last = laterMonth(last, MonthStart(p.StartDate))
+		if p.EndDate != nil {
+			last = laterMonth(last, AddMonths(*p.EndDate, 1))
+		}
+	}
+
+	return last
+}
+
+func laterMonth(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+
+	return a
+}
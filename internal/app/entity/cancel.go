@@ -0,0 +1,75 @@
+package entity
+
+import "time"
+
+// Cancellation records why a subscription was cancelled and when it ends.
+type Cancellation struct {
+	Reason string
+	Note   string
+	// EffectiveDate is the first day of the last month of the subscription.
+	EffectiveDate time.Time
+	CancelledAt   int64
+}
+
+// CancelRequest ends the subscription with ID with EffectiveDate. A nil EffectiveDate
+// is the month of Now, or the first month of a subscription that starts later.
+type CancelRequest struct {
+	ID            string
+	EffectiveDate *time.Time
+	Reason        string
+	Note          string
+	Now           time.Time
+}
+
+// CancellationFilter selects the cancellations taking effect from From to To, both
+// inclusive; nil dates and filters don't restrict the result.
+type CancellationFilter struct {
+	Title     *string
+	ServiceID *string
+	UserID    *string
+	From      *time.Time
+	To        *time.Time
+}
+
+// CancellationCount is the number of cancellations of a service with a reason taking
+// effect in a month.
+type CancellationCount struct {
+	Month  time.Time
+	Title  string
+	Reason string
+	Count  int64
+}
+
+// EndAt returns the subscription ending with month. Phases and pauses after month are
+// dropped, and those running past it end with it.
+func (s Subscription) EndAt(month time.Time) Subscription {
+	month = MonthStart(month)
+	s.EndDate = &month
+
+	var phases []Phase
+	for _, p := range s.Phases {
+		if MonthStart(p.StartDate).After(month) {
+			continue
+		}
+		if MonthStart(p.EndDate).After(month) {
+			p.EndDate = month
+		}
+		phases = append(phases, p)
+	}
+	s.Phases = phases
+
+	var pauses []Pause
+	for _, p := range s.Pauses {
+		if MonthStart(p.StartDate).After(month) {
+			continue
+		}
+		if p.EndDate == nil || MonthStart(*p.EndDate).After(month) {
+			end := month
+			p.EndDate = &end
+		}
+		pauses = append(pauses, p)
+	}
+	s.Pauses = pauses
+
+	return s
+}
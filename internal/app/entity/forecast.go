@@ -0,0 +1,33 @@
+package entity
+
+import "time"
+
+// ForecastRequest asks for the projected cost of the months ahead.
+type ForecastRequest struct {
+	UserID *string
+	// Now is the moment of the forecast; its month is the first one projected.
+	Now    time.Time
+	Months int
+	// GrowthPercent is the yearly price rise assumed for the subscriptions without an end
+	// date, zero for none.
+	GrowthPercent int
+}
+
+// ForecastMonth is the projected cost of a month with the subscriptions that make it
+// up, the most expensive first.
+type ForecastMonth struct {
+	Month time.Time
+	Cost  int64
+	Items []ForecastItem
+}
+
+// ForecastItem is the projected price of a subscription in a month.
+type ForecastItem struct {
+	SubscriptionID string
+	Title          string
+	Price          int64
+	// Renewed is set on the months of terms that automatic renewals have not added yet.
+	Renewed bool
+	// Grown is set when Price includes the assumed growth.
+	Grown bool
+}
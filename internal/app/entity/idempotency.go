@@ -0,0 +1,8 @@
+package entity
+
+// IdempotentResponse is a response stored for replaying retries of the same request.
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
@@ -0,0 +1,72 @@
+package entity
+
+import "time"
+
+// Pause is a run of months a subscription is on hold and not billed. Dates are the first
+// days of months; EndDate is the last paused month, nil while the pause lasts until the
+// subscription is resumed.
+type Pause struct {
+	StartDate time.Time
+	EndDate   *time.Time
+}
+
+// Covers reports whether month is paused.
+func (p Pause) Covers(month time.Time) bool {
+	month = MonthStart(month)
+
+	return !month.Before(MonthStart(p.StartDate)) && (p.EndDate == nil || !month.After(MonthStart(*p.EndDate)))
+}
+
+// PauseRequest puts the subscription with ID on hold from StartDate until EndDate, or
+// until it is resumed when EndDate is nil.
+type PauseRequest struct {
+	ID        string
+	StartDate time.Time
+	EndDate   *time.Time
+	UpdatedAt int64
+}
+
+// ResumeRequest ends the pause of the subscription with ID in effect in Month, so that
+// the subscription is billed again from it.
+type ResumeRequest struct {
+	ID        string
+	Month     time.Time
+	UpdatedAt int64
+}
+
+// SubscriptionStatus is the state of a subscription in a month.
+type SubscriptionStatus string
+
+const (
+	StatusScheduled SubscriptionStatus = "scheduled"
+	StatusActive    SubscriptionStatus = "active"
+	StatusPaused    SubscriptionStatus = "paused"
+	StatusEnded     SubscriptionStatus = "ended"
+)
+
+// PausedIn reports whether the subscription is on hold in month.
+func (s Subscription) PausedIn(month time.Time) bool {
+	for _, p := range s.Pauses {
+		if p.Covers(month) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// StatusAt returns the state of the subscription in the month of now.
+func (s Subscription) StatusAt(now time.Time) SubscriptionStatus {
+	month := MonthStart(now)
+
+	switch {
+	case month.Before(MonthStart(s.StartDate)):
+		return StatusScheduled
+	case s.EndDate != nil && month.After(MonthStart(*s.EndDate)):
+		return StatusEnded
+	case s.PausedIn(month):
+		return StatusPaused
+	default:
+		return StatusActive
+	}
+}
@@ -0,0 +1,80 @@
+package entity
+
+import "time"
+
+// Phase is a period of a subscription with its own price, such as a free trial or an
+// introductory discount. Dates are the first days of months; both months are inclusive.
+type Phase struct {
+	StartDate time.Time
+	EndDate   time.Time
+	Price     int64
+}
+
+// MonthlyCost is the cost of the subscriptions active in a month.
+type MonthlyCost struct {
+	Month time.Time
+	Cost  int64
+}
+
+// MonthStart returns the first day of the month of t.
+func MonthStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// AddMonths moves the first day of a month by n months.
+func AddMonths(month time.Time, n int) time.Time {
+	return MonthStart(month).AddDate(0, n, 0)
+}
+
+// MonthsBetween counts the months from from to to, both inclusive; it is zero or negative
+// when to is before from.
+func MonthsBetween(from, to time.Time) int {
+	return (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month()) + 1
+}
+
+// Cost adds up the price of every month the subscription is billed in from from to to,
+// both inclusive, as PriceAt has it. A nil bound leaves that side to the dates of the
+// subscription, so an open-ended subscription costs nothing without to.
+func (s Subscription) Cost(from, to *time.Time) int64 {
+	start, end := MonthStart(s.StartDate), s.EndDate
+	if from != nil && MonthStart(*from).After(start) {
+		start = MonthStart(*from)
+	}
+	if to != nil && (end == nil || to.Before(*end)) {
+		end = to
+	}
+	if end == nil {
+		return 0
+	}
+
+	var cost int64
+	for month := start; !month.After(MonthStart(*end)); month = AddMonths(month, 1) {
+		if price, ok := s.PriceAt(month); ok {
+			cost += price
+		}
+	}
+
+	return cost
+}
+
+// PriceAt returns the price of the subscription in month and whether it is billed then:
+// it is not before the start, after the end or during a pause.
+func (s Subscription) PriceAt(month time.Time) (int64, bool) {
+	month = MonthStart(month)
+
+	if month.Before(MonthStart(s.StartDate)) || (s.EndDate != nil && month.After(MonthStart(*s.EndDate))) {
+		return 0, false
+	}
+
+	if s.PausedIn(month) {
+		return 0, false
+	}
+
+	for _, p := range s.Phases {
+		if !month.Before(MonthStart(p.StartDate)) && !month.After(MonthStart(p.EndDate)) {
+			return p.Price, true
+		}
+	}
+
+	return s.Price, true
+}
@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"math"
+	"time"
+)
+
+// RateLimit allows bursts of up to Burst requests and refills the bucket at Burst
+// requests per Period.
+type RateLimit struct {
+	Burst  int
+	Period time.Duration
+}
+
+// Rate returns the refill rate in tokens per second.
+func (l RateLimit) Rate() float64 {
+	return float64(l.Burst) / l.Period.Seconds()
+}
+
+// RateLimitDecision is the state of a bucket after a request was counted against it.
+type RateLimitDecision struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// RetryAfter is the time until the next request is allowed, zero if it already is.
+	RetryAfter time.Duration
+	// Reset is the time until the bucket is full again.
+	Reset time.Duration
+}
+
+// NewRateLimitDecision builds the decision for a bucket holding tokens after the request.
+func NewRateLimitDecision(limit RateLimit, allowed bool, tokens float64) RateLimitDecision {
+	rate := limit.Rate()
+
+	d := RateLimitDecision{
+		Allowed:   allowed,
+		Limit:     limit.Burst,
+		Remaining: max(int(tokens), 0),
+		Reset:     secondsToDuration((float64(limit.Burst) - tokens) / rate),
+	}
+
+	if tokens < 1 {
+		d.RetryAfter = secondsToDuration((1 - tokens) / rate)
+	}
+
+	return d
+}
+
+// secondsToDuration rounds up to whole milliseconds to hide floating point noise.
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(math.Ceil(seconds*1000-1e-6)) * time.Millisecond
+}
@@ -0,0 +1,112 @@
+package entity
+
+import "time"
+
+// ReminderKind is what a reminder warns about.
+type ReminderKind string
+
+const (
+	// ReminderRenewal warns that a subscription renews automatically; see Renewal.
+	ReminderRenewal ReminderKind = "renewal"
+	// ReminderEnd warns that a subscription ends.
+	ReminderEnd ReminderKind = "end"
+	// ReminderPriceChange warns that the price of a subscription changes, as a phase
+	// starts or ends.
+	ReminderPriceChange ReminderKind = "price_change"
+)
+
+// ReminderPreferences are the reminders a user wants: of the enabled kinds, DaysBefore
+// days ahead. Email is where the email channel sends them.
+type ReminderPreferences struct {
+	UserID       string
+	DaysBefore   int
+	Renewals     bool
+	Ends         bool
+	PriceChanges bool
+	Email        string
+	UpdatedAt    int64
+}
+
+// Wants reports whether the user asked for reminders of kind.
+func (p ReminderPreferences) Wants(kind ReminderKind) bool {
+	switch kind {
+	case ReminderRenewal:
+		return p.Renewals
+	case ReminderEnd:
+		return p.Ends
+	case ReminderPriceChange:
+		return p.PriceChanges
+	default:
+		return false
+	}
+}
+
+// Reminder is a warning about a change of a subscription taking effect on Date, the
+// first day of a month. Price is the price from then on, or the price renewed at.
+// A reminder is identified by its subscription, kind and date.
+type Reminder struct {
+	TenantID       string
+	SubscriptionID string
+	UserID         string
+	Email          string
+	ServiceName    string
+	Kind           ReminderKind
+	Date           time.Time
+	Price          int64
+}
+
+// ReminderTarget is a subscription with the preferences of its user, for the reminder
+// job that works across tenants.
+type ReminderTarget struct {
+	TenantID    string
+	Preferences ReminderPreferences
+	Subscription
+}
+
+// Reminders returns the reminders of the subscription the user of prefs wants at now:
+// those of changes after now and no more than prefs.DaysBefore days ahead.
+//
+// A subscription that renews automatically renews on the first day of its last month,
+// see RenewalDue; one that does not ends after its last month. The price changes where
+// two billed months in a row have different prices.
+func (s Subscription) Reminders(prefs ReminderPreferences, now time.Time) []Reminder {
+	horizon := now.AddDate(0, 0, prefs.DaysBefore)
+	upcoming := func(date time.Time) bool {
+		return date.After(now) && !date.After(horizon)
+	}
+
+	var reminders []Reminder
+	add := func(kind ReminderKind, date time.Time, price int64) {
+		if prefs.Wants(kind) && upcoming(date) {
+			reminders = append(reminders, Reminder{
+				SubscriptionID: s.ID,
+				UserID:         s.UserID,
+				Email:          prefs.Email,
+				ServiceName:    s.Title,
+				Kind:           kind,
+				Date:           date,
+				Price:          price,
+			})
+		}
+	}
+
+	if s.EndDate != nil {
+		if s.Renewal != nil && s.Cancellation == nil {
+			add(ReminderRenewal, MonthStart(*s.EndDate), s.Price)
+		} else {
+			add(ReminderEnd, AddMonths(*s.EndDate, 1), 0)
+		}
+	}
+
+	if prefs.PriceChanges {
+		for month := AddMonths(now, 1); !month.After(horizon); month = AddMonths(month, 1) {
+			previous, billedBefore := s.PriceAt(AddMonths(month, -1))
+			price, billed := s.PriceAt(month)
+			if billedBefore && billed && price != previous {
+				add(ReminderPriceChange, month, price)
+			}
+		}
+	}
+
+	return reminders
+}
@@ -0,0 +1,72 @@
+package entity
+
+import "time"
+
+// Renewal makes a subscription with an end date continue automatically: once the last
+// month of its term starts, the end date moves TermMonths later.
+type Renewal struct {
+	TermMonths int
+}
+
+// RenewalDue reports whether the subscription is to be renewed in the month of now: it
+// renews automatically, is not cancelled and its last month has started.
+func (s Subscription) RenewalDue(now time.Time) bool {
+	return s.Renewal != nil && s.Cancellation == nil && s.EndDate != nil &&
+		!MonthStart(now).Before(MonthStart(*s.EndDate))
+}
+
+// PeriodEnd returns the last month of the billing period that month is in: the month
+// itself for a subscription without an end date, the end date for one that doesn't
+// renew, and the last month of the renewal term for one that does, also when the end
+// date has already moved to the next term.
+func (s Subscription) PeriodEnd(month time.Time) time.Time {
+	month = MonthStart(month)
+	if s.EndDate == nil {
+		return month
+	}
+
+	end := MonthStart(*s.EndDate)
+	if s.Renewal == nil || s.Renewal.TermMonths < 1 {
+		return end
+	}
+
+	for previous := end.AddDate(0, -s.Renewal.TermMonths, 0); !previous.Before(month); {
+		end, previous = previous, previous.AddDate(0, -s.Renewal.TermMonths, 0)
+	}
+
+	return end
+}
+
+// EventType is the kind of a SubscriptionEvent.
+type EventType string
+
+const (
+	EventRenewed        EventType = "renewed"
+	EventBudgetExceeded EventType = "budget_exceeded"
+)
+
+// SubscriptionEvent is an entry in the history of a subscription. Renewal is set on
+// EventRenewed and BudgetAlert on EventBudgetExceeded.
+type SubscriptionEvent struct {
+	ID             string
+	SubscriptionID string
+	Type           EventType
+	Renewal        *RenewalEvent
+	BudgetAlert    *BudgetAlert
+	CreatedAt      int64
+}
+
+// RenewalEvent is a term added to a subscription: its end date moved from
+// PreviousEndDate to EndDate.
+type RenewalEvent struct {
+	PreviousEndDate time.Time
+	EndDate         time.Time
+	TermMonths      int
+}
+
+// TenantSubscription is a subscription with the tenant it belongs to, for the jobs that
+// work across tenants.
+type TenantSubscription struct {
+	TenantID string
+	Subscription
+}
@@ -0,0 +1,40 @@
+package entity
+
+import "strings"
+
+// DefaultCurrency is the currency of services that don't name one.
+const DefaultCurrency = "RUB"
+
+// Service is an entry of the service catalog of a tenant. Subscriptions refer to it by
+// ServiceID, and the titles they are created with resolve to it through its name and
+// aliases, compared ignoring case and surrounding spaces, see ServiceKey.
+type Service struct {
+	ID string
+	// Name is the canonical name, the title of the subscriptions created for it.
+	Name    string
+	Aliases []string
+	// Category is empty for services without one.
+	Category  string
+	VendorURL string
+	// DefaultPrice is the usual monthly price, nil when unknown.
+	DefaultPrice *int64
+	Currency     string
+	CreatedAt    int64
+	UpdatedAt    int64
+}
+
+// ServiceFilter selects the services of a category, or all of them when Category is nil.
+type ServiceFilter struct {
+	Category *string
+}
+
+// Names returns the name of the service followed by its aliases.
+func (s Service) Names() []string {
+	return append([]string{s.Name}, s.Aliases...)
+}
+
+// ServiceKey is the form in which the names and aliases of services, and the titles
+// resolved through them, are compared.
+func ServiceKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -3,31 +3,60 @@ package entity
 import "time"
 
 type Subscription struct {
-	ID        string
-	Title     string
+	ID    string
+	Title string
+	// ServiceID is the service of the catalog the subscription is to, if any; see
+	// Service.
+	ServiceID *string
 	Price     int64
 	UserID    string
 	StartDate time.Time
 	EndDate   *time.Time
-	CreatedAt int64
-	UpdatedAt int64
+	// Phases are the periods with their own price, in order; see Phase.
+	Phases []Phase
+	// Renewal is set on subscriptions that renew automatically; see Renewal.
+	Renewal *Renewal
+	// Pauses are the periods on hold, in order; see Pause.
+	Pauses []Pause
+	// Cancellation is set once the subscription is cancelled.
+	Cancellation *Cancellation
+	CreatedAt    int64
+	UpdatedAt    int64
 }
 
 type UpdateSubscriptionRequest struct {
-	ID        string
-	Title     string
+	ID    string
+	Title string
+	// ServiceID is the service of the catalog the subscription is to, if any; see
+	// Service.
+	ServiceID *string
 	Price     int64
 	UserID    string
 	StartDate time.Time
 	EndDate   *time.Time
-	CreatedAt int64
-	UpdatedAt int64
+	// Phases are the periods with their own price, in order; see Phase.
+	Phases []Phase
+	// Renewal is set on subscriptions that renew automatically; see Renewal.
+	Renewal *Renewal
+	// Pauses are left as they are by SubscriptionRepo.Update and Create; they change
+	// with SubscriptionRepo.UpdatePauses.
+	Pauses []Pause
+	// Cancellation is left as it is by SubscriptionRepo.Update and Create; it is set by
+	// SubscriptionRepo.Cancel.
+	Cancellation *Cancellation
+	CreatedAt    int64
+	UpdatedAt    int64
 }
 
 type CreateSubscriptionRequest UpdateSubscriptionRequest
 
+// NoPrice is the Price of a CreateSubscriptionRequest that takes the default price of
+// its service in the catalog.
+const NoPrice int64 = -1
+
 type ListSubscriptionFilter struct {
 	Title     *string
+	ServiceID *string
 	UserID    *string
 	Price     *int64
 	StartDate *time.Time
@@ -35,3 +64,13 @@ type ListSubscriptionFilter struct {
 	Limit     *int
 	Offset    *int
 }
+
+// PeriodFilter selects the subscriptions active in any month from From to To, both
+// inclusive.
+type PeriodFilter struct {
+	Title     *string
+	ServiceID *string
+	UserID    *string
+	From      time.Time
+	To        time.Time
+}
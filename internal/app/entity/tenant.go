@@ -0,0 +1,15 @@
+package entity
+
+import "context"
+
+type tenantKey struct{}
+
+// WithTenant stores the tenant (organization) the request acts within.
+func WithTenant(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenantID)
+}
+
+func TenantFromContext(ctx context.Context) (string, bool) {
+	tenantID, ok := ctx.Value(tenantKey{}).(string)
+	return tenantID, ok && tenantID != ""
+}
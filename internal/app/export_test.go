@@ -0,0 +1,5 @@
+package app
+
+type MigrationProvider = migrationProvider
+
+var RunMigration = runMigration
@@ -0,0 +1,112 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pressly/goose/v3"
+
+	"subscription-service/internal/adapter/db"
+	"subscription-service/internal/config"
+)
+
+// MigrateCommands lists the commands accepted by Migrate.
+var MigrateCommands = []string{"up", "down", "status", "version", "redo"}
+
+// Migrate runs a migration command against the configured database and reports the
+// outcome to out:
+//   - up applies all pending migrations;
+//   - down rolls back the latest applied migration;
+//   - redo rolls back the latest migration and applies it again;
+//   - status lists every migration with the time it was applied;
+//   - version prints the current version of the schema.
+func Migrate(ctx context.Context, cfg *config.Config, command string, out io.Writer) error {
+	if !slices.Contains(MigrateCommands, command) {
+		return fmt.Errorf("unknown migrate command %q, expected one of %v", command, MigrateCommands)
+	}
+
+	if cfg.Storage != config.StoragePostgres {
+		return fmt.Errorf("migrations apply to %q storage only, configured storage is %q",
+			config.StoragePostgres, cfg.Storage)
+	}
+
+	return db.WithMigrations(ctx, cfg.DBConfig.ConnectionString, func(provider *goose.Provider) error {
+		return runMigration(ctx, provider, command, out)
+	})
+}
+
+// migrationProvider is the part of goose.Provider the migrate commands use.
+type migrationProvider interface {
+	Up(ctx context.Context) ([]*goose.MigrationResult, error)
+	UpByOne(ctx context.Context) (*goose.MigrationResult, error)
+	Down(ctx context.Context) (*goose.MigrationResult, error)
+	Status(ctx context.Context) ([]*goose.MigrationStatus, error)
+	GetDBVersion(ctx context.Context) (int64, error)
+}
+
+func runMigration(ctx context.Context, provider migrationProvider, command string, out io.Writer) error {
+	switch command {
+	case "up":
+		results, err := provider.Up(ctx)
+		printResults(out, results...)
+		if err == nil && len(results) == 0 {
+			fmt.Fprintln(out, "no pending migrations")
+		}
+		return err
+	case "down":
+		result, err := provider.Down(ctx)
+		printResults(out, result)
+		return err
+	case "redo":
+		result, err := provider.Down(ctx)
+		printResults(out, result)
+		if err != nil {
+			return err
+		}
+		result, err = provider.UpByOne(ctx)
+		printResults(out, result)
+		return err
+	case "status":
+		statuses, err := provider.Status(ctx)
+		if err != nil {
+			return err
+		}
+		return printStatus(out, statuses)
+	case "version":
+		version, err := provider.GetDBVersion(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, version)
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command %q, expected one of %v", command, MigrateCommands)
+	}
+}
+
+func printResults(out io.Writer, results ...*goose.MigrationResult) {
+	for _, result := range results {
+		if result != nil {
+			fmt.Fprintln(out, result)
+		}
+	}
+}
+
+func printStatus(out io.Writer, statuses []*goose.MigrationStatus) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "APPLIED AT\tMIGRATION")
+
+	for _, status := range statuses {
+		appliedAt := "pending"
+		if status.State == goose.StateApplied {
+			appliedAt = status.AppliedAt.UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%s\n", appliedAt, status.Source.Path)
+	}
+
+	return w.Flush()
+}
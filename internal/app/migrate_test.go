@@ -0,0 +1,208 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pressly/goose/v3"
+
+	"subscription-service/internal/app"
+	"subscription-service/internal/config"
+)
+
+// fakeProvider records the calls of the migrate commands and fails the one named fail.
+type fakeProvider struct {
+	calls []string
+	fail  string
+}
+
+var (
+	_ app.MigrationProvider = (*fakeProvider)(nil)
+
+	errMigration = errors.New("migration failed")
+	applied      = time.Date(2026, time.October, 19, 11, 0, 0, 0, time.UTC)
+)
+
+func result(direction string) *goose.MigrationResult {
+	return &goose.MigrationResult{
+		Source:    &goose.Source{Type: goose.TypeSQL, Path: "20261019110000_tenants.sql", Version: 20261019110000},
+		Direction: direction,
+	}
+}
+
+func (p *fakeProvider) call(name string) error {
+	p.calls = append(p.calls, name)
+	if p.fail == name {
+		return errMigration
+	}
+
+	return nil
+}
+
+func (p *fakeProvider) Up(context.Context) ([]*goose.MigrationResult, error) {
+	if err := p.call("up"); err != nil {
+		return nil, err
+	}
+
+	return []*goose.MigrationResult{result("up")}, nil
+}
+
+func (p *fakeProvider) UpByOne(context.Context) (*goose.MigrationResult, error) {
+	if err := p.call("up by one"); err != nil {
+		return nil, err
+	}
+
+	return result("up"), nil
+}
+
+func (p *fakeProvider) Down(context.Context) (*goose.MigrationResult, error) {
+	if err := p.call("down"); err != nil {
+		return nil, err
+	}
+
+	return result("down"), nil
+}
+
+func (p *fakeProvider) Status(context.Context) ([]*goose.MigrationStatus, error) {
+	if err := p.call("status"); err != nil {
+		return nil, err
+	}
+
+	return []*goose.MigrationStatus{
+		{Source: &goose.Source{Path: "20250824202004_init.sql"}, State: goose.StateApplied, AppliedAt: applied},
+		{Source: &goose.Source{Path: "20261019110000_tenants.sql"}, State: goose.StatePending},
+	}, nil
+}
+
+func (p *fakeProvider) GetDBVersion(context.Context) (int64, error) {
+	if err := p.call("version"); err != nil {
+		return 0, err
+	}
+
+	return 20261019110000, nil
+}
+
+func TestRunMigration(t *testing.T) {
+	tests := []struct {
+		name      string
+		command   string
+		fail      string
+		wantCalls []string
+		wantOut   []string
+		wantErr   error
+	}{
+		{"up", "up", "", []string{"up"}, []string{"OK    up 20261019110000_tenants.sql"}, nil},
+		{"up fails", "up", "up", []string{"up"}, nil, errMigration},
+		{"down", "down", "", []string{"down"}, []string{"OK    down 20261019110000_tenants.sql"}, nil},
+		{"redo", "redo", "", []string{"down", "up by one"}, []string{"down 20261019110000", "up 20261019110000"}, nil},
+		{"redo stops when down fails", "redo", "down", []string{"down"}, nil, errMigration},
+		{"status", "status", "", []string{"status"},
+			[]string{"APPLIED AT            MIGRATION\n" +
+				"2026-10-19T11:00:00Z  20250824202004_init.sql\n" +
+				"pending               20261019110000_tenants.sql\n"}, nil},
+		{"version", "version", "", []string{"version"}, []string{"20261019110000\n"}, nil},
+		{"version fails", "version", "version", []string{"version"}, nil, errMigration},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &fakeProvider{fail: tt.fail}
+			var out bytes.Buffer
+
+			err := app.RunMigration(context.Background(), provider, tt.command, &out)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("run %s: %v, want %v", tt.command, err, tt.wantErr)
+			}
+			if !slices.Equal(provider.calls, tt.wantCalls) {
+				t.Errorf("run %s called %v, want %v", tt.command, provider.calls, tt.wantCalls)
+			}
+			for _, want := range tt.wantOut {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("run %s printed %q, want it to contain %q", tt.command, out.String(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestMigrateRejects(t *testing.T) {
+	postgres := config.Default()
+	postgres.Storage = config.StoragePostgres
+	// Nothing listens there: the rejections come before a connection is made.
+	postgres.DBConfig.ConnectionString = "postgres://localhost:1/none?connect_timeout=1"
+
+	memory := config.Default()
+	memory.Storage = config.StorageMemory
+
+	tests := []struct {
+		name    string
+		cfg     *config.Config
+		command string
+		want    string
+	}{
+		{"unknown command", &postgres, "sideways", `unknown migrate command "sideways"`},
+		{"create", &postgres, "create", `unknown migrate command "create"`},
+		{"memory storage", &memory, "up", `migrations apply to "postgres" storage only`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+
+			err := app.Migrate(context.Background(), tt.cfg, tt.command, &out)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("migrate %s: %v, want %q", tt.command, err, tt.want)
+			}
+			if out.Len() > 0 {
+				t.Errorf("migrate %s printed %q", tt.command, out.String())
+			}
+		})
+	}
+}
+
+// TestMigrateProvider runs the commands that don't roll back against the database of
+// TEST_DATABASE_URL, which the other tests share.
+func TestMigrateProvider(t *testing.T) {
+	connStr := os.Getenv("TEST_DATABASE_URL")
+	if connStr == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cfg := config.Default()
+	cfg.Storage = config.StoragePostgres
+	cfg.DBConfig.ConnectionString = connStr
+
+	if err := app.Migrate(ctx, &cfg, "up", &bytes.Buffer{}); err != nil {
+		t.Fatalf("migrate up: %v", err)
+	}
+	var up bytes.Buffer
+	if err := app.Migrate(ctx, &cfg, "up", &up); err != nil || up.String() != "no pending migrations\n" {
+		t.Errorf("migrate up again: %v, printed %q, want no pending migrations", err, up.String())
+	}
+
+	var status bytes.Buffer
+	if err := app.Migrate(ctx, &cfg, "status", &status); err != nil {
+		t.Fatalf("migrate status: %v", err)
+	}
+	if !strings.Contains(status.String(), "20261019110000_tenants.sql") || strings.Contains(status.String(), "pending") {
+		t.Errorf("status after up = %q, want every migration applied", status.String())
+	}
+
+	var version bytes.Buffer
+	if err := app.Migrate(ctx, &cfg, "version", &version); err != nil {
+		t.Fatalf("migrate version: %v", err)
+	}
+	if v, err := strconv.ParseInt(strings.TrimSpace(version.String()), 10, 64); err != nil || v < 20261019110000 {
+		t.Errorf("version after up = %q, want the latest migration", version.String())
+	}
+}
@@ -0,0 +1,32 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/usecase"
+)
+
+// remindPeriodically sends the due reminders right away and then every interval until
+// ctx is done. Failed reminders are logged and sent again on the next run.
+func remindPeriodically(ctx context.Context, reminder usecase.ReminderUseCase, interval time.Duration, logger *zap.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		sent, err := reminder.SendDue(ctx)
+		if err != nil {
+			logger.Error("send reminders", zap.Int("sent", sent), zap.Error(err))
+		} else if sent > 0 {
+			logger.Info("sent reminders", zap.Int("sent", sent))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
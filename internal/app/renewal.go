@@ -0,0 +1,32 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/usecase"
+)
+
+// renewPeriodically renews the due subscriptions right away and then every interval
+// until ctx is done. Failures are logged and retried on the next run.
+func renewPeriodically(ctx context.Context, renewal usecase.RenewalUseCase, interval time.Duration, logger *zap.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		renewed, err := renewal.RenewDue(ctx)
+		if err != nil {
+			logger.Error("renew subscriptions", zap.Int("renewed", renewed), zap.Error(err))
+		} else if renewed > 0 {
+			logger.Info("renewed subscriptions", zap.Int("renewed", renewed))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+var _ AnalyticsUseCase = (*Analytics)(nil)
+
+// Analytics reports business metrics over all subscriptions of a tenant.
+type Analytics struct {
+	analyticsRepo    port.AnalyticsRepo
+	subscriptionRepo port.SubscriptionRepo
+	serviceRepo      port.ServiceRepo
+	now              func() time.Time
+	logger           *zap.Logger
+}
+
+// NewAnalytics returns the analytics use case reporting cohorts as of the time from now,
+// or from the system clock when now is nil. Filters by service resolve the names of the
+// catalog of serviceRepo, if any.
+func NewAnalytics(
+	analyticsRepo port.AnalyticsRepo,
+	subscriptionRepo port.SubscriptionRepo,
+	serviceRepo port.ServiceRepo,
+	now func() time.Time,
+	logger *zap.Logger,
+) (*Analytics, error) {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &Analytics{
+		analyticsRepo:    analyticsRepo,
+		subscriptionRepo: subscriptionRepo,
+		serviceRepo:      serviceRepo,
+		now:              now,
+		logger:           logger,
+	}, nil
+}
+
+// Metrics returns the revenue and churn of every month of the filter. They span all users
+// of the tenant, so only admins may see them.
+func (r *Analytics) Metrics(ctx context.Context, filter entity.MetricsFilter) ([]entity.MonthlyMetrics, error) {
+	principal, err := tenantPrincipalFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !principal.IsAdmin() {
+		return nil, ErrForbidden
+	}
+
+	filter.From, filter.To = entity.MonthStart(filter.From), entity.MonthStart(filter.To)
+
+	if filter.Title, filter.ServiceID, err = resolveTitle(ctx, r.serviceRepo, filter.Title); err != nil {
+		return nil, err
+	}
+
+	metrics, err := r.analyticsRepo.MonthlyMetrics(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute metrics: %w", err)
+	}
+
+	return metrics, nil
+}
@@ -0,0 +1,62 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	repo "subscription-service/internal/adapter/repo/mock"
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/app/usecase"
+)
+
+func TestMetricsAreForAdmins(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	analyticsRepo := repo.NewMockAnalyticsRepo(ctrl)
+
+	analyticsUsecase, err := usecase.NewAnalytics(analyticsRepo, nil, nil, nil, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filter := entity.MetricsFilter{
+		From: month(2025, time.January).Add(48 * time.Hour),
+		To:   month(2025, time.March).Add(48 * time.Hour),
+	}
+
+	if _, err := analyticsUsecase.Metrics(userContext(ownerID), filter); !errors.Is(err, usecase.ErrForbidden) {
+		t.Errorf("metrics for a user: %v, want %v", err, usecase.ErrForbidden)
+	}
+
+	ctx := adminContext()
+	want := []entity.MonthlyMetrics{{Month: month(2025, time.January), MRR: 400}}
+	analyticsRepo.EXPECT().MonthlyMetrics(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, got entity.MetricsFilter) ([]entity.MonthlyMetrics, error) {
+			if !got.From.Equal(month(2025, time.January)) || !got.To.Equal(month(2025, time.March)) {
+				t.Errorf("filter = %s to %s, want 01-2025 to 03-2025", got.From, got.To)
+			}
+			return want, nil
+		})
+
+	metrics, err := analyticsUsecase.Metrics(ctx, filter)
+	if err != nil || len(metrics) != 1 || metrics[0] != want[0] {
+		t.Errorf("metrics = %+v, %v, want %+v", metrics, err, want)
+	}
+}
+
+func TestMonthlyMetricsRates(t *testing.T) {
+	m := entity.MonthlyMetrics{MRR: 650, PreviousSubscribers: 4, ChurnedSubscribers: 1}
+	if m.ARR() != 7800 || m.LogoChurnRate() != 0.25 {
+		t.Errorf("ARR = %d, logo churn rate = %v, want 7800 and 0.25", m.ARR(), m.LogoChurnRate())
+	}
+
+	if rate := (entity.MonthlyMetrics{}).LogoChurnRate(); rate != 0 {
+		t.Errorf("logo churn rate without subscribers = %v, want 0", rate)
+	}
+}
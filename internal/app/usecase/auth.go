@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/pkg/logctx"
+	"subscription-service/internal/port"
+)
+
+const (
+	apiKeyScheme      = "sk_"
+	apiKeyRandomBytes = 32
+	apiKeyPrefixLen   = len(apiKeyScheme) + 8
+
+	bootstrapKeySubject = "api_key:bootstrap"
+)
+
+var _ AuthUseCase = (*Auth)(nil)
+
+type Auth struct {
+	apiKeyRepo       port.APIKeyRepo
+	tokenVerifier    port.TokenVerifier
+	bootstrapKeyHash []byte
+	logger           *zap.Logger
+}
+
+// NewAuth creates the authentication use case. tokenVerifier may be nil when bearer
+// tokens are not configured. bootstrapAdminKey is an optional static admin key used
+// to create the first API keys.
+func NewAuth(
+	apiKeyRepo port.APIKeyRepo,
+	tokenVerifier port.TokenVerifier,
+	bootstrapAdminKey string,
+	logger *zap.Logger,
+) (*Auth, error) {
+	var bootstrapKeyHash []byte
+	if bootstrapAdminKey != "" {
+		bootstrapKeyHash = hashAPIKey(bootstrapAdminKey)
+	}
+
+	return &Auth{
+		apiKeyRepo:       apiKeyRepo,
+		tokenVerifier:    tokenVerifier,
+		bootstrapKeyHash: bootstrapKeyHash,
+		logger:           logger,
+	}, nil
+}
+
+func (r *Auth) AuthenticateAPIKey(ctx context.Context, key string) (entity.Principal, error) {
+	hash := hashAPIKey(key)
+
+	if r.bootstrapKeyHash != nil && subtle.ConstantTimeCompare(hash, r.bootstrapKeyHash) == 1 {
+		return entity.Principal{
+			Subject: bootstrapKeySubject,
+			Roles:   []entity.Role{entity.RoleAdmin},
+			Method:  entity.AuthMethodAPIKey,
+		}, nil
+	}
+
+	apiKey, err := r.apiKeyRepo.GetByHash(ctx, hash)
+	if err != nil {
+		if errors.Is(err, port.ErrNotFound) {
+			return entity.Principal{}, ErrUnauthenticated
+		}
+		return entity.Principal{}, fmt.Errorf("get api key: %w", err)
+	}
+
+	if apiKey.RevokedAt != nil {
+		logctx.Logger(ctx, r.logger).Info("revoked api key used", zap.String("api_key_id", apiKey.ID))
+		return entity.Principal{}, ErrUnauthenticated
+	}
+
+	principal := entity.Principal{
+		Subject: "api_key:" + apiKey.ID,
+		Roles:   []entity.Role{apiKey.Role},
+		Method:  entity.AuthMethodAPIKey,
+	}
+	if apiKey.UserID != nil {
+		principal.UserID = *apiKey.UserID
+	}
+	if apiKey.TenantID != nil {
+		principal.TenantID = *apiKey.TenantID
+	}
+
+	return principal, nil
+}
+
+func (r *Auth) AuthenticateToken(ctx context.Context, token string) (entity.Principal, error) {
+	if r.tokenVerifier == nil {
+		return entity.Principal{}, ErrUnauthenticated
+	}
+
+	principal, err := r.tokenVerifier.Verify(ctx, token)
+	if err != nil {
+		logctx.Logger(ctx, r.logger).Debug("verify bearer token", zap.Error(err))
+		return entity.Principal{}, ErrUnauthenticated
+	}
+
+	return principal, nil
+}
+
+// CreateAPIKey stores a new key and returns it together with its plaintext value.
+// The plaintext is never stored and can't be recovered later.
+func (r *Auth) CreateAPIKey(
+	ctx context.Context,
+	req entity.CreateAPIKeyRequest,
+) (*entity.APIKey, string, error) {
+	principal, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Tenant admins can only issue keys for their own tenant.
+	if scope := tenantScope(principal); scope != nil {
+		if req.TenantID != nil && *req.TenantID != *scope {
+			return nil, "", ErrForbidden
+		}
+		req.TenantID = scope
+	}
+
+	if req.Name == "" {
+		return nil, "", fmt.Errorf("%w: name is required", ErrInvalidAPIKeyData)
+	}
+
+	switch req.Role {
+	case entity.RoleUser:
+		if req.UserID == nil {
+			return nil, "", fmt.Errorf("%w: user keys must be bound to a user", ErrInvalidAPIKeyData)
+		}
+		if req.TenantID == nil {
+			return nil, "", fmt.Errorf("%w: user keys must be bound to a tenant", ErrInvalidAPIKeyData)
+		}
+	case entity.RoleAdmin:
+	default:
+		return nil, "", fmt.Errorf("%w: unknown role %q", ErrInvalidAPIKeyData, req.Role)
+	}
+
+	plaintext, err := generateAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	apiKey := entity.APIKey{
+		ID:        uuid.NewString(),
+		Name:      req.Name,
+		Prefix:    plaintext[:apiKeyPrefixLen],
+		UserID:    req.UserID,
+		TenantID:  req.TenantID,
+		Role:      req.Role,
+		CreatedAt: time.Now().UnixMilli(),
+	}
+
+	if err = r.apiKeyRepo.Create(ctx, apiKey, hashAPIKey(plaintext)); err != nil {
+		return nil, "", fmt.Errorf("create api key: %w", err)
+	}
+
+	return &apiKey, plaintext, nil
+}
+
+func (r *Auth) ListAPIKeys(ctx context.Context) ([]entity.APIKey, error) {
+	principal, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := r.apiKeyRepo.List(ctx, tenantScope(principal))
+	if err != nil {
+		return nil, fmt.Errorf("list api keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+func (r *Auth) RevokeAPIKey(ctx context.Context, id string) error {
+	principal, err := requireAdmin(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = r.apiKeyRepo.Revoke(ctx, id, tenantScope(principal), time.Now().UnixMilli())
+	if err != nil {
+		if errors.Is(err, port.ErrNotFound) {
+			return ErrAPIKeyNotFound
+		}
+		return fmt.Errorf("revoke api key: %w", err)
+	}
+
+	return nil
+}
+
+func generateAPIKey() (string, error) {
+	buf := make([]byte, apiKeyRandomBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate api key: %w", err)
+	}
+
+	return apiKeyScheme + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashAPIKey uses a plain SHA-256: keys are long random strings, so a slow password
+// hash would add latency to every request without adding security.
+func hashAPIKey(key string) []byte {
+	sum := sha256.Sum256([]byte(key))
+	return sum[:]
+}
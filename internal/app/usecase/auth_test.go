@@ -0,0 +1,214 @@
+package usecase_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	repo "subscription-service/internal/adapter/repo/mock"
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/app/usecase"
+	pkg "subscription-service/internal/pkg/utils"
+	"subscription-service/internal/port"
+)
+
+const testTenantID = "6b3f1c1e-4a53-4c59-a1f4-0f0e5d2b8a11"
+
+func adminContext() context.Context {
+	return entity.WithPrincipal(entity.WithTenant(context.Background(), testTenantID), entity.Principal{
+		Subject: "api_key:admin",
+		Roles:   []entity.Role{entity.RoleAdmin},
+		Method:  entity.AuthMethodAPIKey,
+	})
+}
+
+func TestCreateAPIKeyStoresOnlyHash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyRepo := repo.NewMockAPIKeyRepo(ctrl)
+
+	authUsecase, err := usecase.NewAuth(apiKeyRepo, nil, "", zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := adminContext()
+
+	var storedHash []byte
+	apiKeyRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ entity.APIKey, hash []byte) error {
+			storedHash = hash
+			return nil
+		})
+
+	key, plaintext, err := authUsecase.CreateAPIKey(ctx, entity.CreateAPIKeyRequest{
+		Name:     "exporter",
+		UserID:   pkg.PointerTo(uuid.NewString()),
+		TenantID: pkg.PointerTo(testTenantID),
+		Role:     entity.RoleUser,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(plaintext, key.Prefix) {
+		t.Errorf("expected key %q to start with prefix %q", plaintext, key.Prefix)
+	}
+
+	sum := sha256.Sum256([]byte(plaintext))
+	if string(storedHash) != string(sum[:]) {
+		t.Error("expected the sha256 of the key to be stored")
+	}
+}
+
+func TestCreateAPIKeyRequiresAdmin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authUsecase, err := usecase.NewAuth(repo.NewMockAPIKeyRepo(ctrl), nil, "", zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := entity.CreateAPIKeyRequest{Name: "exporter", Role: entity.RoleAdmin}
+
+	_, _, err = authUsecase.CreateAPIKey(context.Background(), req)
+	if !errors.Is(err, usecase.ErrUnauthenticated) {
+		t.Errorf("expected ErrUnauthenticated, got %v", err)
+	}
+
+	userCtx := entity.WithPrincipal(context.Background(), entity.Principal{Roles: []entity.Role{entity.RoleUser}})
+
+	_, _, err = authUsecase.CreateAPIKey(userCtx, req)
+	if !errors.Is(err, usecase.ErrForbidden) {
+		t.Errorf("expected ErrForbidden, got %v", err)
+	}
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyRepo := repo.NewMockAPIKeyRepo(ctrl)
+
+	authUsecase, err := usecase.NewAuth(apiKeyRepo, nil, "bootstrap", zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	userID := uuid.NewString()
+
+	apiKeyRepo.EXPECT().GetByHash(ctx, gomock.Any()).Return(&entity.APIKey{
+		ID:     "active",
+		UserID: &userID,
+		Role:   entity.RoleUser,
+	}, nil)
+
+	principal, err := authUsecase.AuthenticateAPIKey(ctx, "sk_active")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if principal.UserID != userID || principal.IsAdmin() {
+		t.Errorf("unexpected principal %+v", principal)
+	}
+
+	apiKeyRepo.EXPECT().GetByHash(ctx, gomock.Any()).Return(&entity.APIKey{
+		ID:        "revoked",
+		Role:      entity.RoleAdmin,
+		RevokedAt: pkg.PointerTo(int64(1)),
+	}, nil)
+
+	if _, err = authUsecase.AuthenticateAPIKey(ctx, "sk_revoked"); !errors.Is(err, usecase.ErrUnauthenticated) {
+		t.Errorf("expected revoked key to be rejected, got %v", err)
+	}
+
+	apiKeyRepo.EXPECT().GetByHash(ctx, gomock.Any()).Return(nil, port.ErrNotFound)
+
+	if _, err = authUsecase.AuthenticateAPIKey(ctx, "sk_unknown"); !errors.Is(err, usecase.ErrUnauthenticated) {
+		t.Errorf("expected unknown key to be rejected, got %v", err)
+	}
+
+	principal, err = authUsecase.AuthenticateAPIKey(ctx, "bootstrap")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !principal.IsAdmin() {
+		t.Errorf("expected bootstrap key to grant admin, got %+v", principal)
+	}
+}
+
+func TestRevokeUnknownAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyRepo := repo.NewMockAPIKeyRepo(ctrl)
+
+	authUsecase, err := usecase.NewAuth(apiKeyRepo, nil, "", zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := adminContext()
+	id := uuid.NewString()
+
+	apiKeyRepo.EXPECT().Revoke(ctx, id, gomock.Nil(), gomock.Any()).Return(port.ErrNotFound)
+
+	if err = authUsecase.RevokeAPIKey(ctx, id); !errors.Is(err, usecase.ErrAPIKeyNotFound) {
+		t.Errorf("expected ErrAPIKeyNotFound, got %v", err)
+	}
+}
+
+func TestTenantAdminIsConfinedToTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyRepo := repo.NewMockAPIKeyRepo(ctrl)
+
+	authUsecase, err := usecase.NewAuth(apiKeyRepo, nil, "", zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := entity.WithPrincipal(context.Background(), entity.Principal{
+		Subject:  "api_key:tenant-admin",
+		TenantID: testTenantID,
+		Roles:    []entity.Role{entity.RoleAdmin},
+	})
+
+	_, _, err = authUsecase.CreateAPIKey(ctx, entity.CreateAPIKeyRequest{
+		Name:     "exporter",
+		TenantID: pkg.PointerTo(uuid.NewString()),
+		Role:     entity.RoleAdmin,
+	})
+	if !errors.Is(err, usecase.ErrForbidden) {
+		t.Errorf("expected key for another tenant to be forbidden, got %v", err)
+	}
+
+	apiKeyRepo.EXPECT().Create(ctx, gomock.Any(), gomock.Any()).Return(nil)
+
+	key, _, err := authUsecase.CreateAPIKey(ctx, entity.CreateAPIKeyRequest{
+		Name:   "exporter",
+		UserID: pkg.PointerTo(uuid.NewString()),
+		Role:   entity.RoleUser,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.TenantID == nil || *key.TenantID != testTenantID {
+		t.Errorf("expected key to inherit the admin tenant, got %v", key.TenantID)
+	}
+
+	apiKeyRepo.EXPECT().List(ctx, pkg.PointerTo(testTenantID)).Return(nil, nil)
+
+	if _, err = authUsecase.ListAPIKeys(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
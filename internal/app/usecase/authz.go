@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+
+	"subscription-service/internal/app/entity"
+)
+
+// principalFrom returns the caller of the use case. Every use case call must be made on
+// behalf of an authenticated principal.
+func principalFrom(ctx context.Context) (entity.Principal, error) {
+	principal, ok := entity.PrincipalFromContext(ctx)
+	if !ok {
+		return entity.Principal{}, ErrUnauthenticated
+	}
+
+	// A regular user without a user id would otherwise match nothing or, worse,
+	// everything once filters are built from it.
+	if !principal.IsAdmin() && principal.UserID == "" {
+		return entity.Principal{}, ErrForbidden
+	}
+
+	return principal, nil
+}
+
+// tenantPrincipalFrom is principalFrom for operations on tenant data: the request must
+// also be bound to a tenant.
+func tenantPrincipalFrom(ctx context.Context) (entity.Principal, error) {
+	principal, err := principalFrom(ctx)
+	if err != nil {
+		return entity.Principal{}, err
+	}
+
+	if _, ok := entity.TenantFromContext(ctx); !ok {
+		return entity.Principal{}, ErrTenantRequired
+	}
+
+	return principal, nil
+}
+
+func requireAdmin(ctx context.Context) (entity.Principal, error) {
+	principal, err := principalFrom(ctx)
+	if err != nil {
+		return entity.Principal{}, err
+	}
+	if !principal.IsAdmin() {
+		return entity.Principal{}, ErrForbidden
+	}
+
+	return principal, nil
+}
+
+// tenantScope returns the tenant an admin is confined to, or nil for platform admins.
+func tenantScope(principal entity.Principal) *string {
+	if principal.TenantID == "" {
+		return nil
+	}
+
+	return &principal.TenantID
+}
+
+// canAccess reports whether the principal may act on records of userID.
+func canAccess(principal entity.Principal, userID string) bool {
+	return principal.IsAdmin() || principal.UserID == userID
+}
+
+// scopeFilter restricts a filter to the principal's own records unless it is an admin.
+func scopeFilter(principal entity.Principal, filter entity.ListSubscriptionFilter) entity.ListSubscriptionFilter {
+	if !principal.IsAdmin() {
+		filter.UserID = &principal.UserID
+	}
+
+	return filter
+}
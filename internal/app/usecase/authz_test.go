@@ -0,0 +1,210 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	repo "subscription-service/internal/adapter/repo/mock"
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/app/usecase"
+	"subscription-service/internal/port"
+)
+
+var (
+	ownerID = uuid.NewString()
+	otherID = uuid.NewString()
+)
+
+func userContext(userID string) context.Context {
+	return entity.WithPrincipal(entity.WithTenant(context.Background(), testTenantID), entity.Principal{
+		Subject:  "api_key:" + userID,
+		UserID:   userID,
+		TenantID: testTenantID,
+		Roles:    []entity.Role{entity.RoleUser},
+		Method:   entity.AuthMethodAPIKey,
+	})
+}
+
+type authzMocks struct {
+	subscriptionRepo *repo.MockSubscriptionRepo
+}
+
+func newAuthzUsecase(t *testing.T) (*usecase.Subscription, authzMocks) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mocks := authzMocks{subscriptionRepo: repo.NewMockSubscriptionRepo(ctrl)}
+
+	subscriptionUsecase, err := usecase.NewSubscription(
+		mocks.subscriptionRepo, nil, nil, []string{"too_expensive", "other"}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return subscriptionUsecase, mocks
+}
+
+func TestListAndSumAreScopedToOwner(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		filterUser *string
+		wantUser   *string
+		wantErr    error
+	}{
+		{"user without filter", userContext(ownerID), nil, &ownerID, nil},
+		{"user filtering by another user", userContext(ownerID), &otherID, &ownerID, nil},
+		{"admin without filter", adminContext(), nil, nil, nil},
+		{"admin filtering by user", adminContext(), &otherID, &otherID, nil},
+		{"anonymous", context.Background(), nil, nil, usecase.ErrUnauthenticated},
+		{"user without user id", userContext(""), nil, nil, usecase.ErrForbidden},
+		{"without tenant", entity.WithPrincipal(context.Background(), entity.Principal{
+			Roles: []entity.Role{entity.RoleAdmin},
+		}), nil, nil, usecase.ErrTenantRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscriptionUsecase, mocks := newAuthzUsecase(t)
+
+			checkFilter := func(_ context.Context, filter entity.ListSubscriptionFilter) {
+				if (filter.UserID == nil) != (tt.wantUser == nil) ||
+					(filter.UserID != nil && *filter.UserID != *tt.wantUser) {
+					t.Errorf("expected user filter %v, got %v", tt.wantUser, filter.UserID)
+				}
+			}
+
+			if tt.wantErr == nil {
+				mocks.subscriptionRepo.EXPECT().List(tt.ctx, gomock.Any()).Do(checkFilter).Return(nil, nil)
+				mocks.subscriptionRepo.EXPECT().Sum(tt.ctx, gomock.Any()).Do(checkFilter).Return(int64(0), nil)
+			}
+
+			filter := entity.ListSubscriptionFilter{UserID: tt.filterUser}
+
+			if _, err := subscriptionUsecase.List(tt.ctx, filter); !errors.Is(err, tt.wantErr) {
+				t.Errorf("list: expected error %v, got %v", tt.wantErr, err)
+			}
+			if _, err := subscriptionUsecase.Sum(tt.ctx, filter); !errors.Is(err, tt.wantErr) {
+				t.Errorf("sum: expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestOwnershipOfSingleSubscription(t *testing.T) {
+	subscriptionID := uuid.NewString()
+	owned := &entity.Subscription{ID: subscriptionID, UserID: ownerID}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr error
+	}{
+		{"owner", userContext(ownerID), nil},
+		{"another user", userContext(otherID), usecase.ErrNotFound},
+		{"admin", adminContext(), nil},
+		{"anonymous", context.Background(), usecase.ErrUnauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscriptionUsecase, mocks := newAuthzUsecase(t)
+
+			_, isAuthenticated := entity.PrincipalFromContext(tt.ctx)
+
+			var stored bool
+			if isAuthenticated {
+				// Read and Delete load the record. Update checks the owner of the record its
+				// checked update reads.
+				mocks.subscriptionRepo.EXPECT().GetSubscription(tt.ctx, subscriptionID).Return(owned, nil).Times(2)
+
+				expectUpdateChecked(mocks, nil, *owned, &stored)
+			}
+
+			if tt.wantErr == nil {
+				mocks.subscriptionRepo.EXPECT().Delete(tt.ctx, subscriptionID).Return(nil)
+			}
+
+			if _, err := subscriptionUsecase.Read(tt.ctx, subscriptionID); !errors.Is(err, tt.wantErr) {
+				t.Errorf("read: expected error %v, got %v", tt.wantErr, err)
+			}
+
+			update := entity.UpdateSubscriptionRequest{ID: subscriptionID, Title: "Premium", Price: 100}
+			if err := subscriptionUsecase.Update(tt.ctx, update); !errors.Is(err, tt.wantErr) || stored != (tt.wantErr == nil) {
+				t.Errorf("update: expected error %v, got %v, stored %v", tt.wantErr, err, stored)
+			}
+
+			if err := subscriptionUsecase.Delete(tt.ctx, subscriptionID); !errors.Is(err, tt.wantErr) {
+				t.Errorf("delete: expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestUnknownSubscription(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+	}{
+		{"admin", adminContext()},
+		{"user", userContext(ownerID)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscriptionUsecase, mocks := newAuthzUsecase(t)
+			id := uuid.NewString()
+
+			mocks.subscriptionRepo.EXPECT().UpdateChecked(tt.ctx, gomock.Any(), gomock.Any()).Return(port.ErrNotFound)
+			mocks.subscriptionRepo.EXPECT().GetSubscription(tt.ctx, id).Return(nil, port.ErrNotFound)
+
+			update := entity.UpdateSubscriptionRequest{ID: id, Title: "Premium", Price: 100}
+			if err := subscriptionUsecase.Update(tt.ctx, update); !errors.Is(err, usecase.ErrNotFound) {
+				t.Errorf("update: expected error %v, got %v", usecase.ErrNotFound, err)
+			}
+
+			if err := subscriptionUsecase.Delete(tt.ctx, id); !errors.Is(err, usecase.ErrNotFound) {
+				t.Errorf("delete: expected error %v, got %v", usecase.ErrNotFound, err)
+			}
+		})
+	}
+}
+
+func TestCreateForAnotherUserIsForbidden(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		userID  string
+		wantErr error
+	}{
+		{"for self", userContext(ownerID), ownerID, nil},
+		{"for another user", userContext(ownerID), otherID, usecase.ErrForbidden},
+		{"admin for any user", adminContext(), otherID, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscriptionUsecase, mocks := newAuthzUsecase(t)
+
+			if tt.wantErr == nil {
+				mocks.subscriptionRepo.EXPECT().Create(tt.ctx, gomock.Any()).Return(nil)
+			}
+
+			_, err := subscriptionUsecase.Create(tt.ctx, entity.CreateSubscriptionRequest{
+				Title:  "Premium",
+				Price:  100,
+				UserID: tt.userID,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
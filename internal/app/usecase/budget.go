@@ -0,0 +1,352 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/pkg/logctx"
+	"subscription-service/internal/port"
+)
+
+// maxBudgetMonths bounds how far ahead of its start a subscription without an end date
+// is checked against budgets.
+const maxBudgetMonths = 120
+
+var _ BudgetUseCase = (*Budget)(nil)
+
+// Budget keeps the budgets of users and compares their monthly costs against them.
+type Budget struct {
+	budgetRepo       port.BudgetRepo
+	subscriptionRepo port.SubscriptionRepo
+	serviceRepo      port.ServiceRepo
+	logger           *zap.Logger
+}
+
+// NewBudget returns the budget use case. Budgets cover the subscriptions to the services
+// of the catalog of serviceRepo, if any, that they name.
+func NewBudget(
+	budgetRepo port.BudgetRepo,
+	subscriptionRepo port.SubscriptionRepo,
+	serviceRepo port.ServiceRepo,
+	logger *zap.Logger,
+) (*Budget, error) {
+	return &Budget{
+		budgetRepo:       budgetRepo,
+		subscriptionRepo: subscriptionRepo,
+		serviceRepo:      serviceRepo,
+		logger:           logger,
+	}, nil
+}
+
+// Create stores a new budget of the user. A user has at most one budget per category,
+// including the one without a category.
+func (r *Budget) Create(ctx context.Context, budget entity.Budget) (*entity.Budget, error) {
+	if err := r.authorize(ctx, budget.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := validateBudget(budget); err != nil {
+		return nil, err
+	}
+
+	budget.ID = uuid.NewString()
+
+	if err := r.budgetRepo.CreateBudget(ctx, budget); err != nil {
+		if errors.Is(err, port.ErrBudgetAlreadyExists) {
+			return nil, fmt.Errorf("%w for category %q", ErrBudgetAlreadyExists, budget.Category)
+		}
+		return nil, fmt.Errorf("failed to create budget: %w", err)
+	}
+
+	return &budget, nil
+}
+
+func (r *Budget) Read(ctx context.Context, userID, id string) (*entity.Budget, error) {
+	if err := r.authorize(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return r.get(ctx, userID, id)
+}
+
+// get reads a budget of the user. Budgets of other users are reported as missing.
+func (r *Budget) get(ctx context.Context, userID, id string) (*entity.Budget, error) {
+	budget, err := r.budgetRepo.GetBudget(ctx, id)
+	if err != nil {
+		if errors.Is(err, port.ErrNotFound) {
+			return nil, ErrBudgetNotFound
+		}
+		return nil, fmt.Errorf("failed to get budget: %w", err)
+	}
+
+	if budget.UserID != userID {
+		return nil, ErrBudgetNotFound
+	}
+
+	return budget, nil
+}
+
+// Update replaces the category, services, limit and strictness of a budget of the user.
+func (r *Budget) Update(ctx context.Context, budget entity.Budget) (*entity.Budget, error) {
+	if err := r.authorize(ctx, budget.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := validateBudget(budget); err != nil {
+		return nil, err
+	}
+
+	current, err := r.get(ctx, budget.UserID, budget.ID)
+	if err != nil {
+		return nil, err
+	}
+	budget.CreatedAt = current.CreatedAt
+
+	if err = r.budgetRepo.UpdateBudget(ctx, budget); err != nil {
+		switch {
+		case errors.Is(err, port.ErrNotFound):
+			return nil, ErrBudgetNotFound
+		case errors.Is(err, port.ErrBudgetAlreadyExists):
+			return nil, fmt.Errorf("%w for category %q", ErrBudgetAlreadyExists, budget.Category)
+		}
+		return nil, fmt.Errorf("failed to update budget: %w", err)
+	}
+
+	return &budget, nil
+}
+
+func (r *Budget) Delete(ctx context.Context, userID, id string) error {
+	if err := r.authorize(ctx, userID); err != nil {
+		return err
+	}
+
+	if _, err := r.get(ctx, userID, id); err != nil {
+		return err
+	}
+
+	if err := r.budgetRepo.DeleteBudget(ctx, id); err != nil {
+		if errors.Is(err, port.ErrNotFound) {
+			return ErrBudgetNotFound
+		}
+		return fmt.Errorf("failed to delete budget: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Budget) List(ctx context.Context, userID string) ([]entity.Budget, error) {
+	if err := r.authorize(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	budgets, err := r.budgetRepo.ListBudgets(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list budgets: %w", err)
+	}
+
+	return budgets, nil
+}
+
+// Status compares the cost of the month for each budget of the user, computed like
+// MonthlyCosts, against its limit.
+func (r *Budget) Status(ctx context.Context, userID string, month time.Time) ([]entity.BudgetStatus, error) {
+	budgets, err := r.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	month = entity.MonthStart(month)
+
+	if r.serviceRepo != nil {
+		if err := resolveBudgetServices(ctx, r.serviceRepo, budgets); err != nil {
+			return nil, err
+		}
+	}
+
+	var subs []entity.Subscription
+	if len(budgets) > 0 {
+		subs, err = r.subscriptionRepo.ListActive(ctx, entity.PeriodFilter{UserID: &userID, From: month, To: month})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list active subscriptions: %w", err)
+		}
+	}
+
+	statuses := make([]entity.BudgetStatus, len(budgets))
+	for i, b := range budgets {
+		statuses[i] = entity.BudgetStatus{Budget: b, Month: month, Cost: b.CostAt(subs, month)}
+	}
+
+	return statuses, nil
+}
+
+// authorize makes sure the principal may manage the budgets of userID.
+func (r *Budget) authorize(ctx context.Context, userID string) error {
+	principal, err := tenantPrincipalFrom(ctx)
+	if err != nil {
+		return err
+	}
+	if !canAccess(principal, userID) {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+// validateBudget checks that a budget names its services exactly when it has a category.
+func validateBudget(b entity.Budget) error {
+	switch {
+	case b.Limit < 0:
+		return fmt.Errorf("%w: the limit is negative", ErrInvalidBudget)
+	case b.Category != strings.TrimSpace(b.Category):
+		return fmt.Errorf("%w: the category starts or ends with a space", ErrInvalidBudget)
+	case b.Category == "" && len(b.Services) > 0:
+		return fmt.Errorf("%w: services need a category", ErrInvalidBudget)
+	case b.Category != "" && len(b.Services) == 0:
+		return fmt.Errorf("%w: the category %q has no services", ErrInvalidBudget, b.Category)
+	}
+
+	for i, service := range b.Services {
+		if strings.TrimSpace(service) == "" {
+			return fmt.Errorf("%w: service %d is blank", ErrInvalidBudget, i+1)
+		}
+		if slices.ContainsFunc(b.Services[:i], func(s string) bool { return strings.EqualFold(s, service) }) {
+			return fmt.Errorf("%w: the service %q is listed twice", ErrInvalidBudget, service)
+		}
+	}
+
+	return nil
+}
+
+// checkBudgets compares the months of after, which replaces before or is new when before
+// is nil, against the budgets of its user as read by reader. It returns an alert for each
+// budget the change takes over its limit, see entity.Budget.Overspend, or
+// ErrBudgetExceeded when one of them is strict.
+func (r *Subscription) checkBudgets(
+	ctx context.Context,
+	reader port.CheckReader,
+	before *entity.Subscription,
+	after entity.Subscription,
+) ([]entity.BudgetAlert, error) {
+	budgets, err := reader.ListBudgets(ctx, after.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list budgets: %w", err)
+	}
+
+	// The catalog is read through reader as well: the repository may be locked for the
+	// write.
+	if r.serviceRepo != nil {
+		if err := resolveBudgetServices(ctx, reader, budgets); err != nil {
+			return nil, err
+		}
+	}
+
+	budgets = slices.DeleteFunc(budgets, func(b entity.Budget) bool { return !b.Covers(after) })
+	if len(budgets) == 0 {
+		return nil, nil
+	}
+
+	filter := entity.PeriodFilter{UserID: &after.UserID, From: entity.MonthStart(after.StartDate)}
+	if after.EndDate != nil {
+		filter.To = entity.MonthStart(*after.EndDate)
+	} else {
+		filter.To = entity.AddMonths(filter.From, maxBudgetMonths-1)
+	}
+
+	subs, err := reader.ListActive(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active subscriptions: %w", err)
+	}
+	subs = slices.DeleteFunc(subs, func(s entity.Subscription) bool { return s.ID == after.ID })
+
+	var alerts []entity.BudgetAlert
+	for _, b := range budgets {
+		alert, over := b.Overspend(subs, before, after)
+		if !over {
+			continue
+		}
+
+		if b.Strict {
+			return nil, fmt.Errorf("%w: %s would cost %d of %d in %s",
+				ErrBudgetExceeded, budgetName(b), alert.Cost, b.Limit, formatMonth(alert.Month))
+		}
+		alerts = append(alerts, alert)
+	}
+
+	return alerts, nil
+}
+
+// serviceFinder finds services of the catalog by name, like port.ServiceRepo.
+type serviceFinder interface {
+	FindService(ctx context.Context, name string) (*entity.Service, error)
+}
+
+// resolveBudgetServices sets the ServiceIDs of the budgets to the services of the
+// catalog their Services name. Names that are not in the catalog match titles only.
+func resolveBudgetServices(ctx context.Context, services serviceFinder, budgets []entity.Budget) error {
+	resolved := map[string]*entity.Service{}
+	for i := range budgets {
+		for _, name := range budgets[i].Services {
+			key := entity.ServiceKey(name)
+			service, ok := resolved[key]
+			if !ok {
+				var err error
+				service, err = services.FindService(ctx, name)
+				if err != nil && !errors.Is(err, port.ErrNotFound) {
+					return fmt.Errorf("failed to resolve service: %w", err)
+				}
+				resolved[key] = service
+			}
+
+			if service != nil {
+				budgets[i].ServiceIDs = append(budgets[i].ServiceIDs, service.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+// recordAlerts stores the alerts as events of the subscription. The subscription is
+// already written, so a failure is logged rather than returned.
+func (r *Subscription) recordAlerts(ctx context.Context, subscriptionID string, alerts []entity.BudgetAlert, at int64) {
+	if len(alerts) == 0 {
+		return
+	}
+
+	events := make([]entity.SubscriptionEvent, len(alerts))
+	for i := range alerts {
+		events[i] = entity.SubscriptionEvent{
+			ID:             uuid.NewString(),
+			SubscriptionID: subscriptionID,
+			Type:           entity.EventBudgetExceeded,
+			BudgetAlert:    &alerts[i],
+			CreatedAt:      at,
+		}
+	}
+
+	logger := logctx.Logger(ctx, r.logger).With(zap.String("subscription_id", subscriptionID))
+	for _, a := range alerts {
+		logger.Info("budget exceeded", zap.String("budget_id", a.BudgetID),
+			zap.String("month", formatMonth(a.Month)), zap.Int64("cost", a.Cost), zap.Int64("limit", a.Limit))
+	}
+
+	if err := r.subscriptionRepo.AddEvents(ctx, events); err != nil {
+		logger.Error("failed to record budget alerts", zap.Error(err))
+	}
+}
+
+func budgetName(b entity.Budget) string {
+	if b.Category == "" {
+		return "the budget"
+	}
+
+	return fmt.Sprintf("the %q budget", b.Category)
+}
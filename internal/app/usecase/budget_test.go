@@ -0,0 +1,345 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	repo "subscription-service/internal/adapter/repo/mock"
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/app/usecase"
+	"subscription-service/internal/port"
+)
+
+// newBudgetingUsecase returns a use case whose checked writes read through the returned
+// reader. serviceRepo may be nil.
+func newBudgetingUsecase(
+	t *testing.T,
+	serviceRepo port.ServiceRepo,
+) (*usecase.Subscription, authzMocks, *repo.MockCheckReader) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mocks := authzMocks{subscriptionRepo: repo.NewMockSubscriptionRepo(ctrl)}
+	reader := repo.NewMockCheckReader(ctrl)
+
+	subscriptionUsecase, err := usecase.NewSubscription(
+		mocks.subscriptionRepo, repo.NewMockBudgetRepo(ctrl), serviceRepo, nil, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return subscriptionUsecase, mocks, reader
+}
+
+// expectCreateChecked expects a checked create that stores the subscription when check
+// passes, returning stored.
+func expectCreateChecked(mocks authzMocks, reader port.CheckReader, stored *bool) {
+	mocks.subscriptionRepo.EXPECT().CreateChecked(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ entity.CreateSubscriptionRequest, check func(port.CheckReader) error) error {
+			if err := check(reader); err != nil {
+				return err
+			}
+			*stored = true
+			return nil
+		})
+}
+
+// expectUpdateChecked expects a checked update of current that is stored when check
+// passes.
+func expectUpdateChecked(mocks authzMocks, reader port.CheckReader, current entity.Subscription, stored *bool) {
+	mocks.subscriptionRepo.EXPECT().UpdateChecked(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context,
+			_ entity.UpdateSubscriptionRequest,
+			check func(entity.Subscription, port.CheckReader) error,
+		) error {
+			if err := check(current, reader); err != nil {
+				return err
+			}
+			*stored = true
+			return nil
+		})
+}
+
+func TestBudgetOverspend(t *testing.T) {
+	video := entity.Budget{ID: "video", Category: "video", Services: []string{"Okko", "Ivi"}, Limit: 500}
+
+	okko := entity.Subscription{ID: "okko", Title: "okko", Price: 300, StartDate: month(2025, time.January)}
+	ivi := entity.Subscription{
+		ID: "ivi", Title: "Ivi", Price: 150, StartDate: month(2025, time.March), EndDate: ptr(month(2025, time.May)),
+	}
+
+	tests := []struct {
+		name      string
+		before    *entity.Subscription
+		after     entity.Subscription
+		wantOver  bool
+		wantMonth time.Time
+		wantCost  int64
+	}{
+		{"other service", nil, entity.Subscription{Title: "Kion", Price: 1000, StartDate: month(2025, time.January)},
+			false, time.Time{}, 0},
+		{"within the limit", nil, entity.Subscription{Title: "Ivi", Price: 50, StartDate: month(2025, time.January)},
+			false, time.Time{}, 0},
+		{"over while another runs", nil, entity.Subscription{Title: "IVI", Price: 100, StartDate: month(2025, time.January)},
+			true, month(2025, time.March), 550},
+		{"already over and no dearer", &ivi, entity.Subscription{
+			ID: "ivi", Title: "Ivi", Price: 150, StartDate: month(2025, time.March), EndDate: ptr(month(2025, time.May)),
+		}, false, time.Time{}, 0},
+		{"dearer phase", nil, entity.Subscription{
+			Title: "Ivi", Price: 300, StartDate: month(2025, time.January), EndDate: ptr(month(2025, time.February)),
+			Phases: []entity.Phase{{StartDate: month(2025, time.January), EndDate: month(2025, time.January), Price: 0}},
+		}, true, month(2025, time.February), 600},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subs := []entity.Subscription{okko}
+			if tt.before == nil {
+				subs = append(subs, ivi)
+			}
+
+			alert, over := video.Overspend(subs, tt.before, tt.after)
+			if over != tt.wantOver {
+				t.Fatalf("over = %v, want %v (alert %+v)", over, tt.wantOver, alert)
+			}
+			if over && (!alert.Month.Equal(tt.wantMonth) || alert.Cost != tt.wantCost || alert.BudgetID != video.ID) {
+				t.Errorf("alert = %+v, want %s costing %d", alert, tt.wantMonth, tt.wantCost)
+			}
+		})
+	}
+}
+
+func TestCreateChecksBudgets(t *testing.T) {
+	total := entity.Budget{ID: "total", UserID: ownerID, Limit: 1000}
+	okko := entity.Subscription{ID: "okko", UserID: ownerID, Title: "Okko", Price: 700, StartDate: month(2025, time.January)}
+	post := entity.CreateSubscriptionRequest{
+		Title: "Kion", Price: 400, UserID: ownerID, StartDate: month(2025, time.February), UpdatedAt: 42,
+	}
+
+	t.Run("alert", func(t *testing.T) {
+		subscriptionUsecase, mocks, reader := newBudgetingUsecase(t, nil)
+		ctx := userContext(ownerID)
+
+		var stored bool
+		expectCreateChecked(mocks, reader, &stored)
+		reader.EXPECT().ListBudgets(ctx, ownerID).Return([]entity.Budget{total}, nil)
+		reader.EXPECT().ListActive(ctx, gomock.Any()).Return([]entity.Subscription{okko}, nil)
+		mocks.subscriptionRepo.EXPECT().AddEvents(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, events []entity.SubscriptionEvent) error {
+				if len(events) != 1 || events[0].Type != entity.EventBudgetExceeded || events[0].BudgetAlert == nil {
+					t.Fatalf("events = %+v, want one budget alert", events)
+				}
+				alert := events[0].BudgetAlert
+				if alert.BudgetID != total.ID || !alert.Month.Equal(month(2025, time.February)) ||
+					alert.Cost != 1100 || alert.Limit != 1000 || events[0].CreatedAt != 42 {
+					t.Errorf("alert = %+v at %d", alert, events[0].CreatedAt)
+				}
+				return nil
+			})
+
+		if _, err := subscriptionUsecase.Create(ctx, post); err != nil || !stored {
+			t.Fatalf("create: %v, stored %v", err, stored)
+		}
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		subscriptionUsecase, mocks, reader := newBudgetingUsecase(t, nil)
+		ctx := userContext(ownerID)
+
+		strict := total
+		strict.Strict = true
+		var stored bool
+		expectCreateChecked(mocks, reader, &stored)
+		reader.EXPECT().ListBudgets(ctx, ownerID).Return([]entity.Budget{strict}, nil)
+		reader.EXPECT().ListActive(ctx, gomock.Any()).Return([]entity.Subscription{okko}, nil)
+
+		if _, err := subscriptionUsecase.Create(ctx, post); !errors.Is(err, usecase.ErrBudgetExceeded) || stored {
+			t.Errorf("create over a strict budget: %v, stored %v, want %v", err, stored, usecase.ErrBudgetExceeded)
+		}
+	})
+
+	t.Run("within the limit", func(t *testing.T) {
+		subscriptionUsecase, mocks, reader := newBudgetingUsecase(t, nil)
+		ctx := userContext(ownerID)
+
+		var stored bool
+		expectCreateChecked(mocks, reader, &stored)
+		reader.EXPECT().ListBudgets(ctx, ownerID).Return([]entity.Budget{total}, nil)
+		reader.EXPECT().ListActive(ctx, gomock.Any()).Return(nil, nil)
+
+		if _, err := subscriptionUsecase.Create(ctx, post); err != nil || !stored {
+			t.Fatalf("create: %v, stored %v", err, stored)
+		}
+	})
+
+	t.Run("services of the catalog", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		serviceRepo := repo.NewMockServiceRepo(ctrl)
+		subscriptionUsecase, mocks, reader := newBudgetingUsecase(t, serviceRepo)
+		ctx := userContext(ownerID)
+
+		// The budget names the service by an alias, and the subscriptions to it have other
+		// titles.
+		plus := &entity.Service{ID: "plus", Name: "Yandex Plus", Aliases: []string{"Plus", "Яндекс Плюс"}}
+		video := entity.Budget{
+			ID: "video", UserID: ownerID, Category: "video", Services: []string{"plus", "Ivi"}, Limit: 500, Strict: true,
+		}
+		early := entity.Subscription{
+			ID: "early", UserID: ownerID, Title: "Яндекс Плюс", ServiceID: &plus.ID, Price: 300,
+			StartDate: month(2025, time.January),
+		}
+
+		serviceRepo.EXPECT().FindService(ctx, "Plus").Return(plus, nil)
+		reader.EXPECT().FindService(ctx, "plus").Return(plus, nil)
+		reader.EXPECT().FindService(ctx, "Ivi").Return(nil, port.ErrNotFound)
+		var stored bool
+		expectCreateChecked(mocks, reader, &stored)
+		reader.EXPECT().ListBudgets(ctx, ownerID).Return([]entity.Budget{video}, nil)
+		reader.EXPECT().ListActive(ctx, gomock.Any()).Return([]entity.Subscription{early}, nil)
+
+		post := entity.CreateSubscriptionRequest{
+			Title: "Plus", Price: 300, UserID: ownerID, StartDate: month(2025, time.February), UpdatedAt: 42,
+		}
+		if _, err := subscriptionUsecase.Create(ctx, post); !errors.Is(err, usecase.ErrBudgetExceeded) || stored {
+			t.Errorf("create over a budget by the catalog: %v, stored %v, want %v", err, stored, usecase.ErrBudgetExceeded)
+		}
+	})
+}
+
+func TestUpdateChecksBudgets(t *testing.T) {
+	strict := entity.Budget{ID: "total", UserID: ownerID, Limit: 1000, Strict: true}
+	okko := entity.Subscription{ID: "okko", UserID: ownerID, Title: "Okko", Price: 700, StartDate: month(2025, time.January)}
+	kion := entity.Subscription{ID: "kion", UserID: ownerID, Title: "Kion", Price: 200, StartDate: month(2025, time.January)}
+
+	tests := []struct {
+		name       string
+		price      int64
+		wantErr    error
+		wantStored bool
+	}{
+		{"within the limit", 300, nil, true},
+		{"over a strict budget", 400, usecase.ErrBudgetExceeded, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscriptionUsecase, mocks, reader := newBudgetingUsecase(t, nil)
+			ctx := userContext(ownerID)
+
+			// The stored subscription comes from the checked update only, and the budget
+			// replaces its months with the ones of the update.
+			var stored bool
+			expectUpdateChecked(mocks, reader, kion, &stored)
+			reader.EXPECT().ListBudgets(ctx, ownerID).Return([]entity.Budget{strict}, nil)
+			reader.EXPECT().ListActive(ctx, gomock.Any()).Return([]entity.Subscription{okko, kion}, nil)
+
+			update := entity.UpdateSubscriptionRequest{
+				ID: kion.ID, Title: kion.Title, Price: tt.price, StartDate: kion.StartDate, UpdatedAt: 42,
+			}
+			err := subscriptionUsecase.Update(ctx, update)
+			if !errors.Is(err, tt.wantErr) || stored != tt.wantStored {
+				t.Errorf("update: %v, stored %v, want %v, stored %v", err, stored, tt.wantErr, tt.wantStored)
+			}
+		})
+	}
+}
+
+func TestBudgetUsecase(t *testing.T) {
+	newBudget := func(t *testing.T) (*usecase.Budget, *repo.MockBudgetRepo, *repo.MockSubscriptionRepo) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		budgetRepo, subscriptionRepo := repo.NewMockBudgetRepo(ctrl), repo.NewMockSubscriptionRepo(ctrl)
+		budgetUsecase, err := usecase.NewBudget(budgetRepo, subscriptionRepo, nil, zap.NewNop())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return budgetUsecase, budgetRepo, subscriptionRepo
+	}
+
+	t.Run("validation", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			budget  entity.Budget
+			wantErr bool
+		}{
+			{"total", entity.Budget{Limit: 1000}, false},
+			{"category", entity.Budget{Category: "video", Services: []string{"Okko", "Ivi"}, Limit: 500}, false},
+			{"negative limit", entity.Budget{Limit: -1}, true},
+			{"services without a category", entity.Budget{Services: []string{"Okko"}, Limit: 500}, true},
+			{"category without services", entity.Budget{Category: "video", Limit: 500}, true},
+			{"padded category", entity.Budget{Category: " video", Services: []string{"Okko"}, Limit: 500}, true},
+			{"blank service", entity.Budget{Category: "video", Services: []string{" "}, Limit: 500}, true},
+			{"duplicate service", entity.Budget{Category: "video", Services: []string{"Okko", "OKKO"}, Limit: 500}, true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				budgetUsecase, budgetRepo, _ := newBudget(t)
+				ctx := userContext(ownerID)
+				tt.budget.UserID = ownerID
+
+				if !tt.wantErr {
+					budgetRepo.EXPECT().CreateBudget(ctx, gomock.Any()).Return(nil)
+				}
+
+				_, err := budgetUsecase.Create(ctx, tt.budget)
+				if tt.wantErr != errors.Is(err, usecase.ErrInvalidBudget) {
+					t.Errorf("expected invalid budget error %v, got %v", tt.wantErr, err)
+				}
+			})
+		}
+	})
+
+	t.Run("another user", func(t *testing.T) {
+		budgetUsecase, budgetRepo, _ := newBudget(t)
+
+		if _, err := budgetUsecase.List(userContext(otherID), ownerID); !errors.Is(err, usecase.ErrForbidden) {
+			t.Errorf("list budgets of another user: %v, want %v", err, usecase.ErrForbidden)
+		}
+
+		ctx := userContext(otherID)
+		budgetRepo.EXPECT().GetBudget(ctx, "total").Return(&entity.Budget{ID: "total", UserID: ownerID}, nil)
+		if _, err := budgetUsecase.Read(ctx, otherID, "total"); !errors.Is(err, usecase.ErrBudgetNotFound) {
+			t.Errorf("read a budget of another user: %v, want %v", err, usecase.ErrBudgetNotFound)
+		}
+	})
+
+	t.Run("status", func(t *testing.T) {
+		budgetUsecase, budgetRepo, subscriptionRepo := newBudget(t)
+		ctx := userContext(ownerID)
+
+		budgetRepo.EXPECT().ListBudgets(ctx, ownerID).Return([]entity.Budget{
+			{ID: "total", UserID: ownerID, Limit: 1000},
+			{ID: "video", UserID: ownerID, Category: "video", Services: []string{"Okko"}, Limit: 200},
+		}, nil)
+		subscriptionRepo.EXPECT().ListActive(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, filter entity.PeriodFilter) ([]entity.Subscription, error) {
+				if !filter.From.Equal(month(2025, time.March)) || !filter.To.Equal(month(2025, time.March)) {
+					t.Errorf("filter = %+v, want March 2025", filter)
+				}
+				return []entity.Subscription{
+					{UserID: ownerID, Title: "Okko", Price: 300, StartDate: month(2025, time.January)},
+					{UserID: ownerID, Title: "Kion", Price: 400, StartDate: month(2025, time.February)},
+				}, nil
+			})
+
+		statuses, err := budgetUsecase.Status(ctx, ownerID, month(2025, time.March).Add(5*24*time.Hour))
+		if err != nil {
+			t.Fatalf("status: %v", err)
+		}
+		if len(statuses) != 2 || statuses[0].Cost != 700 || statuses[0].Exceeded() ||
+			statuses[1].Cost != 300 || !statuses[1].Exceeded() {
+			t.Errorf("statuses = %+v, want 700 of 1000 and 300 of 200", statuses)
+		}
+	})
+}
@@ -0,0 +1,169 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/app/usecase"
+)
+
+// expectCancel makes the repo apply cancel to stored and return the result.
+func expectCancel(mocks authzMocks, ctx context.Context, stored entity.Subscription) {
+	mocks.subscriptionRepo.EXPECT().Cancel(ctx, stored.ID, gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context,
+			_ string,
+			cancel func(entity.Subscription) (entity.Subscription, error),
+		) (*entity.Subscription, error) {
+			sub, err := cancel(stored)
+			if err != nil {
+				return nil, err
+			}
+			return &sub, nil
+		})
+}
+
+func TestCancel(t *testing.T) {
+	now := month(2025, time.March).Add(10 * 24 * time.Hour)
+
+	stored := entity.Subscription{
+		ID:        "sub",
+		UserID:    ownerID,
+		StartDate: month(2025, time.January),
+		EndDate:   ptr(month(2025, time.December)),
+		Phases:    []entity.Phase{{StartDate: month(2025, time.January), EndDate: month(2025, time.June), Price: 0}},
+		Pauses:    []entity.Pause{{StartDate: month(2025, time.April)}},
+	}
+
+	tests := []struct {
+		name      string
+		stored    entity.Subscription
+		effective *time.Time
+		reason    string
+		wantEnd   time.Time
+		wantErr   error
+	}{
+		{"end of the period by default", stored, nil, "too_expensive", month(2025, time.December), nil},
+		{"this month by default without an end",
+			entity.Subscription{ID: "sub", UserID: ownerID, StartDate: month(2025, time.January)},
+			nil, "other", month(2025, time.March), nil},
+		// Renewed early for the term from July, the subscription is still in the term
+		// that ends in June.
+		{"end of the renewal term by default", entity.Subscription{
+			ID: "sub", UserID: ownerID, StartDate: month(2025, time.January), EndDate: ptr(month(2025, time.December)),
+			Renewal: &entity.Renewal{TermMonths: 6},
+		}, nil, "other", month(2025, time.June), nil},
+		{"later month", stored, ptr(month(2025, time.May)), "other", month(2025, time.May), nil},
+		{"not started yet", entity.Subscription{ID: "sub", UserID: ownerID, StartDate: month(2025, time.August)},
+			nil, "other", month(2025, time.August), nil},
+		{"past month", stored, ptr(month(2025, time.February)), "other", time.Time{}, usecase.ErrInvalidSubscriptionData},
+		{"after the end", stored, ptr(month(2026, time.January)), "other", time.Time{}, usecase.ErrInvalidSubscriptionData},
+		{"ended", entity.Subscription{
+			ID: "sub", UserID: ownerID, StartDate: month(2024, time.January), EndDate: ptr(month(2025, time.February)),
+		}, nil, "other", time.Time{}, usecase.ErrSubscriptionEnded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscriptionUsecase, mocks := newAuthzUsecase(t)
+			ctx := userContext(ownerID)
+
+			expectCancel(mocks, ctx, tt.stored)
+
+			sub, err := subscriptionUsecase.Cancel(ctx, entity.CancelRequest{
+				ID: "sub", EffectiveDate: tt.effective, Reason: tt.reason, Note: "note", Now: now,
+			})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			c := sub.Cancellation
+			if !sub.EndDate.Equal(tt.wantEnd) || c == nil || !c.EffectiveDate.Equal(tt.wantEnd) ||
+				c.Reason != tt.reason || c.Note != "note" || c.CancelledAt != now.UnixMilli() {
+				t.Errorf("expected the subscription to end in %s, got %v with %+v", tt.wantEnd, sub.EndDate, c)
+			}
+		})
+	}
+}
+
+func TestCancelCutsOffPhasesAndPauses(t *testing.T) {
+	subscriptionUsecase, mocks := newAuthzUsecase(t)
+	ctx := adminContext()
+
+	expectCancel(mocks, ctx, entity.Subscription{
+		ID:        "sub",
+		UserID:    ownerID,
+		StartDate: month(2025, time.January),
+		Phases: []entity.Phase{
+			{StartDate: month(2025, time.January), EndDate: month(2025, time.March), Price: 0},
+			{StartDate: month(2025, time.April), EndDate: month(2025, time.June), Price: 199},
+		},
+		Pauses: []entity.Pause{
+			{StartDate: month(2025, time.February)},
+			{StartDate: month(2025, time.May), EndDate: ptr(month(2025, time.May))},
+		},
+	})
+
+	sub, err := subscriptionUsecase.Cancel(ctx, entity.CancelRequest{
+		ID: "sub", EffectiveDate: ptr(month(2025, time.March)), Reason: "other", Now: month(2025, time.January),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sub.Phases) != 1 || !sub.Phases[0].EndDate.Equal(month(2025, time.March)) {
+		t.Errorf("expected the first phase only, got %+v", sub.Phases)
+	}
+	if len(sub.Pauses) != 1 || sub.Pauses[0].EndDate == nil || !sub.Pauses[0].EndDate.Equal(month(2025, time.March)) {
+		t.Errorf("expected the open pause to end in March, got %+v", sub.Pauses)
+	}
+}
+
+func TestCancelRejectsUnknownReason(t *testing.T) {
+	subscriptionUsecase, _ := newAuthzUsecase(t)
+
+	_, err := subscriptionUsecase.Cancel(adminContext(), entity.CancelRequest{ID: "sub", Reason: "bored", Now: time.Now()})
+	if !errors.Is(err, usecase.ErrUnknownCancelReason) {
+		t.Errorf("expected %v, got %v", usecase.ErrUnknownCancelReason, err)
+	}
+}
+
+func TestCancelOfAnotherUser(t *testing.T) {
+	subscriptionUsecase, mocks := newAuthzUsecase(t)
+	ctx := userContext(otherID)
+
+	expectCancel(mocks, ctx, entity.Subscription{ID: "sub", UserID: ownerID, StartDate: month(2025, time.January)})
+
+	_, err := subscriptionUsecase.Cancel(ctx, entity.CancelRequest{ID: "sub", Reason: "other", Now: time.Now()})
+	if !errors.Is(err, usecase.ErrNotFound) {
+		t.Errorf("expected %v, got %v", usecase.ErrNotFound, err)
+	}
+}
+
+func TestCountCancellationsScopesUsers(t *testing.T) {
+	subscriptionUsecase, mocks := newAuthzUsecase(t)
+	ctx := userContext(ownerID)
+
+	mocks.subscriptionRepo.EXPECT().CountCancellations(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, filter entity.CancellationFilter) ([]entity.CancellationCount, error) {
+			if filter.UserID == nil || *filter.UserID != ownerID {
+				t.Errorf("expected the filter scoped to %s, got %v", ownerID, filter.UserID)
+			}
+			return nil, nil
+		})
+
+	if _, err := subscriptionUsecase.CountCancellations(ctx, entity.CancellationFilter{UserID: &otherID}); err != nil {
+		t.Fatal(err)
+	}
+}
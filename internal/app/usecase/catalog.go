@@ -0,0 +1,264 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+var _ CatalogUseCase = (*Catalog)(nil)
+
+// Catalog keeps the service catalog of a tenant. Everyone in the tenant reads it and
+// admins change it.
+type Catalog struct {
+	serviceRepo port.ServiceRepo
+	logger      *zap.Logger
+}
+
+func NewCatalog(serviceRepo port.ServiceRepo, logger *zap.Logger) (*Catalog, error) {
+	return &Catalog{serviceRepo: serviceRepo, logger: logger}, nil
+}
+
+// Create stores a new service and links the subscriptions whose title is one of its
+// names to it.
+func (r *Catalog) Create(ctx context.Context, service entity.Service) (*entity.Service, error) {
+	if err := r.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	if service.Currency == "" {
+		service.Currency = entity.DefaultCurrency
+	}
+	if err := validateService(service); err != nil {
+		return nil, err
+	}
+
+	service.ID = uuid.NewString()
+
+	if err := r.serviceRepo.CreateService(ctx, service); err != nil {
+		switch {
+		case errors.Is(err, port.ErrServiceAlreadyExists):
+			return nil, fmt.Errorf("%w: a service is already named %s", ErrServiceAlreadyExists, serviceNames(service))
+		case errors.Is(err, port.ErrSubscriptionAlreadyExists):
+			return nil, overlappingNames(service)
+		}
+		return nil, fmt.Errorf("failed to create service: %w", err)
+	}
+
+	return &service, nil
+}
+
+func (r *Catalog) Read(ctx context.Context, id string) (*entity.Service, error) {
+	if _, err := tenantPrincipalFrom(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.get(ctx, id)
+}
+
+func (r *Catalog) get(ctx context.Context, id string) (*entity.Service, error) {
+	service, err := r.serviceRepo.GetService(ctx, id)
+	if err != nil {
+		if errors.Is(err, port.ErrNotFound) {
+			return nil, ErrServiceNotFound
+		}
+		return nil, fmt.Errorf("failed to get service: %w", err)
+	}
+
+	return service, nil
+}
+
+// Update replaces a service. Subscriptions keep their titles; those without a service
+// whose title is one of its new names are linked to it.
+func (r *Catalog) Update(ctx context.Context, service entity.Service) (*entity.Service, error) {
+	if err := r.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	if service.Currency == "" {
+		service.Currency = entity.DefaultCurrency
+	}
+	if err := validateService(service); err != nil {
+		return nil, err
+	}
+
+	current, err := r.get(ctx, service.ID)
+	if err != nil {
+		return nil, err
+	}
+	service.CreatedAt = current.CreatedAt
+
+	if err = r.serviceRepo.UpdateService(ctx, service); err != nil {
+		switch {
+		case errors.Is(err, port.ErrNotFound):
+			return nil, ErrServiceNotFound
+		case errors.Is(err, port.ErrServiceAlreadyExists):
+			return nil, fmt.Errorf("%w: another service is already named %s", ErrServiceAlreadyExists, serviceNames(service))
+		case errors.Is(err, port.ErrSubscriptionAlreadyExists):
+			return nil, overlappingNames(service)
+		}
+		return nil, fmt.Errorf("failed to update service: %w", err)
+	}
+
+	return &service, nil
+}
+
+// Delete removes a service from the catalog. Its subscriptions keep their titles.
+func (r *Catalog) Delete(ctx context.Context, id string) error {
+	if err := r.authorize(ctx); err != nil {
+		return err
+	}
+
+	if err := r.serviceRepo.DeleteService(ctx, id); err != nil {
+		if errors.Is(err, port.ErrNotFound) {
+			return ErrServiceNotFound
+		}
+		return fmt.Errorf("failed to delete service: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Catalog) List(ctx context.Context, filter entity.ServiceFilter) ([]entity.Service, error) {
+	if _, err := tenantPrincipalFrom(ctx); err != nil {
+		return nil, err
+	}
+
+	services, err := r.serviceRepo.ListServices(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+
+	return services, nil
+}
+
+// authorize makes sure the principal is an admin of the tenant.
+func (r *Catalog) authorize(ctx context.Context) error {
+	principal, err := tenantPrincipalFrom(ctx)
+	if err != nil {
+		return err
+	}
+	if !principal.IsAdmin() {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+// validateService checks that the names of a service are distinct and the vendor URL,
+// when set, is an absolute http or https URL.
+func validateService(s entity.Service) error {
+	names := s.Names()
+	for i, name := range names {
+		if strings.TrimSpace(name) != name || name == "" {
+			if i == 0 {
+				return fmt.Errorf("%w: the name is blank or starts or ends with a space", ErrInvalidService)
+			}
+			return fmt.Errorf("%w: alias %d is blank or starts or ends with a space", ErrInvalidService, i)
+		}
+		if slices.ContainsFunc(names[:i], func(n string) bool { return entity.ServiceKey(n) == entity.ServiceKey(name) }) {
+			return fmt.Errorf("%w: the name %q is listed twice", ErrInvalidService, name)
+		}
+	}
+
+	switch {
+	case s.Category != strings.TrimSpace(s.Category):
+		return fmt.Errorf("%w: the category starts or ends with a space", ErrInvalidService)
+	case s.DefaultPrice != nil && *s.DefaultPrice < 0:
+		return fmt.Errorf("%w: the default price is negative", ErrInvalidService)
+	case s.VendorURL == "":
+		return nil
+	}
+
+	u, err := url.Parse(s.VendorURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: the vendor URL %q is not an absolute http or https URL", ErrInvalidService, s.VendorURL)
+	}
+
+	return nil
+}
+
+func serviceNames(s entity.Service) string {
+	names := s.Names()
+
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = fmt.Sprintf("%q", name)
+	}
+
+	return strings.Join(quoted, " or ")
+}
+
+// overlappingNames explains a service write rejected because the subscriptions it would
+// link make one service overlap for a user.
+func overlappingNames(s entity.Service) error {
+	return fmt.Errorf("%w: subscriptions of a user titled %s overlap and can't become one service",
+		ErrSubscriptionAlreadyExists, serviceNames(s))
+}
+
+// resolveService links a subscription to the catalog: to the service of its ServiceID,
+// or else to the service its title is a name of, and gives it the name of the service
+// as its title. It returns the service, or nil for titles that are not in the catalog,
+// which stay as they are, unlinked. Without a service repository nothing is resolved.
+func (r *Subscription) resolveService(ctx context.Context, post *entity.UpdateSubscriptionRequest) (*entity.Service, error) {
+	if r.serviceRepo == nil {
+		return nil, nil
+	}
+
+	var (
+		service *entity.Service
+		err     error
+	)
+	if post.ServiceID != nil {
+		service, err = r.serviceRepo.GetService(ctx, *post.ServiceID)
+		if errors.Is(err, port.ErrNotFound) {
+			return nil, unknownService(*post.ServiceID)
+		}
+	} else {
+		service, err = r.serviceRepo.FindService(ctx, post.Title)
+		if errors.Is(err, port.ErrNotFound) {
+			return nil, nil
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve service: %w", err)
+	}
+
+	post.ServiceID, post.Title = &service.ID, service.Name
+
+	return service, nil
+}
+
+// unknownService is the error of a subscription to a service that is not in the
+// catalog, such as one deleted while the subscription was being written.
+func unknownService(id string) error {
+	return fmt.Errorf("%w: service %s is not in the catalog", ErrInvalidSubscriptionData, id)
+}
+
+// resolveTitle turns a filter by a title in the catalog into a filter by its service,
+// so that the subscriptions created under any of its names match. It returns the title
+// and service filters to use. Without a service repository titles stay as they are.
+func resolveTitle(ctx context.Context, serviceRepo port.ServiceRepo, title *string) (*string, *string, error) {
+	if serviceRepo == nil || title == nil {
+		return title, nil, nil
+	}
+
+	service, err := serviceRepo.FindService(ctx, *title)
+	if err != nil {
+		if errors.Is(err, port.ErrNotFound) {
+			return title, nil, nil
+		}
+		return nil, nil, fmt.Errorf("failed to resolve service: %w", err)
+	}
+
+	return nil, &service.ID, nil
+}
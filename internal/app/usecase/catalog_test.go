@@ -0,0 +1,306 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	repo "subscription-service/internal/adapter/repo/mock"
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/app/usecase"
+	"subscription-service/internal/port"
+)
+
+func newCatalog(t *testing.T) (*usecase.Catalog, *repo.MockServiceRepo) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	serviceRepo := repo.NewMockServiceRepo(ctrl)
+	catalogUsecase, err := usecase.NewCatalog(serviceRepo, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return catalogUsecase, serviceRepo
+}
+
+func TestCatalogCreate(t *testing.T) {
+	plus := entity.Service{Name: "Yandex Plus", Aliases: []string{"Яндекс Плюс"}, VendorURL: "https://plus.yandex.ru"}
+
+	t.Run("admin", func(t *testing.T) {
+		catalogUsecase, serviceRepo := newCatalog(t)
+		ctx := adminContext()
+
+		serviceRepo.EXPECT().CreateService(ctx, gomock.Any()).Return(nil)
+
+		created, err := catalogUsecase.Create(ctx, plus)
+		if err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		if created.ID == "" || created.Currency != entity.DefaultCurrency {
+			t.Errorf("created = %+v, want an id and the default currency", created)
+		}
+	})
+
+	t.Run("user", func(t *testing.T) {
+		catalogUsecase, _ := newCatalog(t)
+
+		if _, err := catalogUsecase.Create(userContext(ownerID), plus); !errors.Is(err, usecase.ErrForbidden) {
+			t.Errorf("create by a user: %v, want %v", err, usecase.ErrForbidden)
+		}
+	})
+
+	t.Run("taken name", func(t *testing.T) {
+		catalogUsecase, serviceRepo := newCatalog(t)
+		ctx := adminContext()
+
+		serviceRepo.EXPECT().CreateService(ctx, gomock.Any()).Return(port.ErrServiceAlreadyExists)
+
+		if _, err := catalogUsecase.Create(ctx, plus); !errors.Is(err, usecase.ErrServiceAlreadyExists) {
+			t.Errorf("create: %v, want %v", err, usecase.ErrServiceAlreadyExists)
+		}
+	})
+
+	t.Run("overlapping subscriptions", func(t *testing.T) {
+		catalogUsecase, serviceRepo := newCatalog(t)
+		ctx := adminContext()
+
+		serviceRepo.EXPECT().CreateService(ctx, gomock.Any()).Return(port.ErrSubscriptionAlreadyExists)
+
+		if _, err := catalogUsecase.Create(ctx, plus); !errors.Is(err, usecase.ErrSubscriptionAlreadyExists) {
+			t.Errorf("create: %v, want %v", err, usecase.ErrSubscriptionAlreadyExists)
+		}
+	})
+}
+
+func TestCatalogValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		service entity.Service
+	}{
+		{"blank name", entity.Service{Name: " "}},
+		{"padded alias", entity.Service{Name: "Okko", Aliases: []string{"okko tv "}}},
+		{"alias repeats the name", entity.Service{Name: "Okko", Aliases: []string{"OKKO"}}},
+		{"padded category", entity.Service{Name: "Okko", Category: " video"}},
+		{"negative price", entity.Service{Name: "Okko", DefaultPrice: ptr(int64(-1))}},
+		{"relative vendor url", entity.Service{Name: "Okko", VendorURL: "okko.tv"}},
+		{"vendor url of another scheme", entity.Service{Name: "Okko", VendorURL: "ftp://okko.tv"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalogUsecase, _ := newCatalog(t)
+
+			if _, err := catalogUsecase.Create(adminContext(), tt.service); !errors.Is(err, usecase.ErrInvalidService) {
+				t.Errorf("create: %v, want %v", err, usecase.ErrInvalidService)
+			}
+		})
+	}
+}
+
+func TestCatalogReadByUser(t *testing.T) {
+	catalogUsecase, serviceRepo := newCatalog(t)
+	ctx := userContext(ownerID)
+
+	serviceRepo.EXPECT().GetService(ctx, "missing").Return(nil, port.ErrNotFound)
+
+	if _, err := catalogUsecase.Read(ctx, "missing"); !errors.Is(err, usecase.ErrServiceNotFound) {
+		t.Errorf("read: %v, want %v", err, usecase.ErrServiceNotFound)
+	}
+	if err := catalogUsecase.Delete(ctx, "missing"); !errors.Is(err, usecase.ErrForbidden) {
+		t.Errorf("delete by a user: %v, want %v", err, usecase.ErrForbidden)
+	}
+}
+
+func TestCreateResolvesService(t *testing.T) {
+	plus := &entity.Service{ID: "plus", Name: "Yandex Plus", Aliases: []string{"Plus"}}
+
+	newUsecase := func(t *testing.T) (*usecase.Subscription, *repo.MockSubscriptionRepo, *repo.MockServiceRepo) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		subscriptionRepo, serviceRepo := repo.NewMockSubscriptionRepo(ctrl), repo.NewMockServiceRepo(ctrl)
+		subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, serviceRepo, nil, zap.NewNop())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return subscriptionUsecase, subscriptionRepo, serviceRepo
+	}
+
+	tests := []struct {
+		name      string
+		post      entity.CreateSubscriptionRequest
+		found     *entity.Service
+		wantTitle string
+		wantID    *string
+	}{
+		{"alias", entity.CreateSubscriptionRequest{Title: "plus"}, plus, "Yandex Plus", &plus.ID},
+		{"service id", entity.CreateSubscriptionRequest{Title: "Кинопоиск", ServiceID: &plus.ID}, plus, "Yandex Plus", &plus.ID},
+		{"not in the catalog", entity.CreateSubscriptionRequest{Title: "Ivi"}, nil, "Ivi", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscriptionUsecase, subscriptionRepo, serviceRepo := newUsecase(t)
+			ctx := adminContext()
+
+			post := tt.post
+			post.UserID, post.Price, post.StartDate = ownerID, 400, month(2025, time.January)
+
+			if post.ServiceID != nil {
+				serviceRepo.EXPECT().GetService(ctx, *post.ServiceID).Return(tt.found, nil)
+			} else if tt.found != nil {
+				serviceRepo.EXPECT().FindService(ctx, post.Title).Return(tt.found, nil)
+			} else {
+				serviceRepo.EXPECT().FindService(ctx, post.Title).Return(nil, port.ErrNotFound)
+			}
+			subscriptionRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, stored entity.CreateSubscriptionRequest) error {
+					if stored.Title != tt.wantTitle || (stored.ServiceID == nil) != (tt.wantID == nil) ||
+						(stored.ServiceID != nil && *stored.ServiceID != *tt.wantID) {
+						t.Errorf("stored %q of %v, want %q of %v", stored.Title, stored.ServiceID, tt.wantTitle, tt.wantID)
+					}
+					return nil
+				})
+
+			if _, err := subscriptionUsecase.Create(ctx, post); err != nil {
+				t.Fatalf("create: %v", err)
+			}
+		})
+	}
+
+	t.Run("unknown service id", func(t *testing.T) {
+		subscriptionUsecase, _, serviceRepo := newUsecase(t)
+		ctx := adminContext()
+
+		serviceRepo.EXPECT().GetService(ctx, "missing").Return(nil, port.ErrNotFound)
+
+		post := entity.CreateSubscriptionRequest{
+			Title: "Ivi", ServiceID: ptr("missing"), UserID: ownerID, Price: 400, StartDate: month(2025, time.January),
+		}
+		if _, err := subscriptionUsecase.Create(ctx, post); !errors.Is(err, usecase.ErrInvalidSubscriptionData) {
+			t.Errorf("create: %v, want %v", err, usecase.ErrInvalidSubscriptionData)
+		}
+	})
+
+	t.Run("service deleted meanwhile", func(t *testing.T) {
+		subscriptionUsecase, subscriptionRepo, serviceRepo := newUsecase(t)
+		ctx := adminContext()
+
+		serviceRepo.EXPECT().FindService(ctx, "plus").Return(plus, nil)
+		subscriptionRepo.EXPECT().Create(ctx, gomock.Any()).Return(port.ErrServiceNotFound)
+
+		post := entity.CreateSubscriptionRequest{Title: "plus", UserID: ownerID, Price: 400, StartDate: month(2025, time.January)}
+		if _, err := subscriptionUsecase.Create(ctx, post); !errors.Is(err, usecase.ErrInvalidSubscriptionData) {
+			t.Errorf("create: %v, want %v", err, usecase.ErrInvalidSubscriptionData)
+		}
+	})
+
+	t.Run("default price", func(t *testing.T) {
+		subscriptionUsecase, subscriptionRepo, serviceRepo := newUsecase(t)
+		ctx := adminContext()
+
+		priced := *plus
+		priced.DefaultPrice = ptr(int64(350))
+		serviceRepo.EXPECT().FindService(ctx, "plus").Return(&priced, nil)
+		subscriptionRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, stored entity.CreateSubscriptionRequest) error {
+				if stored.Price != 350 {
+					t.Errorf("stored price %d, want the default price 350", stored.Price)
+				}
+				return nil
+			})
+
+		post := entity.CreateSubscriptionRequest{
+			Title: "plus", UserID: ownerID, Price: entity.NoPrice, StartDate: month(2025, time.January),
+		}
+		if created, err := subscriptionUsecase.Create(ctx, post); err != nil || created.Price != 350 {
+			t.Errorf("create = %+v, %v, want the default price 350", created, err)
+		}
+	})
+
+	t.Run("no price and no default price", func(t *testing.T) {
+		subscriptionUsecase, _, serviceRepo := newUsecase(t)
+		ctx := adminContext()
+
+		serviceRepo.EXPECT().FindService(ctx, "plus").Return(plus, nil)
+		serviceRepo.EXPECT().FindService(ctx, "Ivi").Return(nil, port.ErrNotFound)
+
+		for _, title := range []string{"plus", "Ivi"} {
+			post := entity.CreateSubscriptionRequest{
+				Title: title, UserID: ownerID, Price: entity.NoPrice, StartDate: month(2025, time.January),
+			}
+			if _, err := subscriptionUsecase.Create(ctx, post); !errors.Is(err, usecase.ErrInvalidSubscriptionData) {
+				t.Errorf("create %s without a price: %v, want %v", title, err, usecase.ErrInvalidSubscriptionData)
+			}
+		}
+	})
+
+	t.Run("sum by an alias", func(t *testing.T) {
+		subscriptionUsecase, subscriptionRepo, serviceRepo := newUsecase(t)
+		ctx := adminContext()
+
+		serviceRepo.EXPECT().FindService(ctx, "plus").Return(plus, nil)
+		subscriptionRepo.EXPECT().Sum(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, filter entity.ListSubscriptionFilter) (int64, error) {
+				if filter.Title != nil || filter.ServiceID == nil || *filter.ServiceID != plus.ID {
+					t.Errorf("filter = %+v, want service %s only", filter, plus.ID)
+				}
+				return 800, nil
+			})
+
+		if sum, err := subscriptionUsecase.Sum(ctx, entity.ListSubscriptionFilter{Title: ptr("plus")}); err != nil || sum != 800 {
+			t.Errorf("sum = %d, %v, want 800", sum, err)
+		}
+	})
+	t.Run("cancellations by an alias", func(t *testing.T) {
+		subscriptionUsecase, subscriptionRepo, serviceRepo := newUsecase(t)
+		ctx := adminContext()
+
+		serviceRepo.EXPECT().FindService(ctx, "plus").Return(plus, nil)
+		subscriptionRepo.EXPECT().CountCancellations(ctx, entity.CancellationFilter{ServiceID: &plus.ID}).Return(nil, nil)
+
+		if _, err := subscriptionUsecase.CountCancellations(ctx, entity.CancellationFilter{Title: ptr("plus")}); err != nil {
+			t.Errorf("count cancellations: %v", err)
+		}
+	})
+}
+
+func TestAnalyticsResolveAliases(t *testing.T) {
+	plus := &entity.Service{ID: "plus", Name: "Yandex Plus", Aliases: []string{"Plus"}}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	analyticsRepo := repo.NewMockAnalyticsRepo(ctrl)
+	subscriptionRepo, serviceRepo := repo.NewMockSubscriptionRepo(ctrl), repo.NewMockServiceRepo(ctrl)
+
+	analyticsUsecase, err := usecase.NewAnalytics(analyticsRepo, subscriptionRepo, serviceRepo,
+		func() time.Time { return month(2025, time.April) }, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := adminContext()
+	from, to := month(2025, time.January), month(2025, time.March)
+
+	serviceRepo.EXPECT().FindService(ctx, "plus").Return(plus, nil).Times(2)
+	analyticsRepo.EXPECT().MonthlyMetrics(ctx, entity.MetricsFilter{ServiceID: &plus.ID, From: from, To: to}).
+		Return(nil, nil)
+	subscriptionRepo.EXPECT().ListActive(ctx, entity.PeriodFilter{ServiceID: &plus.ID, From: from, To: to}).
+		Return(nil, nil)
+
+	if _, err := analyticsUsecase.Metrics(ctx, entity.MetricsFilter{Title: ptr("plus"), From: from, To: to}); err != nil {
+		t.Errorf("metrics: %v", err)
+	}
+	if _, err := analyticsUsecase.Cohorts(ctx, entity.CohortFilter{Title: ptr("plus"), From: from, To: to}); err != nil {
+		t.Errorf("cohorts: %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"subscription-service/internal/app/entity"
+)
+
+// Cohorts reports the retention of the subscriptions starting in the months of the filter
+// as of the current month, see RetentionCohorts. Like Metrics it spans all users of the
+// tenant, so only admins may see it.
+func (r *Analytics) Cohorts(ctx context.Context, filter entity.CohortFilter) (*entity.CohortReport, error) {
+	principal, err := tenantPrincipalFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !principal.IsAdmin() {
+		return nil, ErrForbidden
+	}
+
+	asOf := entity.MonthStart(r.now())
+	from, to := entity.MonthStart(filter.From), entity.MonthStart(filter.To)
+
+	report := &entity.CohortReport{AsOf: asOf, Cohorts: []entity.Cohort{}}
+	if to.After(asOf) {
+		to = asOf
+	}
+	if to.Before(from) {
+		return report, nil
+	}
+
+	if filter.Title, filter.ServiceID, err = resolveTitle(ctx, r.serviceRepo, filter.Title); err != nil {
+		return nil, err
+	}
+
+	subs, err := r.subscriptionRepo.ListActive(ctx, entity.PeriodFilter{
+		Title: filter.Title, ServiceID: filter.ServiceID, From: from, To: to,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active subscriptions: %w", err)
+	}
+
+	subs = slices.DeleteFunc(subs, func(s entity.Subscription) bool {
+		return entity.MonthStart(s.StartDate).Before(from)
+	})
+
+	report.Cohorts = RetentionCohorts(subs, asOf)
+
+	return report, nil
+}
+
+// RetentionCohorts groups the subscriptions by the month they start in and counts, for
+// each month after it up to the month of now, the subscriptions of the group still
+// running then: ones that end in that month or later. Subscriptions without an end date
+// run until now, and ones starting after the month of now are left out. Cohorts are in
+// order of their months; months nobody starts in are left out.
+func RetentionCohorts(subs []entity.Subscription, now time.Time) []entity.Cohort {
+	now = entity.MonthStart(now)
+
+	byMonth := map[time.Time]*entity.Cohort{}
+	for _, sub := range subs {
+		start := entity.MonthStart(sub.StartDate)
+		if start.After(now) {
+			continue
+		}
+
+		cohort, ok := byMonth[start]
+		if !ok {
+			cohort = &entity.Cohort{Month: start, Retained: make([]int64, entity.MonthsBetween(start, now)-1)}
+			byMonth[start] = cohort
+		}
+		cohort.Size++
+
+		last := now
+		if sub.EndDate != nil && entity.MonthStart(*sub.EndDate).Before(now) {
+			last = entity.MonthStart(*sub.EndDate)
+		}
+		for i := 0; i < entity.MonthsBetween(start, last)-1; i++ {
+			cohort.Retained[i]++
+		}
+	}
+
+	cohorts := make([]entity.Cohort, 0, len(byMonth))
+	for _, c := range byMonth {
+		cohorts = append(cohorts, *c)
+	}
+	slices.SortFunc(cohorts, func(a, b entity.Cohort) int { return a.Month.Compare(b.Month) })
+
+	return cohorts
+}
@@ -0,0 +1,99 @@
+package usecase_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"go.uber.org/zap"
+
+	repo "subscription-service/internal/adapter/repo/mock"
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/app/usecase"
+)
+
+var cohortNow = month(2025, time.April).Add(20 * 24 * time.Hour)
+
+// cohortSubscriptions start in 01-2025 and 03-2025, as of 04-2025:
+//
+//	01-2025: one ends in 01-2025, one in 02-2025, one runs on, and one ends in 06-2025
+//	03-2025: one ends in 03-2025 and one runs on
+func cohortSubscriptions() []entity.Subscription {
+	return []entity.Subscription{
+		{ID: "a", StartDate: month(2025, time.January), EndDate: ptr(month(2025, time.January))},
+		{ID: "b", StartDate: month(2025, time.January), EndDate: ptr(month(2025, time.February))},
+		{ID: "c", StartDate: month(2025, time.January)},
+		{ID: "d", StartDate: month(2025, time.January), EndDate: ptr(month(2025, time.June))},
+		{ID: "e", StartDate: month(2025, time.March), EndDate: ptr(month(2025, time.March))},
+		{ID: "f", StartDate: month(2025, time.March)},
+	}
+}
+
+func TestRetentionCohorts(t *testing.T) {
+	subs := append(cohortSubscriptions(),
+		entity.Subscription{ID: "later", StartDate: month(2025, time.May)})
+
+	got := usecase.RetentionCohorts(subs, cohortNow)
+
+	want := []entity.Cohort{
+		{Month: month(2025, time.January), Size: 4, Retained: []int64{3, 2, 2}},
+		{Month: month(2025, time.March), Size: 2, Retained: []int64{1}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("cohorts = %+v, want %+v", got, want)
+	}
+
+	if shares := got[0].Retention(); !reflect.DeepEqual(shares, []float64{0.75, 0.5, 0.5}) {
+		t.Errorf("retention of 01-2025 = %v, want 0.75, 0.5, 0.5", shares)
+	}
+
+	current := usecase.RetentionCohorts([]entity.Subscription{{StartDate: month(2025, time.April)}}, cohortNow)
+	if len(current) != 1 || current[0].Size != 1 || len(current[0].Retained) != 0 {
+		t.Errorf("cohort of the current month = %+v, want one subscription and no months", current)
+	}
+}
+
+func TestCohorts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	subscriptionRepo := repo.NewMockSubscriptionRepo(ctrl)
+
+	analyticsUsecase, err := usecase.NewAnalytics(nil, subscriptionRepo, nil, func() time.Time { return cohortNow },
+		zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := analyticsUsecase.Cohorts(userContext(ownerID), entity.CohortFilter{}); !errors.Is(err, usecase.ErrForbidden) {
+		t.Errorf("cohorts for a user: %v, want %v", err, usecase.ErrForbidden)
+	}
+
+	ctx := adminContext()
+	title := "Okko"
+
+	// The period ends with the current month, and subscriptions active in it that started
+	// earlier are not a cohort of it.
+	subscriptionRepo.EXPECT().ListActive(ctx, entity.PeriodFilter{
+		Title: &title, From: month(2025, time.February), To: month(2025, time.April),
+	}).Return(cohortSubscriptions(), nil)
+
+	report, err := analyticsUsecase.Cohorts(ctx, entity.CohortFilter{
+		Title: &title, From: month(2025, time.February), To: month(2025, time.December),
+	})
+	if err != nil {
+		t.Fatalf("cohorts: %v", err)
+	}
+
+	want := []entity.Cohort{{Month: month(2025, time.March), Size: 2, Retained: []int64{1}}}
+	if !report.AsOf.Equal(month(2025, time.April)) || !reflect.DeepEqual(report.Cohorts, want) {
+		t.Errorf("report = %+v, want %+v as of 04-2025", report, want)
+	}
+
+	report, err = analyticsUsecase.Cohorts(ctx, entity.CohortFilter{From: month(2025, time.May), To: month(2025, time.June)})
+	if err != nil || len(report.Cohorts) != 0 {
+		t.Errorf("cohorts after now = %+v, %v, want none", report, err)
+	}
+}
@@ -6,7 +6,26 @@ var (
 	ErrSubscriptionNotFound      = errors.New("subscription not found")
 	ErrSubscriptionAlreadyExists = errors.New("subscription already exists")
 	ErrInvalidSubscriptionData   = errors.New("invalid subscription data")
+	ErrSubscriptionEnded         = errors.New("subscription has already ended")
+	ErrUnknownCancelReason       = errors.New("unknown cancellation reason")
 
-	ErrNotFound           = errors.New("subscription not found")
-	ErrTransactionFailure = errors.New("transaction failure")
+	ErrInvalidReminderPreferences = errors.New("invalid reminder preferences")
+
+	ErrBudgetNotFound      = errors.New("budget not found")
+	ErrBudgetAlreadyExists = errors.New("budget already exists")
+	ErrInvalidBudget       = errors.New("invalid budget")
+	ErrBudgetExceeded      = errors.New("budget exceeded")
+
+	ErrServiceNotFound      = errors.New("service not found")
+	ErrServiceAlreadyExists = errors.New("service already exists")
+	ErrInvalidService       = errors.New("invalid service")
+
+	ErrNotFound = errors.New("subscription not found")
+
+	ErrUnauthenticated   = errors.New("authentication required")
+	ErrForbidden         = errors.New("access denied")
+	ErrAPIKeyNotFound    = errors.New("api key not found")
+	ErrInvalidAPIKeyData = errors.New("invalid api key data")
+
+	ErrTenantRequired = errors.New("tenant is required")
 )
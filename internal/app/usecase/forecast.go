@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"subscription-service/internal/app/entity"
+)
+
+// Forecast projects the cost of req.Months months from the month of req.Now, see
+// ProjectCosts. Users other than admins get the forecast of their own subscriptions.
+func (r *Subscription) Forecast(ctx context.Context, req entity.ForecastRequest) ([]entity.ForecastMonth, error) {
+	principal, err := tenantPrincipalFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !principal.IsAdmin() {
+		req.UserID = &principal.UserID
+	}
+
+	from := entity.MonthStart(req.Now)
+
+	subs, err := r.subscriptionRepo.ListActive(ctx, entity.PeriodFilter{
+		UserID: req.UserID,
+		From:   from,
+		To:     entity.AddMonths(from, req.Months-1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active subscriptions: %w", err)
+	}
+
+	return ProjectCosts(subs, from, req.Months, req.GrowthPercent), nil
+}
+
+// ProjectCosts projects the cost of each of the months months from the month of from.
+// A subscription is billed as PriceAt has it, so end dates, pauses and phases are taken
+// into account; one that renews automatically and is not cancelled goes on past its end
+// date in terms of its renewal. When growthPercent is not zero, the regular price of a
+// subscription without an end date rises by that percent every twelve months of the
+// forecast.
+func ProjectCosts(subs []entity.Subscription, from time.Time, months, growthPercent int) []entity.ForecastMonth {
+	from = entity.MonthStart(from)
+	to := entity.AddMonths(from, months-1)
+
+	forecast := make([]entity.ForecastMonth, 0, max(months, 0))
+	for i := 0; i < months; i++ {
+		forecast = append(forecast, entity.ForecastMonth{Month: entity.AddMonths(from, i)})
+	}
+
+	for _, sub := range subs {
+		projected, lastEnd := renewedUntil(sub, to)
+
+		for i := range forecast {
+			month := forecast[i].Month
+
+			price, ok := projected.PriceAt(month)
+			if !ok {
+				continue
+			}
+
+			item := entity.ForecastItem{
+				SubscriptionID: sub.ID,
+				Title:          sub.Title,
+				Price:          price,
+				Renewed:        lastEnd != nil && month.After(*lastEnd),
+			}
+
+			if years := i / 12; sub.EndDate == nil && growthPercent != 0 && years > 0 && !inPhase(sub, month) {
+				item.Price = grow(price, growthPercent, years)
+				item.Grown = true
+			}
+
+			forecast[i].Cost += item.Price
+			forecast[i].Items = append(forecast[i].Items, item)
+		}
+	}
+
+	for i := range forecast {
+		slices.SortFunc(forecast[i].Items, func(a, b entity.ForecastItem) int {
+			return cmp.Or(cmp.Compare(b.Price, a.Price), cmp.Compare(a.Title, b.Title),
+				cmp.Compare(a.SubscriptionID, b.SubscriptionID))
+		})
+	}
+
+	return forecast
+}
+
+// renewedUntil extends a subscription that renews automatically by its terms until it
+// lasts through the month to. It also returns the end date the subscription has now, or
+// nil when it does not renew.
+func renewedUntil(sub entity.Subscription, to time.Time) (entity.Subscription, *time.Time) {
+	if sub.Renewal == nil || sub.Cancellation != nil || sub.EndDate == nil || sub.Renewal.TermMonths < 1 {
+		return sub, nil
+	}
+
+	lastEnd := entity.MonthStart(*sub.EndDate)
+
+	end := lastEnd
+	for end.Before(to) {
+		end = entity.AddMonths(end, sub.Renewal.TermMonths)
+	}
+	sub.EndDate = &end
+
+	return sub, &lastEnd
+}
+
+// inPhase reports whether month falls in one of the phases of the subscription.
+func inPhase(sub entity.Subscription, month time.Time) bool {
+	return slices.ContainsFunc(sub.Phases, func(p entity.Phase) bool {
+		return !month.Before(entity.MonthStart(p.StartDate)) && !month.After(entity.MonthStart(p.EndDate))
+	})
+}
+
+// grow raises price by percent for each of years, rounded to a whole amount.
+func grow(price int64, percent, years int) int64 {
+	return int64(math.Round(float64(price) * math.Pow(1+float64(percent)/100, float64(years))))
+}
@@ -0,0 +1,170 @@
+package usecase_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/app/usecase"
+)
+
+func TestProjectCosts(t *testing.T) {
+	from := month(2026, time.March)
+
+	tests := []struct {
+		name   string
+		sub    entity.Subscription
+		growth int
+		months int
+		want   []entity.ForecastItem // one per month, a zero Price for none
+	}{
+		{
+			name: "end date",
+			sub: entity.Subscription{
+				Title: "Okko", Price: 400, StartDate: month(2025, time.January), EndDate: ptr(month(2026, time.April)),
+			},
+			months: 3,
+			want:   []entity.ForecastItem{{Price: 400}, {Price: 400}, {}},
+		},
+		{
+			name:   "starts later",
+			sub:    entity.Subscription{Title: "Okko", Price: 400, StartDate: month(2026, time.May)},
+			months: 3,
+			want:   []entity.ForecastItem{{}, {}, {Price: 400}},
+		},
+		{
+			name: "price change after a discount",
+			sub: entity.Subscription{
+				Title: "Okko", Price: 400, StartDate: month(2026, time.March),
+				Phases: []entity.Phase{{StartDate: month(2026, time.March), EndDate: month(2026, time.April), Price: 200}},
+			},
+			growth: 10,
+			months: 3,
+			want:   []entity.ForecastItem{{Price: 200}, {Price: 200}, {Price: 400}},
+		},
+		{
+			name: "pause",
+			sub: entity.Subscription{
+				Title: "Okko", Price: 400, StartDate: month(2025, time.January),
+				Pauses: []entity.Pause{{StartDate: month(2026, time.April), EndDate: ptr(month(2026, time.April))}},
+			},
+			months: 3,
+			want:   []entity.ForecastItem{{Price: 400}, {}, {Price: 400}},
+		},
+		{
+			name: "renewal",
+			sub: entity.Subscription{
+				Title: "Okko", Price: 400, StartDate: month(2025, time.May), EndDate: ptr(month(2026, time.March)),
+				Renewal: &entity.Renewal{TermMonths: 1},
+			},
+			months: 3,
+			want:   []entity.ForecastItem{{Price: 400}, {Price: 400, Renewed: true}, {Price: 400, Renewed: true}},
+		},
+		{
+			name: "cancelled renewal",
+			sub: entity.Subscription{
+				Title: "Okko", Price: 400, StartDate: month(2025, time.May), EndDate: ptr(month(2026, time.March)),
+				Renewal:      &entity.Renewal{TermMonths: 12},
+				Cancellation: &entity.Cancellation{Reason: "other"},
+			},
+			months: 2,
+			want:   []entity.ForecastItem{{Price: 400}, {}},
+		},
+		{
+			name:   "growth of an open-ended subscription",
+			sub:    entity.Subscription{Title: "Okko", Price: 400, StartDate: month(2025, time.January)},
+			growth: 10,
+			months: 25,
+			want: append(append(
+				slices.Repeat([]entity.ForecastItem{{Price: 400}}, 12),
+				slices.Repeat([]entity.ForecastItem{{Price: 440, Grown: true}}, 12)...),
+				entity.ForecastItem{Price: 484, Grown: true}),
+		},
+		{
+			name: "no growth with an end date",
+			sub: entity.Subscription{
+				Title: "Okko", Price: 400, StartDate: month(2025, time.January), EndDate: ptr(month(2030, time.January)),
+			},
+			growth: 10,
+			months: 13,
+			want:   slices.Repeat([]entity.ForecastItem{{Price: 400}}, 13),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.sub.ID = "okko"
+
+			forecast := usecase.ProjectCosts([]entity.Subscription{tt.sub}, from, tt.months, tt.growth)
+			if len(forecast) != tt.months {
+				t.Fatalf("got %d months, want %d", len(forecast), tt.months)
+			}
+
+			for i, m := range forecast {
+				if want := entity.AddMonths(from, i); !m.Month.Equal(want) {
+					t.Errorf("month %d is %s, want %s", i, m.Month, want)
+				}
+
+				want := tt.want[i]
+				if want == (entity.ForecastItem{}) {
+					if m.Cost != 0 || len(m.Items) != 0 {
+						t.Errorf("%s = %d from %+v, want nothing", m.Month.Format("01-2006"), m.Cost, m.Items)
+					}
+					continue
+				}
+
+				want.SubscriptionID, want.Title = "okko", "Okko"
+				if m.Cost != want.Price || len(m.Items) != 1 || m.Items[0] != want {
+					t.Errorf("%s = %d from %+v, want %+v", m.Month.Format("01-2006"), m.Cost, m.Items, want)
+				}
+			}
+		})
+	}
+}
+
+func TestProjectCostsOrdersItems(t *testing.T) {
+	subs := []entity.Subscription{
+		{ID: "kion", Title: "Kion", Price: 300, StartDate: month(2026, time.January)},
+		{ID: "okko", Title: "Okko", Price: 400, StartDate: month(2026, time.January)},
+		{ID: "ivi", Title: "Ivi", Price: 300, StartDate: month(2026, time.January)},
+	}
+
+	forecast := usecase.ProjectCosts(subs, month(2026, time.March), 1, 0)
+
+	var titles []string
+	for _, item := range forecast[0].Items {
+		titles = append(titles, item.Title)
+	}
+	if forecast[0].Cost != 1000 || !slices.Equal(titles, []string{"Okko", "Ivi", "Kion"}) {
+		t.Errorf("forecast = %d from %v, want 1000 from Okko, Ivi and Kion", forecast[0].Cost, titles)
+	}
+}
+
+func TestForecastIsScopedToUser(t *testing.T) {
+	subscriptionUsecase, mocks := newAuthzUsecase(t)
+	ctx := userContext(ownerID)
+
+	mocks.subscriptionRepo.EXPECT().ListActive(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, filter entity.PeriodFilter) ([]entity.Subscription, error) {
+			if filter.UserID == nil || *filter.UserID != ownerID {
+				t.Errorf("filter user = %v, want %s", filter.UserID, ownerID)
+			}
+			if !filter.From.Equal(month(2026, time.March)) || !filter.To.Equal(month(2027, time.February)) {
+				t.Errorf("filter = %s to %s, want 03-2026 to 02-2027", filter.From, filter.To)
+			}
+			return nil, nil
+		})
+
+	forecast, err := subscriptionUsecase.Forecast(ctx, entity.ForecastRequest{
+		UserID: &otherID,
+		Now:    month(2026, time.March).Add(10 * 24 * time.Hour),
+		Months: 12,
+	})
+	if err != nil || len(forecast) != 12 {
+		t.Errorf("forecast = %d months, %v, want 12", len(forecast), err)
+	}
+}
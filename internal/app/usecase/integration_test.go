@@ -14,6 +14,7 @@ import (
 	"subscription-service/internal/app/entity"
 	"subscription-service/internal/app/usecase"
 	pkg "subscription-service/internal/pkg/utils"
+	"subscription-service/internal/port"
 )
 
 func TestCreate(t *testing.T) {
@@ -27,7 +28,7 @@ func TestCreate(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, logger)
+	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, nil, nil, logger)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -42,7 +43,7 @@ func TestCreate(t *testing.T) {
 		UpdatedAt: time.Now().UnixMilli(),
 	}
 
-	ctx := context.Background()
+	ctx := adminContext()
 
 	subscriptionRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
 		func(ctx context.Context,
@@ -78,7 +79,7 @@ func TestCreateWithRepoError(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, logger)
+	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, nil, nil, logger)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -93,7 +94,7 @@ func TestCreateWithRepoError(t *testing.T) {
 		UpdatedAt: time.Now().UnixMilli(),
 	}
 
-	ctx := context.Background()
+	ctx := adminContext()
 
 	expectedErr := errors.New("database error")
 	subscriptionRepo.EXPECT().Create(ctx, gomock.Any()).Return(expectedErr)
@@ -115,7 +116,7 @@ func TestRead(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, logger)
+	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, nil, nil, logger)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -128,7 +129,7 @@ func TestRead(t *testing.T) {
 		UserID: "user123",
 	}
 
-	ctx := context.Background()
+	ctx := adminContext()
 
 	subscriptionRepo.EXPECT().GetSubscription(ctx, subscriptionID).Return(expectedSubscription, nil)
 
@@ -153,14 +154,14 @@ func TestReadWithNotFound(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, logger)
+	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, nil, nil, logger)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	subscriptionID := uuid.NewString()
 
-	ctx := context.Background()
+	ctx := adminContext()
 
 	expectedErr := errors.New("not found")
 	subscriptionRepo.EXPECT().GetSubscription(ctx, subscriptionID).Return(nil, expectedErr)
@@ -182,26 +183,27 @@ func TestUpdate(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	transactionController := repo.NewMockTransactionController(ctrl)
-
-	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, transactionController, logger)
+	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, nil, nil, logger)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	mockTransaction := repo.NewMockTransaction(ctrl)
-
 	updateRequest := entity.UpdateSubscriptionRequest{
 		ID:    uuid.NewString(),
 		Title: "Updated Premium",
 		Price: 1500,
 	}
 
-	ctx := context.Background()
+	ctx := adminContext()
 
-	subscriptionRepo.EXPECT().Update(ctx, updateRequest).Return(nil)
-	transactionController.EXPECT().BeginTx(ctx, entity.RepeatableRead).Return(mockTransaction, nil).Times(1)
-	mockTransaction.EXPECT().Commit(ctx).Return(nil).Times(1)
+	subscriptionRepo.EXPECT().UpdateChecked(ctx, updateRequest, gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context,
+			_ entity.UpdateSubscriptionRequest,
+			check func(entity.Subscription, port.CheckReader) error,
+		) error {
+			return check(entity.Subscription{ID: updateRequest.ID}, nil)
+		})
 
 	err = subscriptionUsecase.Update(ctx, updateRequest)
 	if err != nil {
@@ -220,15 +222,16 @@ func TestDelete(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, logger)
+	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, nil, nil, logger)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	subscriptionID := uuid.NewString()
 
-	ctx := context.Background()
+	ctx := adminContext()
 
+	subscriptionRepo.EXPECT().GetSubscription(ctx, subscriptionID).Return(&entity.Subscription{ID: subscriptionID}, nil)
 	subscriptionRepo.EXPECT().Delete(ctx, subscriptionID).Return(nil)
 
 	err = subscriptionUsecase.Delete(ctx, subscriptionID)
@@ -248,15 +251,16 @@ func TestDeleteWithRepoError(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, logger)
+	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, nil, nil, logger)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	subscriptionID := uuid.NewString()
 
-	ctx := context.Background()
+	ctx := adminContext()
 
+	subscriptionRepo.EXPECT().GetSubscription(ctx, subscriptionID).Return(&entity.Subscription{ID: subscriptionID}, nil)
 	expectedErr := errors.New("delete failed")
 	subscriptionRepo.EXPECT().Delete(ctx, subscriptionID).Return(expectedErr)
 
@@ -277,7 +281,7 @@ func TestList(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, logger)
+	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, nil, nil, logger)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -303,7 +307,7 @@ func TestList(t *testing.T) {
 		},
 	}
 
-	ctx := context.Background()
+	ctx := adminContext()
 
 	subscriptionRepo.EXPECT().List(ctx, filter).Return(expectedSubscriptions, nil)
 
@@ -328,7 +332,7 @@ func TestListWithEmptyResult(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, logger)
+	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, nil, nil, logger)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -339,7 +343,7 @@ func TestListWithEmptyResult(t *testing.T) {
 		Offset: pkg.PointerTo(0),
 	}
 
-	ctx := context.Background()
+	ctx := adminContext()
 
 	subscriptionRepo.EXPECT().List(ctx, filter).Return([]entity.Subscription{}, nil)
 
@@ -364,7 +368,7 @@ func TestSum(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, logger)
+	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, nil, nil, logger)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -375,7 +379,7 @@ func TestSum(t *testing.T) {
 
 	expectedSum := int64(2500)
 
-	ctx := context.Background()
+	ctx := adminContext()
 
 	subscriptionRepo.EXPECT().Sum(ctx, filter).Return(expectedSum, nil)
 
@@ -400,7 +404,7 @@ func TestSumWithZeroResult(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, logger)
+	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, nil, nil, logger)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -409,7 +413,7 @@ func TestSumWithZeroResult(t *testing.T) {
 		UserID: pkg.PointerTo("60601fee-2bf1-4721-ae6f-7636e79a0cba"),
 	}
 
-	ctx := context.Background()
+	ctx := adminContext()
 
 	subscriptionRepo.EXPECT().Sum(ctx, filter).Return(int64(0), nil)
 
@@ -434,7 +438,7 @@ func TestSumWithRepoError(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, logger)
+	subscriptionUsecase, err := usecase.NewSubscription(subscriptionRepo, nil, nil, nil, logger)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -443,7 +447,7 @@ func TestSumWithRepoError(t *testing.T) {
 		UserID: pkg.PointerTo("60601fee-2bf1-4721-ae6f-7636e79a0cba"),
 	}
 
-	ctx := context.Background()
+	ctx := adminContext()
 
 	expectedErr := errors.New("sum calculation failed")
 	subscriptionRepo.EXPECT().Sum(ctx, filter).Return(int64(0), expectedErr)
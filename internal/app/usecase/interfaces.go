@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"subscription-service/internal/app/entity"
 )
@@ -13,4 +14,51 @@ type SubscriptionUseCase interface {
 	Delete(ctx context.Context, id string) error
 	List(ctx context.Context, filter entity.ListSubscriptionFilter) ([]entity.Subscription, error)
 	Sum(ctx context.Context, filter entity.ListSubscriptionFilter) (int64, error)
+	MonthlyCosts(ctx context.Context, filter entity.PeriodFilter) ([]entity.MonthlyCost, error)
+	Forecast(ctx context.Context, req entity.ForecastRequest) ([]entity.ForecastMonth, error)
+	Pause(ctx context.Context, req entity.PauseRequest) (*entity.Subscription, error)
+	Resume(ctx context.Context, req entity.ResumeRequest) (*entity.Subscription, error)
+	Cancel(ctx context.Context, req entity.CancelRequest) (*entity.Subscription, error)
+	CountCancellations(ctx context.Context, filter entity.CancellationFilter) ([]entity.CancellationCount, error)
+	Events(ctx context.Context, id string) ([]entity.SubscriptionEvent, error)
+}
+
+type RenewalUseCase interface {
+	RenewDue(ctx context.Context) (int, error)
+}
+
+type ReminderUseCase interface {
+	Preferences(ctx context.Context, userID string) (*entity.ReminderPreferences, error)
+	SetPreferences(ctx context.Context, prefs entity.ReminderPreferences) (*entity.ReminderPreferences, error)
+	SendDue(ctx context.Context) (int, error)
+}
+
+type BudgetUseCase interface {
+	Create(ctx context.Context, budget entity.Budget) (*entity.Budget, error)
+	Read(ctx context.Context, userID, id string) (*entity.Budget, error)
+	Update(ctx context.Context, budget entity.Budget) (*entity.Budget, error)
+	Delete(ctx context.Context, userID, id string) error
+	List(ctx context.Context, userID string) ([]entity.Budget, error)
+	Status(ctx context.Context, userID string, month time.Time) ([]entity.BudgetStatus, error)
+}
+
+type CatalogUseCase interface {
+	Create(ctx context.Context, service entity.Service) (*entity.Service, error)
+	Read(ctx context.Context, id string) (*entity.Service, error)
+	Update(ctx context.Context, service entity.Service) (*entity.Service, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, filter entity.ServiceFilter) ([]entity.Service, error)
+}
+
+type AnalyticsUseCase interface {
+	Metrics(ctx context.Context, filter entity.MetricsFilter) ([]entity.MonthlyMetrics, error)
+	Cohorts(ctx context.Context, filter entity.CohortFilter) (*entity.CohortReport, error)
+}
+
+type AuthUseCase interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (entity.Principal, error)
+	AuthenticateToken(ctx context.Context, token string) (entity.Principal, error)
+	CreateAPIKey(ctx context.Context, req entity.CreateAPIKeyRequest) (*entity.APIKey, string, error)
+	ListAPIKeys(ctx context.Context) ([]entity.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id string) error
 }
@@ -0,0 +1,221 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/app/usecase"
+)
+
+// expectUpdatePauses makes the repo apply modify to stored and return the result.
+func expectUpdatePauses(mocks authzMocks, ctx context.Context, stored entity.Subscription) {
+	mocks.subscriptionRepo.EXPECT().UpdatePauses(ctx, stored.ID, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context,
+			_ string,
+			updatedAt int64,
+			modify func(entity.Subscription) ([]entity.Pause, error),
+		) (*entity.Subscription, error) {
+			pauses, err := modify(stored)
+			if err != nil {
+				return nil, err
+			}
+			stored.Pauses, stored.UpdatedAt = pauses, updatedAt
+			return &stored, nil
+		})
+}
+
+func TestPause(t *testing.T) {
+	stored := entity.Subscription{
+		ID:        "sub",
+		UserID:    ownerID,
+		StartDate: month(2025, time.January),
+		EndDate:   ptr(month(2025, time.December)),
+		Pauses:    []entity.Pause{{StartDate: month(2025, time.June), EndDate: ptr(month(2025, time.July))}},
+	}
+
+	tests := []struct {
+		name       string
+		start      time.Time
+		end        *time.Time
+		wantStarts []time.Time
+		wantErr    bool
+	}{
+		{"before another pause", month(2025, time.March), ptr(month(2025, time.April)),
+			[]time.Time{month(2025, time.March), month(2025, time.June)}, false},
+		{"until resumed", month(2025, time.September), nil,
+			[]time.Time{month(2025, time.June), month(2025, time.September)}, false},
+		{"before the start", month(2024, time.December), nil, nil, true},
+		{"after the end", month(2026, time.January), nil, nil, true},
+		{"ending after the subscription", month(2025, time.November), ptr(month(2026, time.February)), nil, true},
+		{"ending before it starts", month(2025, time.March), ptr(month(2025, time.February)), nil, true},
+		{"overlapping another pause", month(2025, time.July), ptr(month(2025, time.August)), nil, true},
+		{"open pause overlapping a later one", month(2025, time.May), nil, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscriptionUsecase, mocks := newAuthzUsecase(t)
+			ctx := userContext(ownerID)
+
+			expectUpdatePauses(mocks, ctx, stored)
+
+			sub, err := subscriptionUsecase.Pause(ctx, entity.PauseRequest{
+				ID: stored.ID, StartDate: tt.start, EndDate: tt.end, UpdatedAt: 42,
+			})
+			if tt.wantErr {
+				if !errors.Is(err, usecase.ErrInvalidSubscriptionData) {
+					t.Errorf("expected invalid data error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var starts []time.Time
+			for _, p := range sub.Pauses {
+				starts = append(starts, p.StartDate)
+			}
+			if !slices.Equal(starts, tt.wantStarts) || sub.UpdatedAt != 42 {
+				t.Errorf("expected pauses starting %v, got %+v updated at %d", tt.wantStarts, sub.Pauses, sub.UpdatedAt)
+			}
+		})
+	}
+}
+
+func TestPauseOfAnotherUser(t *testing.T) {
+	subscriptionUsecase, mocks := newAuthzUsecase(t)
+	ctx := userContext(otherID)
+
+	expectUpdatePauses(mocks, ctx, entity.Subscription{ID: "sub", UserID: ownerID, StartDate: month(2025, time.January)})
+
+	_, err := subscriptionUsecase.Pause(ctx, entity.PauseRequest{ID: "sub", StartDate: month(2025, time.March)})
+	if !errors.Is(err, usecase.ErrNotFound) {
+		t.Errorf("expected %v, got %v", usecase.ErrNotFound, err)
+	}
+}
+
+func TestResume(t *testing.T) {
+	stored := entity.Subscription{
+		ID:        "sub",
+		UserID:    ownerID,
+		StartDate: month(2025, time.January),
+		Pauses: []entity.Pause{
+			{StartDate: month(2025, time.March), EndDate: ptr(month(2025, time.May))},
+			{StartDate: month(2025, time.September)},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		month   time.Time
+		want    []entity.Pause
+		wantErr bool
+	}{
+		{"within a pause", month(2025, time.April), []entity.Pause{
+			{StartDate: month(2025, time.March), EndDate: ptr(month(2025, time.March))},
+			{StartDate: month(2025, time.September)},
+		}, false},
+		{"open pause", month(2025, time.December), []entity.Pause{
+			{StartDate: month(2025, time.March), EndDate: ptr(month(2025, time.May))},
+			{StartDate: month(2025, time.September), EndDate: ptr(month(2025, time.November))},
+		}, false},
+		{"first month of a pause", month(2025, time.September), []entity.Pause{
+			{StartDate: month(2025, time.March), EndDate: ptr(month(2025, time.May))},
+		}, false},
+		{"not paused", month(2025, time.June), nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscriptionUsecase, mocks := newAuthzUsecase(t)
+			ctx := adminContext()
+
+			stored := stored
+			stored.Pauses = slices.Clone(stored.Pauses)
+			expectUpdatePauses(mocks, ctx, stored)
+
+			sub, err := subscriptionUsecase.Resume(ctx, entity.ResumeRequest{ID: stored.ID, Month: tt.month})
+			if tt.wantErr {
+				if !errors.Is(err, usecase.ErrInvalidSubscriptionData) {
+					t.Errorf("expected invalid data error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !slices.EqualFunc(sub.Pauses, tt.want, func(a, b entity.Pause) bool {
+				return a.StartDate.Equal(b.StartDate) &&
+					(a.EndDate == nil) == (b.EndDate == nil) && (a.EndDate == nil || a.EndDate.Equal(*b.EndDate))
+			}) {
+				t.Errorf("expected pauses %+v, got %+v", tt.want, sub.Pauses)
+			}
+		})
+	}
+}
+
+func TestUpdateKeepsPausesWithinPeriod(t *testing.T) {
+	stored := entity.Subscription{
+		ID: "sub", UserID: ownerID, Title: "Okko", Price: 400, StartDate: month(2025, time.March),
+		Pauses: []entity.Pause{{StartDate: month(2025, time.June), EndDate: ptr(month(2025, time.July))}},
+	}
+
+	tests := []struct {
+		name       string
+		start      time.Time
+		end        *time.Time
+		wantErr    error
+		wantStored bool
+	}{
+		{"pauses within", month(2025, time.April), ptr(month(2025, time.December)), nil, true},
+		{"ends before a pause", month(2025, time.March), ptr(month(2025, time.June)), usecase.ErrInvalidSubscriptionData, false},
+		{"starts after a pause", month(2025, time.August), nil, usecase.ErrInvalidSubscriptionData, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscriptionUsecase, mocks := newAuthzUsecase(t)
+			ctx := userContext(ownerID)
+
+			var wrote bool
+			expectUpdateChecked(mocks, nil, stored, &wrote)
+
+			update := entity.UpdateSubscriptionRequest{
+				ID: stored.ID, Title: stored.Title, Price: stored.Price, StartDate: tt.start, EndDate: tt.end,
+			}
+			err := subscriptionUsecase.Update(ctx, update)
+			if !errors.Is(err, tt.wantErr) || wrote != tt.wantStored {
+				t.Errorf("update: %v, stored %v, want %v, stored %v", err, wrote, tt.wantErr, tt.wantStored)
+			}
+		})
+	}
+}
+
+func TestStatusAt(t *testing.T) {
+	sub := entity.Subscription{
+		StartDate: month(2025, time.March),
+		EndDate:   ptr(month(2025, time.October)),
+		Pauses:    []entity.Pause{{StartDate: month(2025, time.June), EndDate: ptr(month(2025, time.July))}},
+	}
+
+	for now, want := range map[time.Time]entity.SubscriptionStatus{
+		month(2025, time.February):                       entity.StatusScheduled,
+		month(2025, time.March).Add(20 * 24 * time.Hour): entity.StatusActive,
+		month(2025, time.July):                           entity.StatusPaused,
+		month(2025, time.October):                        entity.StatusActive,
+		month(2025, time.November):                       entity.StatusEnded,
+	} {
+		if got := sub.StatusAt(now); got != want {
+			t.Errorf("status in %s = %s, want %s", now.Format("01-2006"), got, want)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/app/usecase"
+)
+
+func month(year int, m time.Month) time.Time {
+	return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCreateValidatesPhases(t *testing.T) {
+	end := month(2025, time.December)
+
+	tests := []struct {
+		name    string
+		phases  []entity.Phase
+		wantErr bool
+	}{
+		{"no phases", nil, false},
+		{"trial and discount", []entity.Phase{
+			{StartDate: month(2025, time.January), EndDate: month(2025, time.January), Price: 0},
+			{StartDate: month(2025, time.February), EndDate: month(2025, time.April), Price: 199},
+		}, false},
+		{"phases until the end", []entity.Phase{
+			{StartDate: month(2025, time.January), EndDate: end, Price: 100},
+		}, false},
+		{"first phase after the start", []entity.Phase{
+			{StartDate: month(2025, time.February), EndDate: month(2025, time.March), Price: 0},
+		}, true},
+		{"gap", []entity.Phase{
+			{StartDate: month(2025, time.January), EndDate: month(2025, time.January), Price: 0},
+			{StartDate: month(2025, time.March), EndDate: month(2025, time.April), Price: 199},
+		}, true},
+		{"overlap", []entity.Phase{
+			{StartDate: month(2025, time.January), EndDate: month(2025, time.February), Price: 0},
+			{StartDate: month(2025, time.February), EndDate: month(2025, time.April), Price: 199},
+		}, true},
+		{"ends before it starts", []entity.Phase{
+			{StartDate: month(2025, time.January), EndDate: month(2024, time.December), Price: 0},
+		}, true},
+		{"after the end of the subscription", []entity.Phase{
+			{StartDate: month(2025, time.January), EndDate: month(2026, time.January), Price: 0},
+		}, true},
+		{"negative price", []entity.Phase{
+			{StartDate: month(2025, time.January), EndDate: month(2025, time.January), Price: -1},
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscriptionUsecase, mocks := newAuthzUsecase(t)
+			ctx := adminContext()
+
+			if !tt.wantErr {
+				mocks.subscriptionRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+			}
+
+			_, err := subscriptionUsecase.Create(ctx, entity.CreateSubscriptionRequest{
+				Title:     "Okko",
+				Price:     399,
+				UserID:    ownerID,
+				StartDate: month(2025, time.January),
+				EndDate:   &end,
+				Phases:    tt.phases,
+			})
+
+			if tt.wantErr != errors.Is(err, usecase.ErrInvalidSubscriptionData) {
+				t.Errorf("expected invalid data error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidatesPeriod(t *testing.T) {
+	start, end := month(2025, time.January), month(2024, time.December)
+
+	t.Run("create", func(t *testing.T) {
+		subscriptionUsecase, _ := newAuthzUsecase(t)
+
+		_, err := subscriptionUsecase.Create(adminContext(), entity.CreateSubscriptionRequest{
+			Title: "Okko", Price: 399, UserID: ownerID, StartDate: start, EndDate: &end,
+		})
+		if !errors.Is(err, usecase.ErrInvalidSubscriptionData) {
+			t.Errorf("create ending before it starts: %v, want %v", err, usecase.ErrInvalidSubscriptionData)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		subscriptionUsecase, _ := newAuthzUsecase(t)
+
+		err := subscriptionUsecase.Update(adminContext(), entity.UpdateSubscriptionRequest{
+			ID: "42", Title: "Okko", Price: 399, UserID: ownerID, StartDate: start, EndDate: &end,
+		})
+		if !errors.Is(err, usecase.ErrInvalidSubscriptionData) {
+			t.Errorf("update ending before it starts: %v, want %v", err, usecase.ErrInvalidSubscriptionData)
+		}
+	})
+}
+
+func TestMonthlyCosts(t *testing.T) {
+	subscriptionUsecase, mocks := newAuthzUsecase(t)
+	ctx := userContext(ownerID)
+
+	subs := []entity.Subscription{
+		{
+			Title:     "Okko",
+			Price:     400,
+			StartDate: month(2025, time.January),
+			EndDate:   ptr(month(2025, time.December)),
+			Phases: []entity.Phase{
+				{StartDate: month(2025, time.January), EndDate: month(2025, time.February), Price: 0},
+			},
+		},
+		{Title: "Kion", Price: 200, StartDate: month(2025, time.March)},
+	}
+
+	mocks.subscriptionRepo.EXPECT().ListActive(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, filter entity.PeriodFilter) ([]entity.Subscription, error) {
+			if filter.UserID == nil || *filter.UserID != ownerID {
+				t.Errorf("expected the filter scoped to %s, got %v", ownerID, filter.UserID)
+			}
+			if !filter.From.Equal(month(2025, time.February)) || !filter.To.Equal(month(2025, time.April)) {
+				t.Errorf("expected the period truncated to months, got %s - %s", filter.From, filter.To)
+			}
+			return subs, nil
+		})
+
+	costs, err := subscriptionUsecase.MonthlyCosts(ctx, entity.PeriodFilter{
+		UserID: &otherID,
+		From:   month(2025, time.February).Add(36 * time.Hour),
+		To:     month(2025, time.April).Add(36 * time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []entity.MonthlyCost{
+		{Month: month(2025, time.February), Cost: 0},
+		{Month: month(2025, time.March), Cost: 600},
+		{Month: month(2025, time.April), Cost: 600},
+	}
+	if !slices.Equal(costs, want) {
+		t.Errorf("expected %v, got %v", want, costs)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
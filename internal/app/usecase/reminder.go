@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/pkg/logctx"
+	"subscription-service/internal/port"
+)
+
+const (
+	// defaultReminderDays is how many days ahead users are reminded until they choose.
+	defaultReminderDays = 7
+	maxReminderDays     = 60
+)
+
+var _ ReminderUseCase = (*Reminder)(nil)
+
+// Reminder keeps the reminder preferences of users and sends them the reminders due.
+type Reminder struct {
+	reminderRepo port.ReminderRepo
+	notifier     port.Notifier
+	now          func() time.Time
+	logger       *zap.Logger
+}
+
+// NewReminder returns the reminder use case sending through notifier and reading the
+// time from now, or from the system clock when now is nil.
+func NewReminder(
+	reminderRepo port.ReminderRepo,
+	notifier port.Notifier,
+	now func() time.Time,
+	logger *zap.Logger,
+) (*Reminder, error) {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &Reminder{reminderRepo: reminderRepo, notifier: notifier, now: now, logger: logger}, nil
+}
+
+// Preferences returns the reminder preferences of the user. A user who has set none
+// gets no reminders: every kind is off.
+func (r *Reminder) Preferences(ctx context.Context, userID string) (*entity.ReminderPreferences, error) {
+	principal, err := tenantPrincipalFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !canAccess(principal, userID) {
+		return nil, ErrForbidden
+	}
+
+	prefs, err := r.reminderRepo.GetReminderPreferences(ctx, userID)
+	if err != nil {
+		if errors.Is(err, port.ErrNotFound) {
+			return &entity.ReminderPreferences{UserID: userID, DaysBefore: defaultReminderDays}, nil
+		}
+		return nil, fmt.Errorf("failed to get reminder preferences: %w", err)
+	}
+
+	return prefs, nil
+}
+
+// SetPreferences replaces the reminder preferences of the user. DaysBefore defaults to
+// defaultReminderDays.
+func (r *Reminder) SetPreferences(
+	ctx context.Context,
+	prefs entity.ReminderPreferences,
+) (*entity.ReminderPreferences, error) {
+	principal, err := tenantPrincipalFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !canAccess(principal, prefs.UserID) {
+		return nil, ErrForbidden
+	}
+
+	if prefs.DaysBefore == 0 {
+		prefs.DaysBefore = defaultReminderDays
+	}
+	if err = validateReminderPreferences(prefs); err != nil {
+		return nil, err
+	}
+
+	prefs.UpdatedAt = r.now().UnixMilli()
+
+	if err = r.reminderRepo.PutReminderPreferences(ctx, prefs); err != nil {
+		return nil, fmt.Errorf("failed to set reminder preferences: %w", err)
+	}
+
+	return &prefs, nil
+}
+
+// SendDue sends every reminder due now, see entity.Subscription.Reminders, that was not
+// sent before, and returns how many it sent. A reminder that failed is sent by a later
+// run; the others go on. Reminders that the channel has no recipient for are dropped.
+func (r *Reminder) SendDue(ctx context.Context) (int, error) {
+	now := r.now()
+
+	targets, err := r.reminderRepo.ReminderTargets(ctx, entity.MonthStart(now))
+	if err != nil {
+		return 0, fmt.Errorf("failed to list reminder targets: %w", err)
+	}
+
+	var (
+		sent int
+		errs []error
+	)
+
+	for _, target := range targets {
+		tenantCtx := entity.WithTenant(ctx, target.TenantID)
+
+		for _, reminder := range target.Reminders(target.Preferences, now) {
+			reminder.TenantID = target.TenantID
+
+			ok, err := r.reminderRepo.SendOnce(tenantCtx, reminder, func(ctx context.Context) error {
+				return r.notify(ctx, reminder)
+			})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to send the %s reminder of subscription %s: %w",
+					reminder.Kind, reminder.SubscriptionID, err))
+				continue
+			}
+			if ok {
+				sent++
+			}
+		}
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+func (r *Reminder) notify(ctx context.Context, reminder entity.Reminder) error {
+	err := r.notifier.Notify(ctx, reminder)
+	if errors.Is(err, port.ErrNoRecipient) {
+		logctx.Logger(ctx, r.logger).Warn("reminder dropped",
+			zap.String("subscription_id", reminder.SubscriptionID),
+			zap.String("kind", string(reminder.Kind)),
+			zap.Error(err))
+		return nil
+	}
+
+	return err
+}
+
+func validateReminderPreferences(prefs entity.ReminderPreferences) error {
+	if prefs.DaysBefore < 1 || prefs.DaysBefore > maxReminderDays {
+		return fmt.Errorf("%w: days before must be 1 to %d, got %d",
+			ErrInvalidReminderPreferences, maxReminderDays, prefs.DaysBefore)
+	}
+
+	if prefs.Email != "" {
+		addr, err := mail.ParseAddress(prefs.Email)
+		if err != nil || addr.Address != prefs.Email {
+			return fmt.Errorf("%w: %q is not an email address", ErrInvalidReminderPreferences, prefs.Email)
+		}
+	}
+
+	return nil
+}
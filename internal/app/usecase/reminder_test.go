@@ -0,0 +1,260 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	repo "subscription-service/internal/adapter/repo/mock"
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/app/usecase"
+	"subscription-service/internal/port"
+)
+
+// reminderNow is the time of the fake clock of the reminder tests, five days before May.
+var reminderNow = month(2026, time.April).Add(25*24*time.Hour + 9*time.Hour)
+
+func newReminderUsecase(t *testing.T) (*usecase.Reminder, *repo.MockReminderRepo, *repo.MockNotifier) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	reminderRepo, notifier := repo.NewMockReminderRepo(ctrl), repo.NewMockNotifier(ctrl)
+
+	reminder, err := usecase.NewReminder(reminderRepo, notifier, func() time.Time { return reminderNow }, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return reminder, reminderRepo, notifier
+}
+
+// sendingOnce makes SendOnce of the mock send every reminder.
+func sendingOnce(reminderRepo *repo.MockReminderRepo) *gomock.Call {
+	return reminderRepo.EXPECT().SendOnce(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+		func(ctx context.Context, _ entity.Reminder, send func(context.Context) error) (bool, error) {
+			if err := send(ctx); err != nil {
+				return false, err
+			}
+			return true, nil
+		})
+}
+
+func TestSubscriptionReminders(t *testing.T) {
+	all := entity.ReminderPreferences{DaysBefore: 7, Renewals: true, Ends: true, PriceChanges: true}
+	endingInApril := month(2026, time.April)
+
+	tests := []struct {
+		name  string
+		sub   entity.Subscription
+		prefs entity.ReminderPreferences
+		want  []string
+	}{
+		{
+			name:  "ends after April",
+			sub:   entity.Subscription{StartDate: month(2025, time.May), EndDate: &endingInApril, Price: 400},
+			prefs: all,
+			want:  []string{"end 05-2026 0"},
+		},
+		{
+			name: "renews in May",
+			sub: entity.Subscription{
+				StartDate: month(2025, time.June), EndDate: ptr(month(2026, time.May)), Price: 400,
+				Renewal: &entity.Renewal{TermMonths: 12},
+			},
+			prefs: all,
+			want:  []string{"renewal 05-2026 400"},
+		},
+		{
+			name: "cancelled instead of renewed",
+			sub: entity.Subscription{
+				StartDate: month(2025, time.May), EndDate: &endingInApril, Price: 400,
+				Renewal:      &entity.Renewal{TermMonths: 12},
+				Cancellation: &entity.Cancellation{Reason: "other", EffectiveDate: endingInApril},
+			},
+			prefs: all,
+			want:  []string{"end 05-2026 0"},
+		},
+		{
+			name: "trial ends",
+			sub: entity.Subscription{
+				StartDate: month(2026, time.April), Price: 400,
+				Phases: []entity.Phase{{StartDate: month(2026, time.April), EndDate: month(2026, time.April), Price: 0}},
+			},
+			prefs: all,
+			want:  []string{"price_change 05-2026 400"},
+		},
+		{
+			name: "paused through May",
+			sub: entity.Subscription{
+				StartDate: month(2026, time.January), Price: 400,
+				Pauses: []entity.Pause{{StartDate: month(2026, time.May)}},
+			},
+			prefs: all,
+		},
+		{
+			name:  "too far ahead",
+			sub:   entity.Subscription{StartDate: month(2025, time.May), EndDate: &endingInApril, Price: 400},
+			prefs: entity.ReminderPreferences{DaysBefore: 3, Ends: true},
+		},
+		{
+			name:  "not wanted",
+			sub:   entity.Subscription{StartDate: month(2025, time.May), EndDate: &endingInApril, Price: 400},
+			prefs: entity.ReminderPreferences{DaysBefore: 7, Renewals: true, PriceChanges: true},
+		},
+		{
+			name:  "already ended",
+			sub:   entity.Subscription{StartDate: month(2025, time.May), EndDate: ptr(month(2026, time.March)), Price: 400},
+			prefs: all,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, r := range tt.sub.Reminders(tt.prefs, reminderNow) {
+				got = append(got, string(r.Kind)+" "+r.Date.Format("01-2006")+" "+strconv.FormatInt(r.Price, 10))
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("reminders = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("reminder %d = %s, want %s", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSendDue(t *testing.T) {
+	reminder, reminderRepo, notifier := newReminderUsecase(t)
+
+	prefs := entity.ReminderPreferences{UserID: ownerID, DaysBefore: 7, Ends: true, Email: "owner@example.com"}
+	ending := entity.ReminderTarget{
+		TenantID:    uuid.NewString(),
+		Preferences: prefs,
+		Subscription: entity.Subscription{
+			ID: uuid.NewString(), Title: "Okko", UserID: ownerID, Price: 400,
+			StartDate: month(2025, time.May), EndDate: ptr(month(2026, time.April)),
+		},
+	}
+	later := ending
+	later.ID = uuid.NewString()
+	later.EndDate = ptr(month(2026, time.June))
+
+	reminderRepo.EXPECT().ReminderTargets(gomock.Any(), month(2026, time.April)).
+		Return([]entity.ReminderTarget{ending, later}, nil)
+	sendingOnce(reminderRepo)
+
+	want := entity.Reminder{
+		TenantID:       ending.TenantID,
+		SubscriptionID: ending.ID,
+		UserID:         ownerID,
+		Email:          prefs.Email,
+		ServiceName:    "Okko",
+		Kind:           entity.ReminderEnd,
+		Date:           month(2026, time.May),
+	}
+	notifier.EXPECT().Notify(gomock.Any(), want).Return(nil)
+
+	if sent, err := reminder.SendDue(context.Background()); sent != 1 || err != nil {
+		t.Errorf("expected one reminder sent, got %d, %v", sent, err)
+	}
+}
+
+func TestSendDueGoesOnAfterFailures(t *testing.T) {
+	reminder, reminderRepo, notifier := newReminderUsecase(t)
+
+	target := func(userID string) entity.ReminderTarget {
+		return entity.ReminderTarget{
+			TenantID:    testTenantID,
+			Preferences: entity.ReminderPreferences{UserID: userID, DaysBefore: 7, Ends: true},
+			Subscription: entity.Subscription{
+				ID: uuid.NewString(), UserID: userID, StartDate: month(2025, time.May), EndDate: ptr(month(2026, time.April)),
+			},
+		}
+	}
+	failing, unreachable, fine := target(uuid.NewString()), target(uuid.NewString()), target(uuid.NewString())
+
+	reminderRepo.EXPECT().ReminderTargets(gomock.Any(), gomock.Any()).
+		Return([]entity.ReminderTarget{failing, unreachable, fine}, nil)
+	sendingOnce(reminderRepo)
+
+	failure := errors.New("connection refused")
+	gomock.InOrder(
+		notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).Return(failure),
+		notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).Return(port.ErrNoRecipient),
+		notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).Return(nil),
+	)
+
+	// The reminder without a recipient counts as sent so that it isn't retried forever.
+	sent, err := reminder.SendDue(context.Background())
+	if sent != 2 || !errors.Is(err, failure) {
+		t.Errorf("expected two reminders sent and the failure, got %d, %v", sent, err)
+	}
+}
+
+func TestReminderPreferences(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		reminder, reminderRepo, _ := newReminderUsecase(t)
+		reminderRepo.EXPECT().GetReminderPreferences(gomock.Any(), ownerID).Return(nil, port.ErrNotFound)
+
+		prefs, err := reminder.Preferences(userContext(ownerID), ownerID)
+		if err != nil || prefs.DaysBefore != 7 || prefs.Renewals || prefs.Ends || prefs.PriceChanges {
+			t.Errorf("expected every reminder off, got %+v, %v", prefs, err)
+		}
+	})
+
+	t.Run("another user", func(t *testing.T) {
+		reminder, _, _ := newReminderUsecase(t)
+
+		if _, err := reminder.Preferences(userContext(ownerID), otherID); !errors.Is(err, usecase.ErrForbidden) {
+			t.Errorf("get: expected %v, got %v", usecase.ErrForbidden, err)
+		}
+		_, err := reminder.SetPreferences(userContext(ownerID), entity.ReminderPreferences{UserID: otherID})
+		if !errors.Is(err, usecase.ErrForbidden) {
+			t.Errorf("set: expected %v, got %v", usecase.ErrForbidden, err)
+		}
+	})
+
+	tests := []struct {
+		name    string
+		prefs   entity.ReminderPreferences
+		wantErr bool
+	}{
+		{"default days", entity.ReminderPreferences{Ends: true}, false},
+		{"with email", entity.ReminderPreferences{DaysBefore: 60, Renewals: true, Email: "owner@example.com"}, false},
+		{"too many days", entity.ReminderPreferences{DaysBefore: 61}, true},
+		{"negative days", entity.ReminderPreferences{DaysBefore: -1}, true},
+		{"not an email", entity.ReminderPreferences{Email: "owner"}, true},
+		{"email with a name", entity.ReminderPreferences{Email: "Owner <owner@example.com>"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reminder, reminderRepo, _ := newReminderUsecase(t)
+
+			tt.prefs.UserID = ownerID
+			if !tt.wantErr {
+				reminderRepo.EXPECT().PutReminderPreferences(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			prefs, err := reminder.SetPreferences(adminContext(), tt.prefs)
+			if tt.wantErr != errors.Is(err, usecase.ErrInvalidReminderPreferences) {
+				t.Fatalf("expected invalid preferences error %v, got %v", tt.wantErr, err)
+			}
+			if err == nil && (prefs.DaysBefore == 0 || prefs.UpdatedAt != reminderNow.UnixMilli()) {
+				t.Errorf("unexpected stored preferences %+v", prefs)
+			}
+		})
+	}
+}
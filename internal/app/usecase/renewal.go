@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/pkg/logctx"
+	"subscription-service/internal/port"
+)
+
+var _ RenewalUseCase = (*Renewal)(nil)
+
+// Renewal renews the subscriptions of all tenants that renew automatically.
+type Renewal struct {
+	renewalRepo port.RenewalRepo
+	now         func() time.Time
+	logger      *zap.Logger
+}
+
+// NewRenewal returns the renewal use case reading the time from now, or from the system
+// clock when now is nil.
+func NewRenewal(renewalRepo port.RenewalRepo, now func() time.Time, logger *zap.Logger) (*Renewal, error) {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &Renewal{renewalRepo: renewalRepo, now: now, logger: logger}, nil
+}
+
+// RenewDue adds a term to every subscription whose last month has started, see
+// entity.Subscription.RenewalDue, and another one while the new term has ended as well,
+// recording an event per term. It returns the number of terms added. Only one caller
+// renews at a time: while another holds the lock, such as another replica, RenewDue
+// returns zero. Running it again renews nothing twice.
+func (r *Renewal) RenewDue(ctx context.Context) (int, error) {
+	unlock, ok, err := r.renewalRepo.TryLock(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to take the renewal lock: %w", err)
+	}
+	if !ok {
+		logctx.Logger(ctx, r.logger).Debug("renewal is running elsewhere")
+		return 0, nil
+	}
+	defer unlock()
+
+	now := r.now()
+
+	subs, err := r.renewalRepo.DueRenewals(ctx, entity.MonthStart(now))
+	if err != nil {
+		return 0, fmt.Errorf("failed to list due renewals: %w", err)
+	}
+
+	var (
+		renewed int
+		errs    []error
+	)
+
+	for _, sub := range subs {
+		n, err := r.renew(entity.WithTenant(ctx, sub.TenantID), sub.Subscription, now)
+		renewed += n
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to renew subscription %s: %w", sub.ID, err))
+		}
+	}
+
+	return renewed, errors.Join(errs...)
+}
+
+// renew adds terms to sub until it is no longer due. It stops without an error when the
+// subscription changed since it was listed, as it was then renewed, cancelled or edited
+// by someone else.
+func (r *Renewal) renew(ctx context.Context, sub entity.Subscription, now time.Time) (int, error) {
+	renewed := 0
+
+	for sub.RenewalDue(now) {
+		end := entity.AddMonths(*sub.EndDate, sub.Renewal.TermMonths)
+
+		ok, err := r.renewalRepo.Renew(ctx, entity.SubscriptionEvent{
+			ID:             uuid.NewString(),
+			SubscriptionID: sub.ID,
+			Type:           entity.EventRenewed,
+			Renewal: &entity.RenewalEvent{
+				PreviousEndDate: entity.MonthStart(*sub.EndDate),
+				EndDate:         end,
+				TermMonths:      sub.Renewal.TermMonths,
+			},
+			CreatedAt: now.UnixMilli(),
+		})
+		if err != nil {
+			if errors.Is(err, port.ErrSubscriptionAlreadyExists) {
+				return renewed, fmt.Errorf("%w: the next term overlaps another subscription", ErrSubscriptionAlreadyExists)
+			}
+			return renewed, err
+		}
+		if !ok {
+			return renewed, nil
+		}
+
+		renewed++
+		sub.EndDate = &end
+	}
+
+	return renewed, nil
+}
@@ -0,0 +1,190 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	repo "subscription-service/internal/adapter/repo/mock"
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/app/usecase"
+	"subscription-service/internal/port"
+)
+
+// renewalNow is the time of the fake clock of the renewal tests.
+var renewalNow = month(2026, time.April).Add(10 * 24 * time.Hour)
+
+// newRenewalUsecase returns the use case over a mock repo whose lock is free and
+// expected to be released.
+func newRenewalUsecase(t *testing.T) (*usecase.Renewal, *repo.MockRenewalRepo) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	renewalRepo := repo.NewMockRenewalRepo(ctrl)
+
+	unlocked := false
+	renewalRepo.EXPECT().TryLock(gomock.Any()).Return(func() { unlocked = true }, true, nil)
+	t.Cleanup(func() {
+		if !unlocked {
+			t.Error("the renewal lock was not released")
+		}
+	})
+
+	renewal, err := usecase.NewRenewal(renewalRepo, func() time.Time { return renewalNow }, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return renewal, renewalRepo
+}
+
+func renewable(end time.Time, termMonths int) entity.TenantSubscription {
+	return entity.TenantSubscription{
+		TenantID: uuid.NewString(),
+		Subscription: entity.Subscription{
+			ID:        uuid.NewString(),
+			UserID:    ownerID,
+			StartDate: month(2024, time.January),
+			EndDate:   &end,
+			Renewal:   &entity.Renewal{TermMonths: termMonths},
+		},
+	}
+}
+
+func TestRenewDue(t *testing.T) {
+	renewal, renewalRepo := newRenewalUsecase(t)
+
+	lapsed := renewable(month(2025, time.March), 6)
+	lastMonth := renewable(month(2026, time.April), 12)
+
+	renewalRepo.EXPECT().DueRenewals(gomock.Any(), month(2026, time.April)).
+		Return([]entity.TenantSubscription{lapsed, lastMonth}, nil)
+
+	var got []string
+	renewalRepo.EXPECT().Renew(gomock.Any(), gomock.Any()).Times(4).DoAndReturn(
+		func(ctx context.Context, event entity.SubscriptionEvent) (bool, error) {
+			tenantID, _ := entity.TenantFromContext(ctx)
+			r := event.Renewal
+			if event.Type != entity.EventRenewed || event.CreatedAt != renewalNow.UnixMilli() || r == nil {
+				t.Fatalf("unexpected event %+v", event)
+			}
+			got = append(got, tenantID+" "+event.SubscriptionID+" "+
+				r.PreviousEndDate.Format("01-2006")+" "+r.EndDate.Format("01-2006"))
+			return true, nil
+		})
+
+	renewed, err := renewal.RenewDue(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The lapsed subscription catches up term by term until its term covers April.
+	want := []string{
+		lapsed.TenantID + " " + lapsed.ID + " 03-2025 09-2025",
+		lapsed.TenantID + " " + lapsed.ID + " 09-2025 03-2026",
+		lapsed.TenantID + " " + lapsed.ID + " 03-2026 09-2026",
+		lastMonth.TenantID + " " + lastMonth.ID + " 04-2026 04-2027",
+	}
+	if renewed != len(want) || len(got) != len(want) {
+		t.Fatalf("renewed %d terms %v, want %v", renewed, got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("renewal %d = %s, want %s", i, got[i], want[i])
+		}
+	}
+}
+
+func TestRenewDueWhileLockedElsewhere(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	renewalRepo := repo.NewMockRenewalRepo(ctrl)
+	renewalRepo.EXPECT().TryLock(gomock.Any()).Return(nil, false, nil)
+
+	renewal, err := usecase.NewRenewal(renewalRepo, func() time.Time { return renewalNow }, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if renewed, err := renewal.RenewDue(context.Background()); renewed != 0 || err != nil {
+		t.Errorf("expected nothing renewed, got %d, %v", renewed, err)
+	}
+}
+
+func TestRenewDueStopsAtChangedSubscription(t *testing.T) {
+	renewal, renewalRepo := newRenewalUsecase(t)
+
+	renewalRepo.EXPECT().DueRenewals(gomock.Any(), gomock.Any()).
+		Return([]entity.TenantSubscription{renewable(month(2025, time.March), 6)}, nil)
+	// Renewed by an earlier run since it was listed.
+	renewalRepo.EXPECT().Renew(gomock.Any(), gomock.Any()).Return(false, nil)
+
+	if renewed, err := renewal.RenewDue(context.Background()); renewed != 0 || err != nil {
+		t.Errorf("expected nothing renewed, got %d, %v", renewed, err)
+	}
+}
+
+func TestRenewDueReportsConflictsAndGoesOn(t *testing.T) {
+	renewal, renewalRepo := newRenewalUsecase(t)
+
+	conflicting, other := renewable(month(2026, time.April), 12), renewable(month(2026, time.April), 1)
+
+	renewalRepo.EXPECT().DueRenewals(gomock.Any(), gomock.Any()).
+		Return([]entity.TenantSubscription{conflicting, other}, nil)
+	gomock.InOrder(
+		renewalRepo.EXPECT().Renew(gomock.Any(), gomock.Any()).Return(false, port.ErrSubscriptionAlreadyExists),
+		renewalRepo.EXPECT().Renew(gomock.Any(), gomock.Any()).Return(true, nil),
+	)
+
+	renewed, err := renewal.RenewDue(context.Background())
+	if renewed != 1 || !errors.Is(err, usecase.ErrSubscriptionAlreadyExists) {
+		t.Errorf("expected one renewal and a conflict, got %d, %v", renewed, err)
+	}
+}
+
+func TestCreateValidatesRenewal(t *testing.T) {
+	end := month(2025, time.December)
+
+	tests := []struct {
+		name    string
+		end     *time.Time
+		renewal *entity.Renewal
+		wantErr bool
+	}{
+		{"no renewal", nil, nil, false},
+		{"yearly", &end, &entity.Renewal{TermMonths: 12}, false},
+		{"without an end date", nil, &entity.Renewal{TermMonths: 12}, true},
+		{"empty term", &end, &entity.Renewal{TermMonths: 0}, true},
+		{"too long a term", &end, &entity.Renewal{TermMonths: 121}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscriptionUsecase, mocks := newAuthzUsecase(t)
+			ctx := adminContext()
+
+			if !tt.wantErr {
+				mocks.subscriptionRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+			}
+
+			_, err := subscriptionUsecase.Create(ctx, entity.CreateSubscriptionRequest{
+				Title:     "Okko",
+				Price:     399,
+				UserID:    ownerID,
+				StartDate: month(2025, time.January),
+				EndDate:   tt.end,
+				Renewal:   tt.renewal,
+			})
+
+			if tt.wantErr != errors.Is(err, usecase.ErrInvalidSubscriptionData) {
+				t.Errorf("expected invalid data error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
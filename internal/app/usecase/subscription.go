@@ -4,9 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
-	"github.com/cenkalti/backoff/v4"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
@@ -14,33 +14,34 @@ import (
 	"subscription-service/internal/port"
 )
 
-const (
-	maxRetries             = 3
-	defaultInitialInterval = 3 * time.Millisecond
-)
-
 var _ SubscriptionUseCase = (*Subscription)(nil)
 
 type Subscription struct {
-	subscriptionRepo      port.SubscriptionRepo
-	transactionController port.TransactionController
-	backoffTxDoer         backoff.BackOff
-	logger                *zap.Logger
+	subscriptionRepo port.SubscriptionRepo
+	// budgetRepo has the budgets that creations and updates are checked against.
+	budgetRepo port.BudgetRepo
+	// serviceRepo is the catalog the titles of subscriptions resolve through.
+	serviceRepo port.ServiceRepo
+	// cancelReasons are the reason codes Cancel accepts.
+	cancelReasons []string
+	logger        *zap.Logger
 }
 
+// NewSubscription returns the subscription use case. budgetRepo may be nil, then no
+// budgets are checked, and so may serviceRepo, then titles are not resolved.
 func NewSubscription(
 	subscriptionRepo port.SubscriptionRepo,
-	transactionController port.TransactionController,
+	budgetRepo port.BudgetRepo,
+	serviceRepo port.ServiceRepo,
+	cancelReasons []string,
 	logger *zap.Logger,
 ) (*Subscription, error) {
-	expBackoffDoer := backoff.WithMaxRetries(backoff.NewExponentialBackOff(
-		backoff.WithInitialInterval(defaultInitialInterval),
-	), maxRetries)
 	return &Subscription{
-		subscriptionRepo:      subscriptionRepo,
-		transactionController: transactionController,
-		backoffTxDoer:         expBackoffDoer,
-		logger:                logger,
+		subscriptionRepo: subscriptionRepo,
+		budgetRepo:       budgetRepo,
+		serviceRepo:      serviceRepo,
+		cancelReasons:    cancelReasons,
+		logger:           logger,
 	}, nil
 }
 
@@ -48,29 +49,97 @@ func (r *Subscription) Create(
 	ctx context.Context,
 	post entity.CreateSubscriptionRequest,
 ) (*entity.Subscription, error) {
+	principal, err := tenantPrincipalFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !canAccess(principal, post.UserID) {
+		return nil, ErrForbidden
+	}
+
+	if err := validatePeriod(post.StartDate, post.EndDate); err != nil {
+		return nil, err
+	}
+
+	if err := validatePhases(post.StartDate, post.EndDate, post.Phases); err != nil {
+		return nil, err
+	}
+
+	if err := validateRenewal(post.EndDate, post.Renewal); err != nil {
+		return nil, err
+	}
+
+	service, err := r.resolveService(ctx, (*entity.UpdateSubscriptionRequest)(&post))
+	if err != nil {
+		return nil, err
+	}
+
+	if post.Price == entity.NoPrice {
+		if service == nil || service.DefaultPrice == nil {
+			return nil, fmt.Errorf("%w: the price is required, %s has no default price", ErrInvalidSubscriptionData, post.Title)
+		}
+		post.Price = *service.DefaultPrice
+	}
+
 	id := uuid.NewString()
 	post.ID = id
-	err := r.subscriptionRepo.Create(ctx, post)
+
+	var alerts []entity.BudgetAlert
+	if r.budgetRepo == nil {
+		err = r.subscriptionRepo.Create(ctx, post)
+	} else {
+		// The budgets are checked in the transaction of the insert, so that concurrent
+		// creates can't take a strict budget over its limit together.
+		err = r.subscriptionRepo.CreateChecked(ctx, post, func(reader port.CheckReader) error {
+			var err error
+			alerts, err = r.checkBudgets(ctx, reader, nil, entity.Subscription(post))
+			return err
+		})
+	}
 	if err != nil {
 		if errors.Is(err, port.ErrSubscriptionAlreadyExists) {
 			return nil, ErrSubscriptionAlreadyExists
 		}
+		if errors.Is(err, port.ErrServiceNotFound) {
+			return nil, unknownService(*post.ServiceID)
+		}
 		return nil, err
 	}
 
+	r.recordAlerts(ctx, id, alerts, post.UpdatedAt)
+
 	return &entity.Subscription{
 		ID:        id,
 		Title:     post.Title,
+		ServiceID: post.ServiceID,
 		Price:     post.Price,
 		UserID:    post.UserID,
 		StartDate: post.StartDate,
 		EndDate:   post.EndDate,
+		Phases:    post.Phases,
+		Renewal:   post.Renewal,
 		CreatedAt: post.CreatedAt,
 		UpdatedAt: post.UpdatedAt,
 	}, nil
 }
 
 func (r *Subscription) Read(ctx context.Context, id string) (*entity.Subscription, error) {
+	principal, err := tenantPrincipalFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.get(ctx, principal, id)
+}
+
+// get reads a subscription on behalf of principal. Records of other users are reported
+// as missing so that their existence is not revealed.
+func (r *Subscription) get(
+	ctx context.Context,
+	principal entity.Principal,
+	id string,
+) (*entity.Subscription, error) {
 	sub, err := r.subscriptionRepo.GetSubscription(ctx, id)
 	if err != nil {
 		if errors.Is(err, port.ErrNotFound) {
@@ -79,63 +148,109 @@ func (r *Subscription) Read(ctx context.Context, id string) (*entity.Subscriptio
 		return nil, fmt.Errorf("failed to get subscription: %w", err)
 	}
 
+	if !canAccess(principal, sub.UserID) {
+		return nil, ErrNotFound
+	}
+
 	return sub, nil
 }
 
-func (r *Subscription) Update(ctx context.Context, post entity.UpdateSubscriptionRequest) error {
-	bErr := backoff.Retry(
-		func() error {
-			err := r.update(ctx, post)
-			if err != nil {
-				if errors.Is(err, port.ErrSubscriptionAlreadyExists) {
-					return ErrSubscriptionAlreadyExists
-				}
-				if !errors.Is(err, port.ErrTransactionFailure) {
-					return backoff.Permanent(err)
-				}
-
-				return err
-			}
-
-			return nil
-		},
-		r.backoffTxDoer,
-	)
+// checkOwnership makes sure the subscription exists and a regular user acts on their
+// own one.
+func (r *Subscription) checkOwnership(ctx context.Context, id string) error {
+	principal, err := tenantPrincipalFrom(ctx)
+	if err != nil {
+		return err
+	}
 
-	return bErr
+	_, err = r.get(ctx, principal, id)
+	return err
 }
 
-func (r *Subscription) update(ctx context.Context, post entity.UpdateSubscriptionRequest) error {
-	tx, err := r.transactionController.BeginTx(ctx, entity.RepeatableRead)
+func (r *Subscription) Update(ctx context.Context, post entity.UpdateSubscriptionRequest) error {
+	principal, err := tenantPrincipalFrom(ctx)
 	if err != nil {
-		return backoff.Permanent(err)
+		return err
 	}
 
-	err = r.subscriptionRepo.Update(ctx, post)
-	if err != nil {
-		if errors.Is(err, port.ErrSubscriptionAlreadyExists) {
+	if err := validatePeriod(post.StartDate, post.EndDate); err != nil {
+		return err
+	}
+
+	if err := validatePhases(post.StartDate, post.EndDate, post.Phases); err != nil {
+		return err
+	}
+
+	if err := validateRenewal(post.EndDate, post.Renewal); err != nil {
+		return err
+	}
+
+	if _, err := r.resolveService(ctx, &post); err != nil {
+		return err
+	}
+
+	// The owner, the pauses and the budgets are checked against the subscription as the
+	// transaction of the update reads it, so that nothing changes it in between.
+	var alerts []entity.BudgetAlert
+	err = r.subscriptionRepo.UpdateChecked(ctx, post, func(current entity.Subscription, reader port.CheckReader) error {
+		if !canAccess(principal, current.UserID) {
+			return ErrNotFound
+		}
+
+		if err := checkPausesFit(post, current); err != nil {
 			return err
 		}
-		errR := tx.Rollback(ctx)
-		if errR != nil {
-			r.logger.Error("transaction rollback failed", zap.Error(errR))
+
+		if r.budgetRepo == nil {
+			return nil
 		}
 
-		if errors.Is(err, port.ErrNotFound) {
+		updated := current
+		updated.Title, updated.ServiceID, updated.Price = post.Title, post.ServiceID, post.Price
+		updated.StartDate, updated.EndDate = post.StartDate, post.EndDate
+		updated.Phases, updated.Renewal = post.Phases, post.Renewal
+
+		var err error
+		alerts, err = r.checkBudgets(ctx, reader, &current, updated)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, port.ErrNotFound):
 			return ErrNotFound
+		case errors.Is(err, port.ErrSubscriptionAlreadyExists):
+			return ErrSubscriptionAlreadyExists
+		case errors.Is(err, port.ErrServiceNotFound):
+			return unknownService(*post.ServiceID)
+		case errors.Is(err, ErrNotFound), errors.Is(err, ErrInvalidSubscriptionData),
+			errors.Is(err, ErrBudgetExceeded):
+			return err
 		}
-
-		return fmt.Errorf("update subscription: %w", err)
+		return fmt.Errorf("failed to update subscription: %w", err)
 	}
 
-	if err = tx.Commit(ctx); err != nil {
-		return fmt.Errorf("commit trancastion: %w", err)
+	r.recordAlerts(ctx, post.ID, alerts, post.UpdatedAt)
+
+	return nil
+}
+
+// checkPausesFit makes sure the pauses of the stored subscription lie within its new
+// period, as updates keep them.
+func checkPausesFit(post entity.UpdateSubscriptionRequest, current entity.Subscription) error {
+	for _, p := range current.Pauses {
+		if err := validatePause(post.StartDate, post.EndDate, p); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
 func (r *Subscription) Delete(ctx context.Context, id string) error {
+	if err := r.checkOwnership(ctx, id); err != nil {
+		return err
+	}
+
 	err := r.subscriptionRepo.Delete(ctx, id)
 	if err != nil {
 		if errors.Is(err, port.ErrNotFound) {
@@ -148,7 +263,16 @@ func (r *Subscription) Delete(ctx context.Context, id string) error {
 }
 
 func (r *Subscription) List(ctx context.Context, filter entity.ListSubscriptionFilter) ([]entity.Subscription, error) {
-	subs, err := r.subscriptionRepo.List(ctx, filter)
+	principal, err := tenantPrincipalFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.Title, filter.ServiceID, err = resolveTitle(ctx, r.serviceRepo, filter.Title); err != nil {
+		return nil, err
+	}
+
+	subs, err := r.subscriptionRepo.List(ctx, scopeFilter(principal, filter))
 	if err != nil {
 		return nil, fmt.Errorf("failed to list subscriptions: %w", err)
 	}
@@ -157,10 +281,358 @@ func (r *Subscription) List(ctx context.Context, filter entity.ListSubscriptionF
 }
 
 func (r *Subscription) Sum(ctx context.Context, filter entity.ListSubscriptionFilter) (int64, error) {
-	sum, err := r.subscriptionRepo.Sum(ctx, filter)
+	principal, err := tenantPrincipalFrom(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if filter.Title, filter.ServiceID, err = resolveTitle(ctx, r.serviceRepo, filter.Title); err != nil {
+		return 0, err
+	}
+
+	sum, err := r.subscriptionRepo.Sum(ctx, scopeFilter(principal, filter))
 	if err != nil {
 		return 0, fmt.Errorf("failed to sum subscriptions: %w", err)
 	}
 
 	return sum, nil
 }
+
+// MonthlyCosts returns the cost of every month of the period: the sum of the prices in
+// effect that month of the subscriptions active in it.
+func (r *Subscription) MonthlyCosts(ctx context.Context, filter entity.PeriodFilter) ([]entity.MonthlyCost, error) {
+	principal, err := tenantPrincipalFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !principal.IsAdmin() {
+		filter.UserID = &principal.UserID
+	}
+
+	filter.From, filter.To = entity.MonthStart(filter.From), entity.MonthStart(filter.To)
+
+	if filter.Title, filter.ServiceID, err = resolveTitle(ctx, r.serviceRepo, filter.Title); err != nil {
+		return nil, err
+	}
+
+	subs, err := r.subscriptionRepo.ListActive(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active subscriptions: %w", err)
+	}
+
+	costs := make([]entity.MonthlyCost, 0, max(entity.MonthsBetween(filter.From, filter.To), 0))
+	for month := filter.From; !month.After(filter.To); month = entity.AddMonths(month, 1) {
+		cost := entity.MonthlyCost{Month: month}
+		for _, sub := range subs {
+			if price, ok := sub.PriceAt(month); ok {
+				cost.Cost += price
+			}
+		}
+		costs = append(costs, cost)
+	}
+
+	return costs, nil
+}
+
+// Pause puts a subscription on hold. The pause must lie within the subscription and not
+// overlap its other pauses.
+func (r *Subscription) Pause(ctx context.Context, req entity.PauseRequest) (*entity.Subscription, error) {
+	pause := entity.Pause{StartDate: entity.MonthStart(req.StartDate)}
+	if req.EndDate != nil {
+		end := entity.MonthStart(*req.EndDate)
+		pause.EndDate = &end
+	}
+
+	return r.updatePauses(ctx, req.ID, req.UpdatedAt, func(sub entity.Subscription) ([]entity.Pause, error) {
+		if err := validatePause(sub.StartDate, sub.EndDate, pause); err != nil {
+			return nil, err
+		}
+
+		for _, p := range sub.Pauses {
+			if pausesOverlap(p, pause) {
+				return nil, fmt.Errorf("%w: the pause overlaps the pause from %s",
+					ErrInvalidSubscriptionData, formatMonth(p.StartDate))
+			}
+		}
+
+		pauses := append(sub.Pauses, pause)
+		slices.SortFunc(pauses, func(a, b entity.Pause) int {
+			return a.StartDate.Compare(b.StartDate)
+		})
+
+		return pauses, nil
+	})
+}
+
+// Resume ends the pause in effect in the month of the request: the pause ends the month
+// before, or is removed when it would start in that month.
+func (r *Subscription) Resume(ctx context.Context, req entity.ResumeRequest) (*entity.Subscription, error) {
+	month := entity.MonthStart(req.Month)
+
+	return r.updatePauses(ctx, req.ID, req.UpdatedAt, func(sub entity.Subscription) ([]entity.Pause, error) {
+		i := slices.IndexFunc(sub.Pauses, func(p entity.Pause) bool {
+			return p.Covers(month)
+		})
+		if i < 0 {
+			return nil, fmt.Errorf("%w: the subscription is not paused in %s",
+				ErrInvalidSubscriptionData, formatMonth(month))
+		}
+
+		if entity.MonthStart(sub.Pauses[i].StartDate).Equal(month) {
+			return slices.Delete(sub.Pauses, i, i+1), nil
+		}
+
+		end := entity.AddMonths(month, -1)
+		sub.Pauses[i].EndDate = &end
+
+		return sub.Pauses, nil
+	})
+}
+
+// updatePauses applies modify to the pauses of a subscription the principal may access.
+func (r *Subscription) updatePauses(
+	ctx context.Context,
+	id string,
+	updatedAt int64,
+	modify func(entity.Subscription) ([]entity.Pause, error),
+) (*entity.Subscription, error) {
+	principal, err := tenantPrincipalFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := r.subscriptionRepo.UpdatePauses(ctx, id, updatedAt, func(sub entity.Subscription) ([]entity.Pause, error) {
+		if !canAccess(principal, sub.UserID) {
+			return nil, ErrNotFound
+		}
+
+		return modify(sub)
+	})
+	if err != nil {
+		if errors.Is(err, port.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		if errors.Is(err, ErrNotFound) || errors.Is(err, ErrInvalidSubscriptionData) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update pauses: %w", err)
+	}
+
+	return sub, nil
+}
+
+// Cancel ends a subscription that has not ended yet with the effective month of the
+// request, recording the reason. The month can't be in the past or outside the
+// subscription; phases and pauses after it are cut off, see entity.Subscription.EndAt.
+func (r *Subscription) Cancel(ctx context.Context, req entity.CancelRequest) (*entity.Subscription, error) {
+	principal, err := tenantPrincipalFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(r.cancelReasons, req.Reason) {
+		return nil, fmt.Errorf("%w %q", ErrUnknownCancelReason, req.Reason)
+	}
+
+	current := entity.MonthStart(req.Now)
+
+	sub, err := r.subscriptionRepo.Cancel(ctx, req.ID, func(sub entity.Subscription) (entity.Subscription, error) {
+		if !canAccess(principal, sub.UserID) {
+			return entity.Subscription{}, ErrNotFound
+		}
+
+		if sub.StatusAt(req.Now) == entity.StatusEnded {
+			return entity.Subscription{}, fmt.Errorf("%w in %s", ErrSubscriptionEnded, formatMonth(*sub.EndDate))
+		}
+
+		start := entity.MonthStart(sub.StartDate)
+
+		// By default the subscription ends with the billing period it is in, or the first
+		// one when it hasn't started yet.
+		effective := sub.PeriodEnd(current)
+		if start.After(current) {
+			effective = sub.PeriodEnd(start)
+		}
+		if req.EffectiveDate != nil {
+			effective = entity.MonthStart(*req.EffectiveDate)
+		}
+
+		switch {
+		case effective.Before(current):
+			return entity.Subscription{}, fmt.Errorf("%w: the cancellation takes effect in %s, before the current month",
+				ErrInvalidSubscriptionData, formatMonth(effective))
+		case effective.Before(start):
+			return entity.Subscription{}, fmt.Errorf("%w: the cancellation takes effect in %s, before the subscription starts in %s",
+				ErrInvalidSubscriptionData, formatMonth(effective), formatMonth(start))
+		case sub.EndDate != nil && effective.After(entity.MonthStart(*sub.EndDate)):
+			return entity.Subscription{}, fmt.Errorf("%w: the cancellation takes effect in %s, after the subscription ends in %s",
+				ErrInvalidSubscriptionData, formatMonth(effective), formatMonth(*sub.EndDate))
+		}
+
+		sub = sub.EndAt(effective)
+		sub.Cancellation = &entity.Cancellation{
+			Reason:        req.Reason,
+			Note:          req.Note,
+			EffectiveDate: effective,
+			CancelledAt:   req.Now.UnixMilli(),
+		}
+		sub.UpdatedAt = req.Now.UnixMilli()
+
+		return sub, nil
+	})
+	if err != nil {
+		if errors.Is(err, port.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		if errors.Is(err, ErrNotFound) || errors.Is(err, ErrSubscriptionEnded) || errors.Is(err, ErrInvalidSubscriptionData) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to cancel subscription: %w", err)
+	}
+
+	return sub, nil
+}
+
+// CountCancellations counts the cancellations by the month they take effect in, service
+// and reason. Users other than admins count only their own.
+func (r *Subscription) CountCancellations(
+	ctx context.Context,
+	filter entity.CancellationFilter,
+) ([]entity.CancellationCount, error) {
+	principal, err := tenantPrincipalFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !principal.IsAdmin() {
+		filter.UserID = &principal.UserID
+	}
+
+	if filter.Title, filter.ServiceID, err = resolveTitle(ctx, r.serviceRepo, filter.Title); err != nil {
+		return nil, err
+	}
+
+	counts, err := r.subscriptionRepo.CountCancellations(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count cancellations: %w", err)
+	}
+
+	return counts, nil
+}
+
+// Events returns the history of a subscription the principal may access.
+func (r *Subscription) Events(ctx context.Context, id string) ([]entity.SubscriptionEvent, error) {
+	if err := r.checkOwnership(ctx, id); err != nil {
+		return nil, err
+	}
+
+	events, err := r.subscriptionRepo.ListEvents(ctx, id)
+	if err != nil {
+		if errors.Is(err, port.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	return events, nil
+}
+
+// validatePause checks that the pause lies within the subscription from start to end.
+func validatePause(start time.Time, end *time.Time, p entity.Pause) error {
+	pauseStart := entity.MonthStart(p.StartDate)
+
+	switch {
+	case pauseStart.Before(entity.MonthStart(start)):
+		return fmt.Errorf("%w: the pause starts in %s, before the subscription starts in %s",
+			ErrInvalidSubscriptionData, formatMonth(pauseStart), formatMonth(start))
+	case end != nil && pauseStart.After(entity.MonthStart(*end)):
+		return fmt.Errorf("%w: the pause starts in %s, after the subscription ends in %s",
+			ErrInvalidSubscriptionData, formatMonth(pauseStart), formatMonth(*end))
+	case p.EndDate == nil:
+		return nil
+	case entity.MonthStart(*p.EndDate).Before(pauseStart):
+		return fmt.Errorf("%w: the pause ends before it starts", ErrInvalidSubscriptionData)
+	case end != nil && entity.MonthStart(*p.EndDate).After(entity.MonthStart(*end)):
+		return fmt.Errorf("%w: the pause ends in %s, after the subscription ends in %s",
+			ErrInvalidSubscriptionData, formatMonth(*p.EndDate), formatMonth(*end))
+	}
+
+	return nil
+}
+
+// pausesOverlap reports whether two pauses share a month.
+func pausesOverlap(a, b entity.Pause) bool {
+	endsBefore := func(p entity.Pause, month time.Time) bool {
+		return p.EndDate != nil && entity.MonthStart(*p.EndDate).Before(entity.MonthStart(month))
+	}
+
+	return !endsBefore(a, b.StartDate) && !endsBefore(b, a.StartDate)
+}
+
+// maxRenewalTerm is the longest term of an automatic renewal, in months.
+const maxRenewalTerm = 120
+
+// validateRenewal checks that a subscription that renews automatically has an end date
+// to move and a term within bounds.
+func validateRenewal(end *time.Time, renewal *entity.Renewal) error {
+	switch {
+	case renewal == nil:
+		return nil
+	case end == nil:
+		return fmt.Errorf("%w: a subscription without an end date can't renew", ErrInvalidSubscriptionData)
+	case renewal.TermMonths < 1 || renewal.TermMonths > maxRenewalTerm:
+		return fmt.Errorf("%w: the renewal term must be 1 to %d months, got %d",
+			ErrInvalidSubscriptionData, maxRenewalTerm, renewal.TermMonths)
+	}
+
+	return nil
+}
+
+// validatePeriod checks that the subscription doesn't end before it starts.
+func validatePeriod(start time.Time, end *time.Time) error {
+	if end != nil && entity.MonthStart(*end).Before(entity.MonthStart(start)) {
+		return fmt.Errorf("%w: the subscription ends in %s, before it starts in %s",
+			ErrInvalidSubscriptionData, formatMonth(*end), formatMonth(start))
+	}
+
+	return nil
+}
+
+// validatePhases checks that the phases follow each other month after month from the
+// start of the subscription, without gaps or overlaps, and end by its end date.
+func validatePhases(start time.Time, end *time.Time, phases []entity.Phase) error {
+	expected := entity.MonthStart(start)
+
+	for i, p := range phases {
+		n := i + 1
+		phaseStart, phaseEnd := entity.MonthStart(p.StartDate), entity.MonthStart(p.EndDate)
+
+		switch {
+		case p.Price < 0:
+			return fmt.Errorf("%w: phase %d has a negative price", ErrInvalidSubscriptionData, n)
+		case phaseEnd.Before(phaseStart):
+			return fmt.Errorf("%w: phase %d ends before it starts", ErrInvalidSubscriptionData, n)
+		case i == 0 && !phaseStart.Equal(expected):
+			return fmt.Errorf("%w: phase 1 starts in %s, expected the start of the subscription, %s",
+				ErrInvalidSubscriptionData, formatMonth(phaseStart), formatMonth(expected))
+		case phaseStart.Before(expected):
+			return fmt.Errorf("%w: phase %d starts in %s and overlaps phase %d, expected %s",
+				ErrInvalidSubscriptionData, n, formatMonth(phaseStart), i, formatMonth(expected))
+		case phaseStart.After(expected):
+			return fmt.Errorf("%w: phase %d starts in %s and leaves a gap after phase %d, expected %s",
+				ErrInvalidSubscriptionData, n, formatMonth(phaseStart), i, formatMonth(expected))
+		case end != nil && phaseEnd.After(entity.MonthStart(*end)):
+			return fmt.Errorf("%w: phase %d ends in %s, after the subscription ends in %s",
+				ErrInvalidSubscriptionData, n, formatMonth(phaseEnd), formatMonth(*end))
+		}
+
+		expected = entity.AddMonths(phaseEnd, 1)
+	}
+
+	return nil
+}
+
+func formatMonth(t time.Time) string {
+	return t.Format("01-2006")
+}
@@ -1,6 +1,11 @@
 package config
 
-import "time"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 type LogLevel int8
 
@@ -14,35 +19,261 @@ const (
 	FatalLevel
 )
 
+var logLevelNames = map[LogLevel]string{
+	DebugLevel:  "debug",
+	InfoLevel:   "info",
+	WarnLevel:   "warn",
+	ErrorLevel:  "error",
+	DPanicLevel: "dpanic",
+	PanicLevel:  "panic",
+	FatalLevel:  "fatal",
+}
+
+func (l LogLevel) MarshalText() ([]byte, error) {
+	name, ok := logLevelNames[l]
+	if !ok {
+		return nil, fmt.Errorf("unknown log level %d", l)
+	}
+
+	return []byte(name), nil
+}
+
+func (l *LogLevel) UnmarshalText(text []byte) error {
+	for level, name := range logLevelNames {
+		if strings.EqualFold(string(text), name) {
+			*l = level
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown log level %q", text)
+}
+
+const (
+	LogFormatJSON    = "json"
+	LogFormatConsole = "console"
+)
+
+const (
+	StoragePostgres = "postgres"
+	// StorageMemory keeps everything in process memory and needs no database. Data is
+	// lost on restart; meant for local development.
+	StorageMemory = "memory"
+)
+
 type Config struct {
-	Address  string
-	Log      LogLevel
-	DBConfig DatabaseConfig
+	// Storage is StoragePostgres or StorageMemory.
+	Storage      string             `yaml:"storage" toml:"storage"`
+	Log          LogConfig          `yaml:"log" toml:"log"`
+	HTTP         HTTPConfig         `yaml:"http" toml:"http"`
+	DBConfig     DatabaseConfig     `yaml:"database" toml:"database"`
+	Auth         AuthConfig         `yaml:"auth" toml:"auth"`
+	RateLimit    RateLimitConfig    `yaml:"rate_limit" toml:"rate_limit"`
+	Idempotency  IdempotencyConfig  `yaml:"idempotency" toml:"idempotency"`
+	Cancellation CancellationConfig `yaml:"cancellation" toml:"cancellation"`
+	Renewal      RenewalConfig      `yaml:"renewal" toml:"renewal"`
+	Reminders    RemindersConfig    `yaml:"reminders" toml:"reminders"`
+}
+
+type LogConfig struct {
+	Level LogLevel `yaml:"level" toml:"level"`
+	// Format is LogFormatJSON or LogFormatConsole.
+	Format string `yaml:"format" toml:"format"`
+}
+
+type HTTPConfig struct {
+	Address           string        `yaml:"address" toml:"address"`
+	ReadTimeout       time.Duration `yaml:"read_timeout" toml:"read_timeout"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" toml:"read_header_timeout"`
+	WriteTimeout      time.Duration `yaml:"write_timeout" toml:"write_timeout"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout" toml:"idle_timeout"`
 }
 
 type DatabaseConfig struct {
-	ConnectionString string
-	MaxOpenConns     int32
-	MaxLifetime      time.Duration
-	MaxIdleTime      time.Duration
-}
-
-func New(
-	address string,
-	connStr string,
-	logLevel LogLevel,
-	maxOpenConns int32,
-	maxLifeTime time.Duration,
-	maxIdleTime time.Duration,
-) (*Config, error) {
-	return &Config{
-		Address: address,
-		Log:     logLevel,
+	ConnectionString string        `yaml:"connection_string" toml:"connection_string"`
+	MaxOpenConns     int32         `yaml:"max_open_conns" toml:"max_open_conns"`
+	MaxLifetime      time.Duration `yaml:"max_lifetime" toml:"max_lifetime"`
+	MaxIdleTime      time.Duration `yaml:"max_idle_time" toml:"max_idle_time"`
+	// AutoMigrate applies pending migrations when the service starts. Disable it to run
+	// "migrate up" as a separate deployment step.
+	AutoMigrate bool `yaml:"auto_migrate" toml:"auto_migrate"`
+}
+
+type AuthConfig struct {
+	// AdminAPIKey is a static admin key used to bootstrap the first API keys.
+	AdminAPIKey string    `yaml:"admin_api_key" toml:"admin_api_key"`
+	JWT         JWTConfig `yaml:"jwt" toml:"jwt"`
+}
+
+type JWTConfig struct {
+	HMACSecret string `yaml:"hmac_secret" toml:"hmac_secret"`
+	JWKSFile   string `yaml:"jwks_file" toml:"jwks_file"`
+	Issuer     string `yaml:"issuer" toml:"issuer"`
+	Audience   string `yaml:"audience" toml:"audience"`
+}
+
+func (c JWTConfig) Enabled() bool {
+	return c.HMACSecret != "" || c.JWKSFile != ""
+}
+
+const (
+	RateLimitBackendMemory   = "memory"
+	RateLimitBackendPostgres = "postgres"
+	RateLimitBackendOff      = "off"
+)
+
+type RateLimitConfig struct {
+	// Backend is RateLimitBackendMemory, RateLimitBackendPostgres or RateLimitBackendOff.
+	Backend      string    `yaml:"backend" toml:"backend"`
+	PerPrincipal RateLimit `yaml:"per_principal" toml:"per_principal"`
+	PerIP        RateLimit `yaml:"per_ip" toml:"per_ip"`
+	// Routes adds limits for expensive routes, keyed by "METHOD /route/pattern".
+	Routes map[string]RateLimit `yaml:"routes" toml:"routes"`
+}
+
+// RateLimit allows Requests requests per Per, with bursts of up to Requests.
+// In files and variables it is written as "100/1m".
+type RateLimit struct {
+	Requests int
+	Per      time.Duration
+}
+
+func (l RateLimit) MarshalText() ([]byte, error) {
+	return []byte(strconv.Itoa(l.Requests) + "/" + l.Per.String()), nil
+}
+
+func (l *RateLimit) UnmarshalText(text []byte) error {
+	limit, err := ParseRateLimit(string(text))
+	if err != nil {
+		return err
+	}
+
+	*l = limit
+
+	return nil
+}
+
+func DefaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		Backend:      RateLimitBackendMemory,
+		PerPrincipal: RateLimit{Requests: 300, Per: time.Minute},
+		PerIP:        RateLimit{Requests: 600, Per: time.Minute},
+		Routes: map[string]RateLimit{
+			"GET /subscriptions/sum":         {Requests: 30, Per: time.Minute},
+			"GET /subscriptions/sum/monthly": {Requests: 30, Per: time.Minute},
+		},
+	}
+}
+
+type IdempotencyConfig struct {
+	// TTL is how long responses are kept for replay.
+	TTL time.Duration `yaml:"ttl" toml:"ttl"`
+	// Lease bounds how long a request may hold its key before a retry takes it over,
+	// in case the replica serving it died.
+	Lease time.Duration `yaml:"lease" toml:"lease"`
+}
+
+func DefaultIdempotencyConfig() IdempotencyConfig {
+	return IdempotencyConfig{TTL: 24 * time.Hour, Lease: time.Minute}
+}
+
+type CancellationConfig struct {
+	// Reasons are the reason codes a subscription can be cancelled with.
+	Reasons []string `yaml:"reasons" toml:"reasons"`
+}
+
+func DefaultCancellationConfig() CancellationConfig {
+	return CancellationConfig{
+		Reasons: []string{"too_expensive", "not_using", "switched_service", "technical_issues", "other"},
+	}
+}
+
+type RenewalConfig struct {
+	// Interval is how often the job renewing subscriptions runs; zero turns it off.
+	Interval time.Duration `yaml:"interval" toml:"interval"`
+}
+
+const (
+	NotifierLog     = "log"
+	NotifierSMTP    = "smtp"
+	NotifierWebhook = "webhook"
+)
+
+type RemindersConfig struct {
+	// Interval is how often the job sending reminders runs; zero turns it off.
+	Interval time.Duration `yaml:"interval" toml:"interval"`
+	// Notifier is the channel reminders are sent through: NotifierLog, NotifierSMTP or
+	// NotifierWebhook.
+	Notifier string        `yaml:"notifier" toml:"notifier"`
+	SMTP     SMTPConfig    `yaml:"smtp" toml:"smtp"`
+	Webhook  WebhookConfig `yaml:"webhook" toml:"webhook"`
+}
+
+type SMTPConfig struct {
+	// Address is the host:port of the mail server.
+	Address  string `yaml:"address" toml:"address"`
+	From     string `yaml:"from" toml:"from"`
+	Username string `yaml:"username" toml:"username"`
+	Password string `yaml:"password" toml:"password"`
+	// Timeout bounds sending one email.
+	Timeout time.Duration `yaml:"timeout" toml:"timeout"`
+}
+
+type WebhookConfig struct {
+	URL string `yaml:"url" toml:"url"`
+	// Secret signs the requests with HMAC-SHA256 when set.
+	Secret  string        `yaml:"secret" toml:"secret"`
+	Timeout time.Duration `yaml:"timeout" toml:"timeout"`
+}
+
+// Default returns the configuration used for everything the file, the environment and
+// the flags leave unset.
+func Default() Config {
+	return Config{
+		Storage: StoragePostgres,
+		Log:     LogConfig{Level: InfoLevel, Format: LogFormatJSON},
+		HTTP: HTTPConfig{
+			Address:           ":8080",
+			ReadTimeout:       10 * time.Second,
+			ReadHeaderTimeout: 5 * time.Second,
+			WriteTimeout:      15 * time.Second,
+			IdleTimeout:       2 * time.Minute,
+		},
 		DBConfig: DatabaseConfig{
-			ConnectionString: connStr,
-			MaxOpenConns:     maxOpenConns,
-			MaxLifetime:      maxLifeTime,
-			MaxIdleTime:      maxIdleTime,
+			MaxOpenConns: 25,
+			MaxLifetime:  10 * time.Minute,
+			MaxIdleTime:  5 * time.Minute,
+			AutoMigrate:  true,
 		},
-	}, nil
+		RateLimit:    DefaultRateLimitConfig(),
+		Idempotency:  DefaultIdempotencyConfig(),
+		Cancellation: DefaultCancellationConfig(),
+		Renewal:      RenewalConfig{Interval: time.Hour},
+		Reminders: RemindersConfig{
+			Interval: time.Hour,
+			Notifier: NotifierLog,
+			SMTP:     SMTPConfig{Timeout: 30 * time.Second},
+			Webhook:  WebhookConfig{Timeout: 10 * time.Second},
+		},
+	}
+}
+
+// ParseRateLimit parses limits written as "100/1m".
+func ParseRateLimit(s string) (RateLimit, error) {
+	requestsRaw, perRaw, ok := strings.Cut(s, "/")
+	if !ok {
+		return RateLimit{}, fmt.Errorf("rate limit %q: expected REQUESTS/DURATION", s)
+	}
+
+	requests, err := strconv.Atoi(requestsRaw)
+	if err != nil || requests <= 0 {
+		return RateLimit{}, fmt.Errorf("rate limit %q: requests must be a positive integer", s)
+	}
+
+	per, err := time.ParseDuration(perRaw)
+	if err != nil || per <= 0 {
+		return RateLimit{}, fmt.Errorf("rate limit %q: duration must be positive", s)
+	}
+
+	return RateLimit{Requests: requests, Per: per}, nil
 }
@@ -0,0 +1,406 @@
+package config
+
+import (
+	"bytes"
+	"encoding"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	configFileFlag = "config"
+	configFileEnv  = "CONFIG_FILE"
+)
+
+// setting is one configuration value that can be set from an environment variable and
+// a command line flag. Either name may be empty; secrets have no flags because command
+// lines are visible to other processes.
+type setting struct {
+	flag   string
+	env    string
+	usage  string
+	set    func(c *Config, raw string) error
+	isBool bool
+}
+
+func settings() []setting {
+	return []setting{
+		// DEBUG=true predates LOG_LEVEL and is kept for existing deployments.
+		{
+			env: "DEBUG",
+			set: func(c *Config, raw string) error {
+				debug, err := strconv.ParseBool(raw)
+				if debug {
+					c.Log.Level = DebugLevel
+				}
+				return err
+			},
+		},
+		{
+			flag:  "storage",
+			env:   "STORAGE",
+			usage: "storage: postgres, or memory to run without a database",
+			set:   stringSetter(func(c *Config) *string { return &c.Storage }),
+		},
+		{
+			flag:  "log-level",
+			env:   "LOG_LEVEL",
+			usage: "log level: debug, info, warn or error",
+			set:   textSetter(func(c *Config) encoding.TextUnmarshaler { return &c.Log.Level }),
+		},
+		{
+			flag:  "log-format",
+			env:   "LOG_FORMAT",
+			usage: "log format: json or console",
+			set:   stringSetter(func(c *Config) *string { return &c.Log.Format }),
+		},
+		{
+			flag:  "http-address",
+			env:   "SERVER_PORT",
+			usage: "address to listen on",
+			set:   stringSetter(func(c *Config) *string { return &c.HTTP.Address }),
+		},
+		{
+			flag:  "http-read-timeout",
+			env:   "HTTP_READ_TIMEOUT",
+			usage: "timeout for reading a whole request",
+			set:   durationSetter(func(c *Config) *time.Duration { return &c.HTTP.ReadTimeout }),
+		},
+		{
+			flag:  "http-read-header-timeout",
+			env:   "HTTP_READ_HEADER_TIMEOUT",
+			usage: "timeout for reading request headers",
+			set:   durationSetter(func(c *Config) *time.Duration { return &c.HTTP.ReadHeaderTimeout }),
+		},
+		{
+			flag:  "http-write-timeout",
+			env:   "HTTP_WRITE_TIMEOUT",
+			usage: "timeout for writing a response",
+			set:   durationSetter(func(c *Config) *time.Duration { return &c.HTTP.WriteTimeout }),
+		},
+		{
+			flag:  "http-idle-timeout",
+			env:   "HTTP_IDLE_TIMEOUT",
+			usage: "how long idle keep-alive connections are kept",
+			set:   durationSetter(func(c *Config) *time.Duration { return &c.HTTP.IdleTimeout }),
+		},
+		{
+			flag:  "db-connection-string",
+			env:   "DATABASE_CONNECTION_STRING",
+			usage: "Postgres connection string",
+			set:   stringSetter(func(c *Config) *string { return &c.DBConfig.ConnectionString }),
+		},
+		{
+			flag:  "db-max-open-conns",
+			env:   "MAX_OPEN_CONNS",
+			usage: "maximum number of pooled connections",
+			set: func(c *Config, raw string) error {
+				n, err := strconv.ParseInt(raw, 10, 32)
+				if err != nil {
+					return errors.New("must be an integer")
+				}
+				c.DBConfig.MaxOpenConns = int32(n)
+				return nil
+			},
+		},
+		{
+			flag:  "db-max-lifetime",
+			env:   "MAX_LIFE_TIME",
+			usage: "maximum lifetime of a pooled connection",
+			set:   durationSetter(func(c *Config) *time.Duration { return &c.DBConfig.MaxLifetime }),
+		},
+		{
+			flag:  "db-max-idle-time",
+			env:   "MAX_IDLE_TIME",
+			usage: "how long a pooled connection may stay idle",
+			set:   durationSetter(func(c *Config) *time.Duration { return &c.DBConfig.MaxIdleTime }),
+		},
+		{
+			flag:   "db-auto-migrate",
+			env:    "DB_AUTO_MIGRATE",
+			usage:  "apply pending migrations on serve",
+			set:    boolSetter(func(c *Config) *bool { return &c.DBConfig.AutoMigrate }),
+			isBool: true,
+		},
+		{env: "AUTH_ADMIN_API_KEY", set: stringSetter(func(c *Config) *string { return &c.Auth.AdminAPIKey })},
+		{env: "JWT_HMAC_SECRET", set: stringSetter(func(c *Config) *string { return &c.Auth.JWT.HMACSecret })},
+		{
+			flag:  "jwks-file",
+			env:   "JWKS_FILE",
+			usage: "file with the JSON Web Key Set for bearer tokens",
+			set:   stringSetter(func(c *Config) *string { return &c.Auth.JWT.JWKSFile }),
+		},
+		{
+			flag:  "jwt-issuer",
+			env:   "JWT_ISSUER",
+			usage: "required iss claim of bearer tokens",
+			set:   stringSetter(func(c *Config) *string { return &c.Auth.JWT.Issuer }),
+		},
+		{
+			flag:  "jwt-audience",
+			env:   "JWT_AUDIENCE",
+			usage: "required aud claim of bearer tokens",
+			set:   stringSetter(func(c *Config) *string { return &c.Auth.JWT.Audience }),
+		},
+		{
+			flag:  "rate-limit-backend",
+			env:   "RATE_LIMIT_BACKEND",
+			usage: "rate limit backend: memory, postgres or off",
+			set:   stringSetter(func(c *Config) *string { return &c.RateLimit.Backend }),
+		},
+		{
+			flag:  "rate-limit-per-principal",
+			env:   "RATE_LIMIT_PER_PRINCIPAL",
+			usage: "limit per principal, e.g. 300/1m",
+			set:   textSetter(func(c *Config) encoding.TextUnmarshaler { return &c.RateLimit.PerPrincipal }),
+		},
+		{
+			flag:  "rate-limit-per-ip",
+			env:   "RATE_LIMIT_PER_IP",
+			usage: "limit per client IP, e.g. 600/1m",
+			set:   textSetter(func(c *Config) encoding.TextUnmarshaler { return &c.RateLimit.PerIP }),
+		},
+		{
+			flag:  "rate-limit-sum",
+			env:   "RATE_LIMIT_SUM",
+			usage: "limit of GET /subscriptions/sum and /subscriptions/sum/monthly per client, e.g. 30/1m",
+			set: func(c *Config, raw string) error {
+				limit, err := ParseRateLimit(raw)
+				if err != nil {
+					return err
+				}
+				if c.RateLimit.Routes == nil {
+					c.RateLimit.Routes = make(map[string]RateLimit)
+				}
+				c.RateLimit.Routes["GET /subscriptions/sum"] = limit
+				c.RateLimit.Routes["GET /subscriptions/sum/monthly"] = limit
+				return nil
+			},
+		},
+		{
+			flag:  "idempotency-ttl",
+			env:   "IDEMPOTENCY_TTL",
+			usage: "how long responses to Idempotency-Key requests are kept",
+			set:   durationSetter(func(c *Config) *time.Duration { return &c.Idempotency.TTL }),
+		},
+		{
+			flag:  "cancellation-reasons",
+			env:   "CANCELLATION_REASONS",
+			usage: "comma-separated reason codes subscriptions can be cancelled with",
+			set: func(c *Config, raw string) error {
+				c.Cancellation.Reasons = nil
+				for _, reason := range strings.Split(raw, ",") {
+					if reason = strings.TrimSpace(reason); reason != "" {
+						c.Cancellation.Reasons = append(c.Cancellation.Reasons, reason)
+					}
+				}
+				return nil
+			},
+		},
+		{
+			flag:  "renewal-interval",
+			env:   "RENEWAL_INTERVAL",
+			usage: "how often subscriptions are renewed automatically, 0 to turn renewal off",
+			set:   durationSetter(func(c *Config) *time.Duration { return &c.Renewal.Interval }),
+		},
+		{
+			flag:  "reminder-interval",
+			env:   "REMINDER_INTERVAL",
+			usage: "how often due reminders are sent, 0 to turn reminders off",
+			set:   durationSetter(func(c *Config) *time.Duration { return &c.Reminders.Interval }),
+		},
+		{
+			flag:  "reminder-notifier",
+			env:   "REMINDER_NOTIFIER",
+			usage: "channel of reminders: log, smtp or webhook",
+			set:   stringSetter(func(c *Config) *string { return &c.Reminders.Notifier }),
+		},
+		{
+			flag:  "smtp-address",
+			env:   "SMTP_ADDRESS",
+			usage: "host:port of the mail server of the smtp notifier",
+			set:   stringSetter(func(c *Config) *string { return &c.Reminders.SMTP.Address }),
+		},
+		{
+			flag:  "smtp-from",
+			env:   "SMTP_FROM",
+			usage: "sender address of reminder emails",
+			set:   stringSetter(func(c *Config) *string { return &c.Reminders.SMTP.From }),
+		},
+		{
+			flag:  "smtp-username",
+			env:   "SMTP_USERNAME",
+			usage: "user of the mail server, if it requires authentication",
+			set:   stringSetter(func(c *Config) *string { return &c.Reminders.SMTP.Username }),
+		},
+		{env: "SMTP_PASSWORD", set: stringSetter(func(c *Config) *string { return &c.Reminders.SMTP.Password })},
+		{
+			flag:  "webhook-url",
+			env:   "WEBHOOK_URL",
+			usage: "URL the webhook notifier posts reminders to",
+			set:   stringSetter(func(c *Config) *string { return &c.Reminders.Webhook.URL }),
+		},
+		{env: "WEBHOOK_SECRET", set: stringSetter(func(c *Config) *string { return &c.Reminders.Webhook.Secret })},
+	}
+}
+
+func stringSetter(field func(*Config) *string) func(*Config, string) error {
+	return func(c *Config, raw string) error {
+		*field(c) = raw
+		return nil
+	}
+}
+
+func boolSetter(field func(*Config) *bool) func(*Config, string) error {
+	return func(c *Config, raw string) error {
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", raw)
+		}
+		*field(c) = b
+		return nil
+	}
+}
+
+func durationSetter(field func(*Config) *time.Duration) func(*Config, string) error {
+	return func(c *Config, raw string) error {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", raw)
+		}
+		*field(c) = d
+		return nil
+	}
+}
+
+func textSetter(field func(*Config) encoding.TextUnmarshaler) func(*Config, string) error {
+	return func(c *Config, raw string) error {
+		return field(c).UnmarshalText([]byte(raw))
+	}
+}
+
+// Load builds the configuration from, in increasing priority, the defaults, an optional
+// YAML or TOML file given by --config or CONFIG_FILE, environment variables and flags
+// of fs. The flags are registered on fs and parsed from args, so callers can add their
+// own flags and read positional arguments afterwards. All problems are reported at once.
+func Load(fs *flag.FlagSet, args []string, lookupEnv func(string) (string, bool)) (*Config, error) {
+	all := settings()
+
+	file := fs.String(configFileFlag, "", "YAML or TOML configuration file (env "+configFileEnv+")")
+
+	type flagValue struct {
+		setting setting
+		raw     string
+	}
+	var flags []flagValue
+
+	for _, s := range all {
+		if s.flag == "" {
+			continue
+		}
+
+		usage := s.usage
+		if s.env != "" {
+			usage += " (env " + s.env + ")"
+		}
+
+		record := func(raw string) error {
+			flags = append(flags, flagValue{setting: s, raw: raw})
+			return nil
+		}
+
+		if s.isBool {
+			fs.BoolFunc(s.flag, usage, record)
+		} else {
+			fs.Func(s.flag, usage, record)
+		}
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	cfg := Default()
+
+	var errs []error
+
+	path := *file
+	if path == "" {
+		path, _ = lookupEnv(configFileEnv)
+	}
+
+	if path != "" {
+		if err := loadFile(&cfg, path); err != nil {
+			errs = append(errs, fmt.Errorf("config file %s: %w", path, err))
+		}
+	}
+
+	for _, s := range all {
+		raw, ok := lookupEnv(s.env)
+		if s.env == "" || !ok || raw == "" {
+			continue
+		}
+
+		if err := s.set(&cfg, raw); err != nil {
+			errs = append(errs, fmt.Errorf("env %s: %w", s.env, err))
+		}
+	}
+
+	for _, f := range flags {
+		if err := f.setting.set(&cfg, f.raw); err != nil {
+			errs = append(errs, fmt.Errorf("flag --%s: %w", f.setting.flag, err))
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
+
+func loadFile(cfg *Config, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+
+		if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		return nil
+	case ".toml":
+		meta, err := toml.Decode(string(data), cfg)
+		if err != nil {
+			return err
+		}
+
+		if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+			return fmt.Errorf("unknown keys %v", undecoded)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported format %q, expected .yaml, .yml or .toml", ext)
+	}
+}
@@ -0,0 +1,237 @@
+package config_test
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"subscription-service/internal/config"
+)
+
+const connStr = "postgresql://postgres:password@db:5432/subscriptions_db?sslmode=disable"
+
+func env(vars map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		v, ok := vars[key]
+		return v, ok
+	}
+}
+
+func load(t *testing.T, args []string, vars map[string]string) (*config.Config, error) {
+	t.Helper()
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	return config.Load(fs, args, env(vars))
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	path := writeFile(t, "config.yaml", `
+log:
+  format: console
+http:
+  read_timeout: 20s
+  write_timeout: 20s
+  idle_timeout: 20s
+database:
+  connection_string: `+connStr+`
+rate_limit:
+  per_ip: 10/1s
+`)
+
+	cfg, err := load(t, []string{"--config", path, "--http-idle-timeout", "40s"}, map[string]string{
+		"HTTP_WRITE_TIMEOUT": "30s",
+		"HTTP_IDLE_TIMEOUT":  "30s",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.HTTP.ReadHeaderTimeout != 5*time.Second {
+		t.Errorf("expected default read header timeout, got %s", cfg.HTTP.ReadHeaderTimeout)
+	}
+	if cfg.HTTP.ReadTimeout != 20*time.Second || cfg.Log.Format != config.LogFormatConsole {
+		t.Errorf("expected values from the file, got %s and %q", cfg.HTTP.ReadTimeout, cfg.Log.Format)
+	}
+	if cfg.HTTP.WriteTimeout != 30*time.Second {
+		t.Errorf("expected env to override the file, got %s", cfg.HTTP.WriteTimeout)
+	}
+	if cfg.HTTP.IdleTimeout != 40*time.Second {
+		t.Errorf("expected flag to override env, got %s", cfg.HTTP.IdleTimeout)
+	}
+	if cfg.RateLimit.PerIP != (config.RateLimit{Requests: 10, Per: time.Second}) {
+		t.Errorf("unexpected per IP limit %+v", cfg.RateLimit.PerIP)
+	}
+}
+
+func TestLoadTOML(t *testing.T) {
+	path := writeFile(t, "config.toml", `
+[log]
+level = "warn"
+
+[database]
+connection_string = "`+connStr+`"
+max_open_conns = 5
+max_lifetime = "1h"
+
+[rate_limit.routes]
+"GET /subscriptions/sum" = "5/1m"
+`)
+
+	cfg, err := load(t, nil, map[string]string{"CONFIG_FILE": path})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.Log.Level != config.WarnLevel || cfg.DBConfig.MaxOpenConns != 5 || cfg.DBConfig.MaxLifetime != time.Hour {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+	if limit := cfg.RateLimit.Routes["GET /subscriptions/sum"]; limit.Requests != 5 {
+		t.Errorf("unexpected route limit %+v", limit)
+	}
+}
+
+func TestLoadReportsEveryProblem(t *testing.T) {
+	path := writeFile(t, "config.yaml", "http:\n  unknown_key: 1\n")
+
+	_, err := load(t, []string{"--config", path, "--log-format", "xml"}, map[string]string{
+		"MAX_OPEN_CONNS":    "many",
+		"HTTP_READ_TIMEOUT": "-1s",
+	})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	for _, want := range []string{
+		"config file",
+		"env MAX_OPEN_CONNS",
+		"http.read_timeout",
+		"log.format",
+		"database.connection_string: is required",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected %q to be reported, got:\n%v", want, err)
+		}
+	}
+}
+
+func TestLoadMemoryStorage(t *testing.T) {
+	cfg, err := load(t, []string{"--storage", "memory"}, nil)
+	if err != nil {
+		t.Fatalf("memory storage needs no database: %v", err)
+	}
+	if cfg.Storage != config.StorageMemory {
+		t.Errorf("unexpected storage %q", cfg.Storage)
+	}
+
+	_, err = load(t, []string{"--storage", "memory", "--rate-limit-backend", "postgres"}, nil)
+	if err == nil || !strings.Contains(err.Error(), "rate_limit.backend") {
+		t.Errorf("expected the postgres rate limiter to be rejected, got %v", err)
+	}
+}
+
+func TestLoadCancellationReasons(t *testing.T) {
+	cfg, err := load(t, []string{"--storage", "memory"}, map[string]string{
+		"CANCELLATION_REASONS": "price, moved ,,other",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(cfg.Cancellation.Reasons, ","); got != "price,moved,other" {
+		t.Errorf("reasons = %q, want price,moved,other", got)
+	}
+
+	_, err = load(t, []string{"--storage", "memory", "--cancellation-reasons", "Price,other,other"}, nil)
+	if err == nil || !strings.Contains(err.Error(), `"Price" must be`) || !strings.Contains(err.Error(), `"other" is repeated`) {
+		t.Errorf("expected the invalid and repeated reasons to be reported, got %v", err)
+	}
+}
+
+func TestPrintRedactsSecretsAndRoundTrips(t *testing.T) {
+	cfg, err := load(t, nil, map[string]string{
+		"DATABASE_CONNECTION_STRING": connStr,
+		"AUTH_ADMIN_API_KEY":         "sk_admin_secret",
+		"JWT_HMAC_SECRET":            "hmac-secret",
+		"SMTP_PASSWORD":              "smtp-secret",
+		"WEBHOOK_SECRET":             "webhook-secret",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := cfg.Print(&out); err != nil {
+		t.Fatal(err)
+	}
+
+	// The connection string's password is "password", which is also a key of the output.
+	for _, secret := range []string{":password@", "sk_admin_secret", "hmac-secret", "smtp-secret", "webhook-secret"} {
+		if strings.Contains(out.String(), secret) {
+			t.Errorf("secret %q leaked:\n%s", secret, out.String())
+		}
+	}
+
+	printed, err := load(t, []string{"--config", writeFile(t, "printed.yaml", out.String())}, nil)
+	if err != nil {
+		t.Fatalf("printed config doesn't load: %v", err)
+	}
+
+	if printed.HTTP != cfg.HTTP || printed.RateLimit.PerIP != cfg.RateLimit.PerIP {
+		t.Errorf("printed config differs: %+v", printed)
+	}
+}
+
+func TestRedactedKeywordConnectionString(t *testing.T) {
+	cfg := config.Default()
+	cfg.DBConfig.ConnectionString = "host=db user=postgres password=secret dbname=subscriptions_db"
+
+	got := cfg.Redacted().DBConfig.ConnectionString
+	if strings.Contains(got, "secret") || !strings.Contains(got, "dbname=subscriptions_db") {
+		t.Errorf("unexpected redacted connection string %q", got)
+	}
+}
+
+func TestRedactedURLConnectionString(t *testing.T) {
+	tests := []struct {
+		name    string
+		connStr string
+		want    string
+	}{
+		{"user info", "postgres://postgres:secret@db:5432/subscriptions_db?sslmode=disable",
+			"postgres://postgres:REDACTED@db:5432/subscriptions_db?sslmode=disable"},
+		{"query parameter", "postgres://db/subscriptions_db?password=secret&sslmode=disable",
+			"postgres://db/subscriptions_db?password=REDACTED&sslmode=disable"},
+		{"both", "postgres://postgres:secret@db/subscriptions_db?sslmode=disable&password=secret",
+			"postgres://postgres:REDACTED@db/subscriptions_db?sslmode=disable&password=REDACTED"},
+		{"no password", "postgres://postgres@db/subscriptions_db?sslmode=disable",
+			"postgres://postgres@db/subscriptions_db?sslmode=disable"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.Default()
+			cfg.DBConfig.ConnectionString = tt.connStr
+
+			if got := cfg.Redacted().DBConfig.ConnectionString; got != tt.want {
+				t.Errorf("redacted connection string %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
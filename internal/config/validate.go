@@ -0,0 +1,162 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const redacted = "REDACTED"
+
+// Validate checks required fields and ranges and reports every problem it finds.
+func (c *Config) Validate() error {
+	var errs []error
+
+	check := func(ok bool, field, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(field+": "+format, args...))
+		}
+	}
+
+	positive := func(field string, d time.Duration) {
+		check(d > 0, field, "must be positive, got %s", d)
+	}
+
+	_, levelKnown := logLevelNames[c.Log.Level]
+	check(levelKnown, "log.level", "unknown level %d", c.Log.Level)
+	check(c.Log.Format == LogFormatJSON || c.Log.Format == LogFormatConsole,
+		"log.format", "must be %q or %q, got %q", LogFormatJSON, LogFormatConsole, c.Log.Format)
+
+	check(c.HTTP.Address != "", "http.address", "is required")
+	positive("http.read_timeout", c.HTTP.ReadTimeout)
+	positive("http.read_header_timeout", c.HTTP.ReadHeaderTimeout)
+	positive("http.write_timeout", c.HTTP.WriteTimeout)
+	positive("http.idle_timeout", c.HTTP.IdleTimeout)
+	check(c.HTTP.ReadHeaderTimeout <= c.HTTP.ReadTimeout, "http.read_header_timeout",
+		"must not exceed http.read_timeout (%s)", c.HTTP.ReadTimeout)
+
+	switch c.Storage {
+	case StoragePostgres:
+		check(c.DBConfig.ConnectionString != "", "database.connection_string", "is required")
+		check(c.DBConfig.MaxOpenConns > 0, "database.max_open_conns", "must be positive, got %d", c.DBConfig.MaxOpenConns)
+		positive("database.max_lifetime", c.DBConfig.MaxLifetime)
+		positive("database.max_idle_time", c.DBConfig.MaxIdleTime)
+	case StorageMemory:
+		check(c.RateLimit.Backend != RateLimitBackendPostgres, "rate_limit.backend",
+			"can't be %q with %q storage", RateLimitBackendPostgres, StorageMemory)
+	default:
+		check(false, "storage", "must be %q or %q, got %q", StoragePostgres, StorageMemory, c.Storage)
+	}
+
+	switch c.RateLimit.Backend {
+	case RateLimitBackendMemory, RateLimitBackendPostgres:
+		check(c.RateLimit.PerPrincipal.Requests > 0, "rate_limit.per_principal", "is required")
+		check(c.RateLimit.PerIP.Requests > 0, "rate_limit.per_ip", "is required")
+	case RateLimitBackendOff:
+	default:
+		check(false, "rate_limit.backend", "must be %q, %q or %q, got %q",
+			RateLimitBackendMemory, RateLimitBackendPostgres, RateLimitBackendOff, c.RateLimit.Backend)
+	}
+
+	positive("idempotency.ttl", c.Idempotency.TTL)
+	positive("idempotency.lease", c.Idempotency.Lease)
+
+	check(len(c.Cancellation.Reasons) > 0, "cancellation.reasons", "is required")
+	for i, reason := range c.Cancellation.Reasons {
+		check(reasonCode.MatchString(reason), "cancellation.reasons",
+			"%q must be 1 to 64 lowercase letters, digits and underscores", reason)
+		check(!slices.Contains(c.Cancellation.Reasons[:i], reason), "cancellation.reasons", "%q is repeated", reason)
+	}
+
+	check(c.Renewal.Interval >= 0, "renewal.interval", "must not be negative, got %s", c.Renewal.Interval)
+
+	check(c.Reminders.Interval >= 0, "reminders.interval", "must not be negative, got %s", c.Reminders.Interval)
+	switch c.Reminders.Notifier {
+	case NotifierLog:
+	case NotifierSMTP:
+		check(c.Reminders.SMTP.Address != "", "reminders.smtp.address", "is required")
+		check(c.Reminders.SMTP.From != "", "reminders.smtp.from", "is required")
+		positive("reminders.smtp.timeout", c.Reminders.SMTP.Timeout)
+	case NotifierWebhook:
+		u, err := url.Parse(c.Reminders.Webhook.URL)
+		check(err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "",
+			"reminders.webhook.url", "must be an http or https URL, got %q", c.Reminders.Webhook.URL)
+		positive("reminders.webhook.timeout", c.Reminders.Webhook.Timeout)
+	default:
+		check(false, "reminders.notifier", "must be %q, %q or %q, got %q",
+			NotifierLog, NotifierSMTP, NotifierWebhook, c.Reminders.Notifier)
+	}
+
+	return errors.Join(errs...)
+}
+
+// reasonCode matches cancellation reason codes; the API limits them to 64 characters.
+var reasonCode = regexp.MustCompile(`^[a-z0-9_]{1,64}$`)
+
+var dsnPassword = regexp.MustCompile(`(password\s*=\s*)('[^']*'|\S+)`)
+
+// Redacted returns a copy of the configuration with secrets replaced.
+func (c Config) Redacted() Config {
+	if c.Auth.AdminAPIKey != "" {
+		c.Auth.AdminAPIKey = redacted
+	}
+
+	if c.Auth.JWT.HMACSecret != "" {
+		c.Auth.JWT.HMACSecret = redacted
+	}
+
+	if c.Reminders.SMTP.Password != "" {
+		c.Reminders.SMTP.Password = redacted
+	}
+
+	if c.Reminders.Webhook.Secret != "" {
+		c.Reminders.Webhook.Secret = redacted
+	}
+
+	if u, err := url.Parse(c.DBConfig.ConnectionString); err == nil && u.Scheme != "" {
+		if u.User != nil {
+			if _, hasPassword := u.User.Password(); hasPassword {
+				u.User = url.UserPassword(u.User.Username(), redacted)
+			}
+		}
+		u.RawQuery = redactQueryPassword(u.RawQuery)
+		c.DBConfig.ConnectionString = u.String()
+	} else {
+		c.DBConfig.ConnectionString = dsnPassword.ReplaceAllString(c.DBConfig.ConnectionString, "${1}"+redacted)
+	}
+
+	return c
+}
+
+// redactQueryPassword replaces the values of the password parameters of a URL query,
+// keeping the other parameters as they are written.
+func redactQueryPassword(query string) string {
+	params := strings.Split(query, "&")
+	for i, param := range params {
+		if key, _, ok := strings.Cut(param, "="); ok && key == "password" {
+			params[i] = key + "=" + redacted
+		}
+	}
+
+	return strings.Join(params, "&")
+}
+
+// Print writes the configuration as YAML, in the format accepted by Load, with secrets
+// redacted.
+func (c Config) Print(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(c.Redacted()); err != nil {
+		return err
+	}
+
+	return enc.Close()
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/controller/http/gen"
+	pkg "subscription-service/internal/pkg/utils"
+)
+
+func (r *Server) PostAdminApiKeys(
+	ctx context.Context,
+	request gen.PostAdminApiKeysRequestObject,
+) (gen.PostAdminApiKeysResponseObject, error) {
+	req := entity.CreateAPIKeyRequest{
+		Name: request.Body.Name,
+		Role: entity.Role(request.Body.Role),
+	}
+	if request.Body.UserId != nil {
+		req.UserID = pkg.PointerTo(request.Body.UserId.String())
+	}
+	if request.Body.TenantId != nil {
+		req.TenantID = pkg.PointerTo(request.Body.TenantId.String())
+	}
+
+	key, plaintext, err := r.authUsecase.CreateAPIKey(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("create api key: %w", err)
+	}
+
+	apiKey := toAPIKey(*key)
+
+	return gen.PostAdminApiKeys201JSONResponse(gen.CreatedAPIKey{
+		Id:        apiKey.Id,
+		Name:      apiKey.Name,
+		Prefix:    apiKey.Prefix,
+		UserId:    apiKey.UserId,
+		TenantId:  apiKey.TenantId,
+		Role:      apiKey.Role,
+		CreatedAt: apiKey.CreatedAt,
+		RevokedAt: apiKey.RevokedAt,
+		Key:       plaintext,
+	}), nil
+}
+
+func (r *Server) GetAdminApiKeys(
+	ctx context.Context,
+	_ gen.GetAdminApiKeysRequestObject,
+) (gen.GetAdminApiKeysResponseObject, error) {
+	keys, err := r.authUsecase.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list api keys: %w", err)
+	}
+
+	resp := make([]gen.APIKey, len(keys))
+
+	for i, k := range keys {
+		resp[i] = toAPIKey(k)
+	}
+	return gen.GetAdminApiKeys200JSONResponse(resp), nil
+}
+
+func (r *Server) DeleteAdminApiKeysId(
+	ctx context.Context,
+	request gen.DeleteAdminApiKeysIdRequestObject,
+) (gen.DeleteAdminApiKeysIdResponseObject, error) {
+	err := r.authUsecase.RevokeAPIKey(ctx, request.Id.String())
+	if err != nil {
+		return nil, fmt.Errorf("revoke api key: %w", err)
+	}
+	return gen.DeleteAdminApiKeysId204Response{}, nil
+}
+
+func toAPIKey(k entity.APIKey) gen.APIKey {
+	resp := gen.APIKey{
+		Id:        *pkg.UUID(k.ID),
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Role:      gen.Role(k.Role),
+		CreatedAt: time.UnixMilli(k.CreatedAt).UTC(),
+	}
+
+	if k.UserID != nil {
+		resp.UserId = pkg.UUID(*k.UserID)
+	}
+	if k.TenantID != nil {
+		resp.TenantId = pkg.UUID(*k.TenantID)
+	}
+	if k.RevokedAt != nil {
+		resp.RevokedAt = pkg.PointerTo(time.UnixMilli(*k.RevokedAt).UTC())
+	}
+
+	return resp
+}
@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"time"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/controller/http/gen"
+)
+
+func (r *Server) GetAnalyticsMrr(
+	ctx context.Context,
+	request gen.GetAnalyticsMrrRequestObject,
+) (gen.GetAnalyticsMrrResponseObject, error) {
+	metrics, err := r.metrics(ctx, request.Params.StartDate, request.Params.EndDate, request.Params.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := gen.RevenueReport{Months: make([]gen.RevenueMonth, len(metrics))}
+	for i, m := range metrics {
+		resp.Months[i] = gen.RevenueMonth{
+			Month:          formatMonth(m.Month),
+			Mrr:            int(m.MRR),
+			Arr:            int(m.ARR()),
+			NewMrr:         int(m.NewMRR),
+			ChurnedMrr:     int(m.ChurnedMRR),
+			ExpansionMrr:   int(m.ExpansionMRR),
+			ContractionMrr: int(m.ContractionMRR),
+			NetNewMrr:      int(m.NewMRR + m.ExpansionMRR - m.ChurnedMRR - m.ContractionMRR),
+		}
+	}
+
+	return gen.GetAnalyticsMrr200JSONResponse(resp), nil
+}
+
+func (r *Server) GetAnalyticsChurn(
+	ctx context.Context,
+	request gen.GetAnalyticsChurnRequestObject,
+) (gen.GetAnalyticsChurnResponseObject, error) {
+	metrics, err := r.metrics(ctx, request.Params.StartDate, request.Params.EndDate, request.Params.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := gen.ChurnReport{Months: make([]gen.ChurnMonth, len(metrics))}
+	for i, m := range metrics {
+		resp.Months[i] = gen.ChurnMonth{
+			Month:               formatMonth(m.Month),
+			ActiveSubscribers:   int(m.Subscribers),
+			PreviousSubscribers: int(m.PreviousSubscribers),
+			NewSubscribers:      int(m.NewSubscribers),
+			ChurnedSubscribers:  int(m.ChurnedSubscribers),
+			LogoChurnRate:       m.LogoChurnRate(),
+		}
+	}
+
+	return gen.GetAnalyticsChurn200JSONResponse(resp), nil
+}
+
+// metrics parses the period of an analytics report and computes its metrics.
+func (r *Server) metrics(ctx context.Context, startDate, endDate string, serviceName *string) ([]entity.MonthlyMetrics, error) {
+	filter := entity.MetricsFilter{Title: serviceName}
+
+	var err error
+
+	filter.From, filter.To, err = parsePeriod("start_date", startDate, "end_date", endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := r.analyticsUsecase.Metrics(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("metrics: %w", err)
+	}
+
+	return metrics, nil
+}
+
+func (r *Server) GetAnalyticsCohorts(
+	ctx context.Context,
+	request gen.GetAnalyticsCohortsRequestObject,
+) (gen.GetAnalyticsCohortsResponseObject, error) {
+	filter := entity.CohortFilter{Title: request.Params.ServiceName}
+
+	var err error
+
+	filter.From, filter.To, err = parsePeriod("from", request.Params.From, "to", request.Params.To)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := r.analyticsUsecase.Cohorts(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("cohorts: %w", err)
+	}
+
+	if request.Params.Format != nil && *request.Params.Format == gen.Csv {
+		body, err := cohortsCSV(report.Cohorts)
+		if err != nil {
+			return nil, fmt.Errorf("write cohorts: %w", err)
+		}
+		return gen.GetAnalyticsCohorts200TextcsvResponse{Body: bytes.NewReader(body), ContentLength: int64(len(body))}, nil
+	}
+
+	resp := gen.CohortReport{AsOf: formatMonth(report.AsOf), Cohorts: make([]gen.Cohort, len(report.Cohorts))}
+	for i, c := range report.Cohorts {
+		resp.Cohorts[i] = gen.Cohort{
+			Month:     formatMonth(c.Month),
+			Size:      int(c.Size),
+			Retained:  make([]int, len(c.Retained)),
+			Retention: c.Retention(),
+		}
+		for j, n := range c.Retained {
+			resp.Cohorts[i].Retained[j] = int(n)
+		}
+	}
+
+	return gen.GetAnalyticsCohorts200JSONResponse(resp), nil
+}
+
+// cohortsCSV writes the retention of the cohorts as a table with a row per cohort and a
+// column per month after it; the months a cohort hasn't reached yet are empty.
+func cohortsCSV(cohorts []entity.Cohort) ([]byte, error) {
+	months := 0
+	for _, c := range cohorts {
+		months = max(months, len(c.Retained))
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	header := []string{"cohort", "size"}
+	for i := 1; i <= months; i++ {
+		header = append(header, fmt.Sprintf("month_%d", i))
+	}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	for _, c := range cohorts {
+		record := make([]string, 2, len(header))
+		record[0], record[1] = formatMonth(c.Month), strconv.FormatInt(c.Size, 10)
+		for _, share := range c.Retention() {
+			record = append(record, strconv.FormatFloat(share, 'f', 4, 64))
+		}
+		for len(record) < len(header) {
+			record = append(record, "")
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+
+	return buf.Bytes(), w.Error()
+}
+
+// parsePeriod parses the months of a report period, which spans from one to
+// maxPeriodMonths months.
+func parsePeriod(startName, start, endName, end string) (time.Time, time.Time, error) {
+	from, err := parseMonthParam(startName, gen.Query, start)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	to, err := parseMonthParam(endName, gen.Query, end)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	switch months := entity.MonthsBetween(from, to); {
+	case months < 1:
+		return time.Time{}, time.Time{}, invalidParam(endName, gen.Query, "must not be before "+startName)
+	case months > maxPeriodMonths:
+		return time.Time{}, time.Time{}, invalidParam(endName, gen.Query,
+			fmt.Sprintf("the period spans at most %d months", maxPeriodMonths))
+	}
+
+	return from, to, nil
+}
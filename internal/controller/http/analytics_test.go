@@ -0,0 +1,96 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/app/usecase"
+	"subscription-service/internal/config"
+	handler "subscription-service/internal/controller/http"
+)
+
+type stubAnalytics struct {
+	usecase.AnalyticsUseCase
+
+	filter entity.CohortFilter
+}
+
+func (s *stubAnalytics) Cohorts(_ context.Context, filter entity.CohortFilter) (*entity.CohortReport, error) {
+	s.filter = filter
+
+	return &entity.CohortReport{
+		AsOf: time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC),
+		Cohorts: []entity.Cohort{
+			{Month: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), Size: 4, Retained: []int64{3, 2, 2}},
+			{Month: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC), Size: 2, Retained: []int64{1}},
+		},
+	}, nil
+}
+
+func TestCohorts(t *testing.T) {
+	analytics := &stubAnalytics{}
+
+	router, err := handler.NewServer(
+		config.HTTPConfig{Address: ":0"}, &stubUsecase{}, &stubAuth{}, nil, nil, analytics, nil, nil, zap.NewNop(),
+	).Router(handler.WithResponseValidation())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		query       string
+		contentType string
+		body        string
+	}{
+		{
+			name:        "json",
+			query:       "from=01-2025&to=03-2025&service_name=Okko",
+			contentType: "application/json",
+			body: `{"as_of":"04-2025","cohorts":[` +
+				`{"month":"01-2025","retained":[3,2,2],"retention":[0.75,0.5,0.5],"size":4},` +
+				`{"month":"03-2025","retained":[1],"retention":[0.5],"size":2}]}` + "\n",
+		},
+		{
+			name:        "csv",
+			query:       "from=01-2025&to=03-2025&service_name=Okko&format=csv",
+			contentType: "text/csv",
+			body: "cohort,size,month_1,month_2,month_3\n" +
+				"01-2025,4,0.7500,0.5000,0.5000\n" +
+				"03-2025,2,0.5000,,\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/analytics/cohorts?"+tt.query, nil)
+			req.Header.Set("X-API-Key", adminKey)
+			req.Header.Set("X-Tenant-ID", userTenant)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != tt.contentType {
+				t.Errorf("expected content type %q, got %q", tt.contentType, ct)
+			}
+			if rec.Body.String() != tt.body {
+				t.Errorf("body:\n%s\nwant:\n%s", rec.Body, tt.body)
+			}
+
+			if analytics.filter.Title == nil || *analytics.filter.Title != "Okko" ||
+				!analytics.filter.From.Equal(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)) ||
+				!analytics.filter.To.Equal(time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)) {
+				t.Errorf("filter = %+v, want Okko from 01-2025 to 03-2025", analytics.filter)
+			}
+		})
+	}
+}
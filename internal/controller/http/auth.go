@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/app/usecase"
+	"subscription-service/internal/controller/http/gen"
+)
+
+const (
+	apiKeyHeader = "X-API-Key"
+	tenantHeader = "X-Tenant-ID"
+	bearerPrefix = "Bearer "
+
+	authChallenge = `Bearer realm="subscription-service", ApiKey header="X-API-Key"`
+)
+
+// authenticate resolves the caller from the X-API-Key or Authorization header and puts
+// the principal into the request context. Requests without credentials are passed on
+// untouched: whether an operation needs a principal is decided by authorize.
+func (r *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var (
+			principal entity.Principal
+			err       error
+		)
+
+		apiKey := req.Header.Get(apiKeyHeader)
+		authorization := req.Header.Get("Authorization")
+
+		switch {
+		case apiKey != "":
+			principal, err = r.authUsecase.AuthenticateAPIKey(req.Context(), apiKey)
+		case authorization != "":
+			token, ok := strings.CutPrefix(authorization, bearerPrefix)
+			if !ok || token == "" {
+				r.writeError(w, req, usecase.ErrUnauthenticated)
+				return
+			}
+			principal, err = r.authUsecase.AuthenticateToken(req.Context(), token)
+		default:
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		if err != nil {
+			r.writeError(w, req, err)
+			return
+		}
+
+		tenantID, err := resolveTenant(principal, req.Header.Get(tenantHeader))
+		if err != nil {
+			r.writeError(w, req, err)
+			return
+		}
+
+		ctx := entity.WithPrincipal(req.Context(), principal)
+		if tenantID != "" {
+			ctx = entity.WithTenant(ctx, tenantID)
+		}
+
+		next.ServeHTTP(w, req.WithContext(ctx))
+	})
+}
+
+// resolveTenant picks the tenant of the request. Credentials bound to a tenant always
+// act within it; only platform admins may choose a tenant with the X-Tenant-ID header.
+func resolveTenant(principal entity.Principal, header string) (string, error) {
+	if principal.TenantID != "" {
+		if header != "" && header != principal.TenantID {
+			return "", usecase.ErrForbidden
+		}
+		return principal.TenantID, nil
+	}
+
+	if header == "" {
+		return "", nil
+	}
+
+	if !principal.IsAdmin() {
+		return "", usecase.ErrForbidden
+	}
+
+	if _, err := uuid.Parse(header); err != nil {
+		return "", invalidParam(tenantHeader, gen.Header, "must be a uuid")
+	}
+
+	return header, nil
+}
+
+// authorize is a strict middleware enforcing the security requirements of the operation.
+// The generated router stores the scopes of every scheme in the context; scopes are
+// role names the principal must hold.
+func (r *Server) authorize(f gen.StrictHandlerFunc, _ string) gen.StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, req *http.Request, request any) (any, error) {
+		scopes, secured := operationScopes(ctx)
+		if !secured {
+			return f(ctx, w, req, request)
+		}
+
+		principal, ok := entity.PrincipalFromContext(ctx)
+		if !ok {
+			return nil, usecase.ErrUnauthenticated
+		}
+
+		for _, scope := range scopes[principal.Method] {
+			if !principal.HasRole(entity.Role(scope)) {
+				return nil, usecase.ErrForbidden
+			}
+		}
+
+		return f(ctx, w, req, request)
+	}
+}
+
+func operationScopes(ctx context.Context) (map[entity.AuthMethod][]string, bool) {
+	apiKeyScopes, apiKeySecured := ctx.Value(gen.ApiKeyAuthScopes).([]string)
+	bearerScopes, bearerSecured := ctx.Value(gen.BearerAuthScopes).([]string)
+
+	if !apiKeySecured && !bearerSecured {
+		return nil, false
+	}
+
+	return map[entity.AuthMethod][]string{
+		entity.AuthMethodAPIKey: apiKeyScopes,
+		entity.AuthMethodJWT:    bearerScopes,
+	}, true
+}
@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/controller/http/gen"
+	pkg "subscription-service/internal/pkg/utils"
+)
+
+func (r *Server) GetUsersIdBudgets(
+	ctx context.Context,
+	request gen.GetUsersIdBudgetsRequestObject,
+) (gen.GetUsersIdBudgetsResponseObject, error) {
+	budgets, err := r.budgetUsecase.List(ctx, request.Id.String())
+	if err != nil {
+		return nil, fmt.Errorf("list budgets: %w", err)
+	}
+
+	resp := gen.BudgetList{Items: make([]gen.Budget, len(budgets))}
+	for i, b := range budgets {
+		resp.Items[i] = toBudget(b)
+	}
+
+	return gen.GetUsersIdBudgets200JSONResponse(resp), nil
+}
+
+func (r *Server) PostUsersIdBudgets(
+	ctx context.Context,
+	request gen.PostUsersIdBudgetsRequestObject,
+) (gen.PostUsersIdBudgetsResponseObject, error) {
+	budget := fromBudgetRequest(*request.Body)
+	budget.UserID = request.Id.String()
+	budget.CreatedAt = time.Now().UnixMilli()
+	budget.UpdatedAt = budget.CreatedAt
+
+	created, err := r.budgetUsecase.Create(ctx, budget)
+	if err != nil {
+		return nil, fmt.Errorf("create budget: %w", err)
+	}
+
+	return gen.PostUsersIdBudgets201JSONResponse(toBudget(*created)), nil
+}
+
+func (r *Server) GetUsersIdBudgetsBudgetId(
+	ctx context.Context,
+	request gen.GetUsersIdBudgetsBudgetIdRequestObject,
+) (gen.GetUsersIdBudgetsBudgetIdResponseObject, error) {
+	budget, err := r.budgetUsecase.Read(ctx, request.Id.String(), request.BudgetId.String())
+	if err != nil {
+		return nil, fmt.Errorf("get budget: %w", err)
+	}
+
+	return gen.GetUsersIdBudgetsBudgetId200JSONResponse(toBudget(*budget)), nil
+}
+
+func (r *Server) PutUsersIdBudgetsBudgetId(
+	ctx context.Context,
+	request gen.PutUsersIdBudgetsBudgetIdRequestObject,
+) (gen.PutUsersIdBudgetsBudgetIdResponseObject, error) {
+	budget := fromBudgetRequest(*request.Body)
+	budget.ID = request.BudgetId.String()
+	budget.UserID = request.Id.String()
+	budget.UpdatedAt = time.Now().UnixMilli()
+
+	updated, err := r.budgetUsecase.Update(ctx, budget)
+	if err != nil {
+		return nil, fmt.Errorf("update budget: %w", err)
+	}
+
+	return gen.PutUsersIdBudgetsBudgetId200JSONResponse(toBudget(*updated)), nil
+}
+
+func (r *Server) DeleteUsersIdBudgetsBudgetId(
+	ctx context.Context,
+	request gen.DeleteUsersIdBudgetsBudgetIdRequestObject,
+) (gen.DeleteUsersIdBudgetsBudgetIdResponseObject, error) {
+	if err := r.budgetUsecase.Delete(ctx, request.Id.String(), request.BudgetId.String()); err != nil {
+		return nil, fmt.Errorf("delete budget: %w", err)
+	}
+
+	return gen.DeleteUsersIdBudgetsBudgetId204Response{}, nil
+}
+
+func (r *Server) GetUsersIdBudgetStatus(
+	ctx context.Context,
+	request gen.GetUsersIdBudgetStatusRequestObject,
+) (gen.GetUsersIdBudgetStatusResponseObject, error) {
+	month, err := parseMonthParam("month", gen.Query, request.Params.Month)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := r.budgetUsecase.Status(ctx, request.Id.String(), month)
+	if err != nil {
+		return nil, fmt.Errorf("budget status: %w", err)
+	}
+
+	resp := gen.BudgetStatusReport{Month: formatMonth(month), Budgets: make([]gen.BudgetStatus, len(statuses))}
+	for i, s := range statuses {
+		resp.Budgets[i] = gen.BudgetStatus{
+			BudgetId:  *pkg.UUID(s.ID),
+			Limit:     s.Limit,
+			Cost:      s.Cost,
+			Remaining: s.Limit - s.Cost,
+			Exceeded:  s.Exceeded(),
+			Strict:    s.Strict,
+		}
+		if s.Category != "" {
+			resp.Budgets[i].Category = &s.Category
+		}
+	}
+
+	return gen.GetUsersIdBudgetStatus200JSONResponse(resp), nil
+}
+
+func fromBudgetRequest(body gen.BudgetRequest) entity.Budget {
+	budget := entity.Budget{Limit: body.Limit}
+	if body.Category != nil {
+		budget.Category = *body.Category
+	}
+	if body.Services != nil {
+		budget.Services = *body.Services
+	}
+	if body.Strict != nil {
+		budget.Strict = *body.Strict
+	}
+
+	return budget
+}
+
+func toBudget(b entity.Budget) gen.Budget {
+	resp := gen.Budget{
+		Id:        *pkg.UUID(b.ID),
+		UserId:    *pkg.UUID(b.UserID),
+		Limit:     b.Limit,
+		Strict:    b.Strict,
+		CreatedAt: time.UnixMilli(b.CreatedAt).UTC(),
+		UpdatedAt: time.UnixMilli(b.UpdatedAt).UTC(),
+	}
+	if b.Category != "" {
+		resp.Category = &b.Category
+		resp.Services = &b.Services
+	}
+
+	return resp
+}
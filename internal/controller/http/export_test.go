@@ -0,0 +1,7 @@
+package handler
+
+var (
+	RequestID  = requestID
+	AccessLog  = accessLog
+	RedactBody = redactBody
+)
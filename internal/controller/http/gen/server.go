@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
@@ -17,30 +18,180 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Список API-ключей
+	// (GET /admin/api-keys)
+	GetAdminApiKeys(w http.ResponseWriter, r *http.Request)
+	// Выпустить API-ключ
+	// (POST /admin/api-keys)
+	PostAdminApiKeys(w http.ResponseWriter, r *http.Request)
+	// Отозвать API-ключ
+	// (DELETE /admin/api-keys/{id})
+	DeleteAdminApiKeysId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Причины отмены подписок
+	// (GET /analytics/cancellations)
+	GetAnalyticsCancellations(w http.ResponseWriter, r *http.Request, params GetAnalyticsCancellationsParams)
+	// Отток подписчиков по месяцам
+	// (GET /analytics/churn)
+	GetAnalyticsChurn(w http.ResponseWriter, r *http.Request, params GetAnalyticsChurnParams)
+	// Удержание подписок по когортам
+	// (GET /analytics/cohorts)
+	GetAnalyticsCohorts(w http.ResponseWriter, r *http.Request, params GetAnalyticsCohortsParams)
+	// Выручка по месяцам
+	// (GET /analytics/mrr)
+	GetAnalyticsMrr(w http.ResponseWriter, r *http.Request, params GetAnalyticsMrrParams)
+	// Каталог сервисов
+	// (GET /services)
+	GetServices(w http.ResponseWriter, r *http.Request, params GetServicesParams)
+	// Добавить сервис в каталог
+	// (POST /services)
+	PostServices(w http.ResponseWriter, r *http.Request, params PostServicesParams)
+	// Удалить сервис
+	// (DELETE /services/{service_id})
+	DeleteServicesServiceId(w http.ResponseWriter, r *http.Request, serviceId ServiceID, params DeleteServicesServiceIdParams)
+	// Сервис каталога
+	// (GET /services/{service_id})
+	GetServicesServiceId(w http.ResponseWriter, r *http.Request, serviceId ServiceID, params GetServicesServiceIdParams)
+	// Изменить сервис
+	// (PUT /services/{service_id})
+	PutServicesServiceId(w http.ResponseWriter, r *http.Request, serviceId ServiceID, params PutServicesServiceIdParams)
 	// Список подписок
 	// (GET /subscriptions)
 	GetSubscriptions(w http.ResponseWriter, r *http.Request, params GetSubscriptionsParams)
 	// Создать подписку
 	// (POST /subscriptions)
-	PostSubscriptions(w http.ResponseWriter, r *http.Request)
+	PostSubscriptions(w http.ResponseWriter, r *http.Request, params PostSubscriptionsParams)
+	// Прогноз расходов на подписки
+	// (GET /subscriptions/forecast)
+	GetSubscriptionsForecast(w http.ResponseWriter, r *http.Request, params GetSubscriptionsForecastParams)
 	// Агрегация стоимости подписок
 	// (GET /subscriptions/sum)
 	GetSubscriptionsSum(w http.ResponseWriter, r *http.Request, params GetSubscriptionsSumParams)
+	// Помесячная стоимость подписок
+	// (GET /subscriptions/sum/monthly)
+	GetSubscriptionsSumMonthly(w http.ResponseWriter, r *http.Request, params GetSubscriptionsSumMonthlyParams)
 	// Уд.лить подписку
 	// (DELETE /subscriptions/{id})
-	DeleteSubscriptionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	DeleteSubscriptionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteSubscriptionsIdParams)
 	// Получить подписку по ID
 	// (GET /subscriptions/{id})
-	GetSubscriptionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	GetSubscriptionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetSubscriptionsIdParams)
 	// Обновить подписку
 	// (PUT /subscriptions/{id})
-	PutSubscriptionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	PutSubscriptionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PutSubscriptionsIdParams)
+	// Отменить подписку
+	// (POST /subscriptions/{id}/cancel)
+	PostSubscriptionsIdCancel(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostSubscriptionsIdCancelParams)
+	// События подписки
+	// (GET /subscriptions/{id}/events)
+	GetSubscriptionsIdEvents(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetSubscriptionsIdEventsParams)
+	// Приостановить подписку
+	// (POST /subscriptions/{id}/pause)
+	PostSubscriptionsIdPause(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostSubscriptionsIdPauseParams)
+	// Возобновить подписку
+	// (POST /subscriptions/{id}/resume)
+	PostSubscriptionsIdResume(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostSubscriptionsIdResumeParams)
+	// Исполнение бюджетов за месяц
+	// (GET /users/{id}/budget-status)
+	GetUsersIdBudgetStatus(w http.ResponseWriter, r *http.Request, id UserID, params GetUsersIdBudgetStatusParams)
+	// Бюджеты пользователя
+	// (GET /users/{id}/budgets)
+	GetUsersIdBudgets(w http.ResponseWriter, r *http.Request, id UserID, params GetUsersIdBudgetsParams)
+	// Создать бюджет
+	// (POST /users/{id}/budgets)
+	PostUsersIdBudgets(w http.ResponseWriter, r *http.Request, id UserID, params PostUsersIdBudgetsParams)
+	// Удалить бюджет
+	// (DELETE /users/{id}/budgets/{budget_id})
+	DeleteUsersIdBudgetsBudgetId(w http.ResponseWriter, r *http.Request, id UserID, budgetId BudgetID, params DeleteUsersIdBudgetsBudgetIdParams)
+	// Бюджет пользователя
+	// (GET /users/{id}/budgets/{budget_id})
+	GetUsersIdBudgetsBudgetId(w http.ResponseWriter, r *http.Request, id UserID, budgetId BudgetID, params GetUsersIdBudgetsBudgetIdParams)
+	// Изменить бюджет
+	// (PUT /users/{id}/budgets/{budget_id})
+	PutUsersIdBudgetsBudgetId(w http.ResponseWriter, r *http.Request, id UserID, budgetId BudgetID, params PutUsersIdBudgetsBudgetIdParams)
+	// Настройки напоминаний пользователя
+	// (GET /users/{id}/reminder-preferences)
+	GetUsersIdReminderPreferences(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetUsersIdReminderPreferencesParams)
+	// Изменить настройки напоминаний
+	// (PUT /users/{id}/reminder-preferences)
+	PutUsersIdReminderPreferences(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PutUsersIdReminderPreferencesParams)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Список API-ключей
+// (GET /admin/api-keys)
+func (_ Unimplemented) GetAdminApiKeys(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выпустить API-ключ
+// (POST /admin/api-keys)
+func (_ Unimplemented) PostAdminApiKeys(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отозвать API-ключ
+// (DELETE /admin/api-keys/{id})
+func (_ Unimplemented) DeleteAdminApiKeysId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Причины отмены подписок
+// (GET /analytics/cancellations)
+func (_ Unimplemented) GetAnalyticsCancellations(w http.ResponseWriter, r *http.Request, params GetAnalyticsCancellationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отток подписчиков по месяцам
+// (GET /analytics/churn)
+func (_ Unimplemented) GetAnalyticsChurn(w http.ResponseWriter, r *http.Request, params GetAnalyticsChurnParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удержание подписок по когортам
+// (GET /analytics/cohorts)
+func (_ Unimplemented) GetAnalyticsCohorts(w http.ResponseWriter, r *http.Request, params GetAnalyticsCohortsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выручка по месяцам
+// (GET /analytics/mrr)
+func (_ Unimplemented) GetAnalyticsMrr(w http.ResponseWriter, r *http.Request, params GetAnalyticsMrrParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Каталог сервисов
+// (GET /services)
+func (_ Unimplemented) GetServices(w http.ResponseWriter, r *http.Request, params GetServicesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить сервис в каталог
+// (POST /services)
+func (_ Unimplemented) PostServices(w http.ResponseWriter, r *http.Request, params PostServicesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить сервис
+// (DELETE /services/{service_id})
+func (_ Unimplemented) DeleteServicesServiceId(w http.ResponseWriter, r *http.Request, serviceId ServiceID, params DeleteServicesServiceIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Сервис каталога
+// (GET /services/{service_id})
+func (_ Unimplemented) GetServicesServiceId(w http.ResponseWriter, r *http.Request, serviceId ServiceID, params GetServicesServiceIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить сервис
+// (PUT /services/{service_id})
+func (_ Unimplemented) PutServicesServiceId(w http.ResponseWriter, r *http.Request, serviceId ServiceID, params PutServicesServiceIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Список подписок
 // (GET /subscriptions)
 func (_ Unimplemented) GetSubscriptions(w http.ResponseWriter, r *http.Request, params GetSubscriptionsParams) {
@@ -49,7 +200,13 @@ func (_ Unimplemented) GetSubscriptions(w http.ResponseWriter, r *http.Request,
 
 // Создать подписку
 // (POST /subscriptions)
-func (_ Unimplemented) PostSubscriptions(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) PostSubscriptions(w http.ResponseWriter, r *http.Request, params PostSubscriptionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Прогноз расходов на подписки
+// (GET /subscriptions/forecast)
+func (_ Unimplemented) GetSubscriptionsForecast(w http.ResponseWriter, r *http.Request, params GetSubscriptionsForecastParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -59,21 +216,99 @@ func (_ Unimplemented) GetSubscriptionsSum(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Помесячная стоимость подписок
+// (GET /subscriptions/sum/monthly)
+func (_ Unimplemented) GetSubscriptionsSumMonthly(w http.ResponseWriter, r *http.Request, params GetSubscriptionsSumMonthlyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Уд.лить подписку
 // (DELETE /subscriptions/{id})
-func (_ Unimplemented) DeleteSubscriptionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+func (_ Unimplemented) DeleteSubscriptionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteSubscriptionsIdParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Получить подписку по ID
 // (GET /subscriptions/{id})
-func (_ Unimplemented) GetSubscriptionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+func (_ Unimplemented) GetSubscriptionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetSubscriptionsIdParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Обновить подписку
 // (PUT /subscriptions/{id})
-func (_ Unimplemented) PutSubscriptionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+func (_ Unimplemented) PutSubscriptionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PutSubscriptionsIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отменить подписку
+// (POST /subscriptions/{id}/cancel)
+func (_ Unimplemented) PostSubscriptionsIdCancel(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostSubscriptionsIdCancelParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// События подписки
+// (GET /subscriptions/{id}/events)
+func (_ Unimplemented) GetSubscriptionsIdEvents(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetSubscriptionsIdEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Приостановить подписку
+// (POST /subscriptions/{id}/pause)
+func (_ Unimplemented) PostSubscriptionsIdPause(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostSubscriptionsIdPauseParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Возобновить подписку
+// (POST /subscriptions/{id}/resume)
+func (_ Unimplemented) PostSubscriptionsIdResume(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostSubscriptionsIdResumeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Исполнение бюджетов за месяц
+// (GET /users/{id}/budget-status)
+func (_ Unimplemented) GetUsersIdBudgetStatus(w http.ResponseWriter, r *http.Request, id UserID, params GetUsersIdBudgetStatusParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Бюджеты пользователя
+// (GET /users/{id}/budgets)
+func (_ Unimplemented) GetUsersIdBudgets(w http.ResponseWriter, r *http.Request, id UserID, params GetUsersIdBudgetsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать бюджет
+// (POST /users/{id}/budgets)
+func (_ Unimplemented) PostUsersIdBudgets(w http.ResponseWriter, r *http.Request, id UserID, params PostUsersIdBudgetsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить бюджет
+// (DELETE /users/{id}/budgets/{budget_id})
+func (_ Unimplemented) DeleteUsersIdBudgetsBudgetId(w http.ResponseWriter, r *http.Request, id UserID, budgetId BudgetID, params DeleteUsersIdBudgetsBudgetIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Бюджет пользователя
+// (GET /users/{id}/budgets/{budget_id})
+func (_ Unimplemented) GetUsersIdBudgetsBudgetId(w http.ResponseWriter, r *http.Request, id UserID, budgetId BudgetID, params GetUsersIdBudgetsBudgetIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить бюджет
+// (PUT /users/{id}/budgets/{budget_id})
+func (_ Unimplemented) PutUsersIdBudgetsBudgetId(w http.ResponseWriter, r *http.Request, id UserID, budgetId BudgetID, params PutUsersIdBudgetsBudgetIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Настройки напоминаний пользователя
+// (GET /users/{id}/reminder-preferences)
+func (_ Unimplemented) GetUsersIdReminderPreferences(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetUsersIdReminderPreferencesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить настройки напоминаний
+// (PUT /users/{id}/reminder-preferences)
+func (_ Unimplemented) PutUsersIdReminderPreferences(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PutUsersIdReminderPreferencesParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -86,38 +321,99 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// GetSubscriptions operation middleware
-func (siw *ServerInterfaceWrapper) GetSubscriptions(w http.ResponseWriter, r *http.Request) {
+// GetAdminApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminApiKeys(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSubscriptionsParams
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"admin"})
 
-	// ------------- Optional query parameter "user_id" -------------
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin"})
 
-	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
-		return
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminApiKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "service_name" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "service_name", r.URL.Query(), &params.ServiceName)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "service_name", Err: err})
-		return
+// PostAdminApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminApiKeys(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"admin"})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminApiKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "price" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "price", r.URL.Query(), &params.Price)
+// DeleteAdminApiKeysId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAdminApiKeysId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "price", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{"admin"})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{"admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAdminApiKeysId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAnalyticsCancellations operation middleware
+func (siw *ServerInterfaceWrapper) GetAnalyticsCancellations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAnalyticsCancellationsParams
+
 	// ------------- Optional query parameter "start_date" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "start_date", r.URL.Query(), &params.StartDate)
@@ -134,38 +430,45 @@ func (siw *ServerInterfaceWrapper) GetSubscriptions(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "user_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "service_name" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "service_name", r.URL.Query(), &params.ServiceName)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "service_name", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSubscriptions(w, r, params)
-	}))
+	headers := r.Header
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
 
-	handler.ServeHTTP(w, r)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
 
-// PostSubscriptions operation middleware
-func (siw *ServerInterfaceWrapper) PostSubscriptions(w http.ResponseWriter, r *http.Request) {
+		params.XTenantID = &XTenantID
+
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostSubscriptions(w, r)
+		siw.Handler.GetAnalyticsCancellations(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -175,13 +478,21 @@ func (siw *ServerInterfaceWrapper) PostSubscriptions(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetSubscriptionsSum operation middleware
-func (siw *ServerInterfaceWrapper) GetSubscriptionsSum(w http.ResponseWriter, r *http.Request) {
+// GetAnalyticsChurn operation middleware
+func (siw *ServerInterfaceWrapper) GetAnalyticsChurn(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSubscriptionsSumParams
+	var params GetAnalyticsChurnParams
 
 	// ------------- Required query parameter "start_date" -------------
 
@@ -213,14 +524,6 @@ func (siw *ServerInterfaceWrapper) GetSubscriptionsSum(w http.ResponseWriter, r
 		return
 	}
 
-	// ------------- Optional query parameter "user_id" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
-		return
-	}
-
 	// ------------- Optional query parameter "service_name" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "service_name", r.URL.Query(), &params.ServiceName)
@@ -229,8 +532,29 @@ func (siw *ServerInterfaceWrapper) GetSubscriptionsSum(w http.ResponseWriter, r
 		return
 	}
 
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSubscriptionsSum(w, r, params)
+		siw.Handler.GetAnalyticsChurn(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -240,47 +564,91 @@ func (siw *ServerInterfaceWrapper) GetSubscriptionsSum(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteSubscriptionsId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSubscriptionsId(w http.ResponseWriter, r *http.Request) {
+// GetAnalyticsCohorts operation middleware
+func (siw *ServerInterfaceWrapper) GetAnalyticsCohorts(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAnalyticsCohortsParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteSubscriptionsId(w, r, id)
-	}))
+	// ------------- Required query parameter "to" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-// GetSubscriptionsId operation middleware
-func (siw *ServerInterfaceWrapper) GetSubscriptionsId(w http.ResponseWriter, r *http.Request) {
+	// ------------- Optional query parameter "service_name" -------------
 
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "service_name", r.URL.Query(), &params.ServiceName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "service_name", Err: err})
+		return
+	}
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// ------------- Optional query parameter "format" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
 		return
 	}
 
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSubscriptionsId(w, r, id)
+		siw.Handler.GetAnalyticsCohorts(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -290,490 +658,5652 @@ func (siw *ServerInterfaceWrapper) GetSubscriptionsId(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// PutSubscriptionsId operation middleware
-func (siw *ServerInterfaceWrapper) PutSubscriptionsId(w http.ResponseWriter, r *http.Request) {
+// GetAnalyticsMrr operation middleware
+func (siw *ServerInterfaceWrapper) GetAnalyticsMrr(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutSubscriptionsId(w, r, id)
-	}))
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAnalyticsMrrParams
+
+	// ------------- Required query parameter "start_date" -------------
+
+	if paramValue := r.URL.Query().Get("start_date"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "start_date"})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	err = runtime.BindQueryParameter("form", true, true, "start_date", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
+		return
+	}
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+	// ------------- Required query parameter "end_date" -------------
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	if paramValue := r.URL.Query().Get("end_date"); paramValue != "" {
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "end_date"})
+		return
+	}
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindQueryParameter("form", true, true, "end_date", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_date", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Optional query parameter "service_name" -------------
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindQueryParameter("form", true, false, "service_name", r.URL.Query(), &params.ServiceName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "service_name", Err: err})
+		return
+	}
 
-type RequiredParamError struct {
-	ParamName string
-}
+	headers := r.Header
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+		params.XTenantID = &XTenantID
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAnalyticsMrr(w, r, params)
+	}))
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+	handler.ServeHTTP(w, r)
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+// GetServices operation middleware
+func (siw *ServerInterfaceWrapper) GetServices(w http.ResponseWriter, r *http.Request) {
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	var err error
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	ctx := r.Context()
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	r = r.WithContext(ctx)
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetServicesParams
 
-	if r == nil {
-		r = chi.NewRouter()
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
+		return
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
 		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/subscriptions", wrapper.GetSubscriptions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/subscriptions", wrapper.PostSubscriptions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/subscriptions/sum", wrapper.GetSubscriptionsSum)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/subscriptions/{id}", wrapper.DeleteSubscriptionsId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/subscriptions/{id}", wrapper.GetSubscriptionsId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/subscriptions/{id}", wrapper.PutSubscriptionsId)
-	})
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServices(w, r, params)
+	}))
 
-	return r
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetSubscriptionsRequestObject struct {
-	Params GetSubscriptionsParams
+	handler.ServeHTTP(w, r)
 }
 
-type GetSubscriptionsResponseObject interface {
-	VisitGetSubscriptionsResponse(w http.ResponseWriter) error
-}
+// PostServices operation middleware
+func (siw *ServerInterfaceWrapper) PostServices(w http.ResponseWriter, r *http.Request) {
 
-type GetSubscriptions200JSONResponse []Subscription
+	var err error
 
-func (response GetSubscriptions200JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-type GetSubscriptions400JSONResponse ErrorResponse
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GetSubscriptions400JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostServicesParams
 
-type GetSubscriptions500JSONResponse ErrorResponse
+	headers := r.Header
 
-func (response GetSubscriptions500JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
 
-type PostSubscriptionsRequestObject struct {
-	Body *PostSubscriptionsJSONRequestBody
-}
+		params.XTenantID = &XTenantID
 
-type PostSubscriptionsResponseObject interface {
-	VisitPostSubscriptionsResponse(w http.ResponseWriter) error
-}
+	}
 
-type PostSubscriptions201JSONResponse Subscription
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostServices(w, r, params)
+	}))
 
-func (response PostSubscriptions201JSONResponse) VisitPostSubscriptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type PostSubscriptions400JSONResponse ErrorResponse
+// DeleteServicesServiceId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteServicesServiceId(w http.ResponseWriter, r *http.Request) {
 
-func (response PostSubscriptions400JSONResponse) VisitPostSubscriptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "service_id" -------------
+	var serviceId ServiceID
 
-type PostSubscriptions422JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "service_id", chi.URLParam(r, "service_id"), &serviceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "service_id", Err: err})
+		return
+	}
 
-func (response PostSubscriptions422JSONResponse) VisitPostSubscriptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
 
-type PostSubscriptions500JSONResponse ErrorResponse
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response PostSubscriptions500JSONResponse) VisitPostSubscriptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteServicesServiceIdParams
 
-type GetSubscriptionsSumRequestObject struct {
-	Params GetSubscriptionsSumParams
-}
+	headers := r.Header
 
-type GetSubscriptionsSumResponseObject interface {
-	VisitGetSubscriptionsSumResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
 
-type GetSubscriptionsSum200JSONResponse AggregationResult
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
 
-func (response GetSubscriptionsSum200JSONResponse) VisitGetSubscriptionsSumResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+		params.XTenantID = &XTenantID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	}
 
-type GetSubscriptionsSum400JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteServicesServiceId(w, r, serviceId, params)
+	}))
 
-func (response GetSubscriptionsSum400JSONResponse) VisitGetSubscriptionsSumResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetSubscriptionsSum500JSONResponse ErrorResponse
+// GetServicesServiceId operation middleware
+func (siw *ServerInterfaceWrapper) GetServicesServiceId(w http.ResponseWriter, r *http.Request) {
 
-func (response GetSubscriptionsSum500JSONResponse) VisitGetSubscriptionsSumResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	// ------------- Path parameter "service_id" -------------
+	var serviceId ServiceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "service_id", chi.URLParam(r, "service_id"), &serviceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "service_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetServicesServiceIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServicesServiceId(w, r, serviceId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutServicesServiceId operation middleware
+func (siw *ServerInterfaceWrapper) PutServicesServiceId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "service_id" -------------
+	var serviceId ServiceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "service_id", chi.URLParam(r, "service_id"), &serviceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "service_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutServicesServiceIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutServicesServiceId(w, r, serviceId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "service_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_name", r.URL.Query(), &params.ServiceName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "service_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "price" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "price", r.URL.Query(), &params.Price)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "price", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubscriptions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) PostSubscriptions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSubscriptionsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSubscriptions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSubscriptionsForecast operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionsForecast(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsForecastParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "months" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "months", r.URL.Query(), &params.Months)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "months", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "growth_percent" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "growth_percent", r.URL.Query(), &params.GrowthPercent)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "growth_percent", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubscriptionsForecast(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSubscriptionsSum operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionsSum(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsSumParams
+
+	// ------------- Required query parameter "start_date" -------------
+
+	if paramValue := r.URL.Query().Get("start_date"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "start_date"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "start_date", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "end_date" -------------
+
+	if paramValue := r.URL.Query().Get("end_date"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "end_date"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "end_date", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "service_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_name", r.URL.Query(), &params.ServiceName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "service_name", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubscriptionsSum(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSubscriptionsSumMonthly operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionsSumMonthly(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsSumMonthlyParams
+
+	// ------------- Required query parameter "start_date" -------------
+
+	if paramValue := r.URL.Query().Get("start_date"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "start_date"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "start_date", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_date", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "end_date" -------------
+
+	if paramValue := r.URL.Query().Get("end_date"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "end_date"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "end_date", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end_date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "service_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_name", r.URL.Query(), &params.ServiceName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "service_name", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubscriptionsSumMonthly(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSubscriptionsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSubscriptionsId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSubscriptionsIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSubscriptionsId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSubscriptionsId operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionsId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubscriptionsId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutSubscriptionsId operation middleware
+func (siw *ServerInterfaceWrapper) PutSubscriptionsId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutSubscriptionsIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutSubscriptionsId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostSubscriptionsIdCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostSubscriptionsIdCancel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSubscriptionsIdCancelParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSubscriptionsIdCancel(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSubscriptionsIdEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionsIdEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsIdEventsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubscriptionsIdEvents(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostSubscriptionsIdPause operation middleware
+func (siw *ServerInterfaceWrapper) PostSubscriptionsIdPause(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSubscriptionsIdPauseParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSubscriptionsIdPause(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostSubscriptionsIdResume operation middleware
+func (siw *ServerInterfaceWrapper) PostSubscriptionsIdResume(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSubscriptionsIdResumeParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSubscriptionsIdResume(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersIdBudgetStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersIdBudgetStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersIdBudgetStatusParams
+
+	// ------------- Required query parameter "month" -------------
+
+	if paramValue := r.URL.Query().Get("month"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "month"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "month", r.URL.Query(), &params.Month)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "month", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersIdBudgetStatus(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersIdBudgets operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersIdBudgets(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersIdBudgetsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersIdBudgets(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersIdBudgets operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersIdBudgets(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersIdBudgetsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersIdBudgets(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUsersIdBudgetsBudgetId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersIdBudgetsBudgetId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "budget_id" -------------
+	var budgetId BudgetID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "budget_id", chi.URLParam(r, "budget_id"), &budgetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "budget_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteUsersIdBudgetsBudgetIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUsersIdBudgetsBudgetId(w, r, id, budgetId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersIdBudgetsBudgetId operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersIdBudgetsBudgetId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "budget_id" -------------
+	var budgetId BudgetID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "budget_id", chi.URLParam(r, "budget_id"), &budgetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "budget_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersIdBudgetsBudgetIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersIdBudgetsBudgetId(w, r, id, budgetId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutUsersIdBudgetsBudgetId operation middleware
+func (siw *ServerInterfaceWrapper) PutUsersIdBudgetsBudgetId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "budget_id" -------------
+	var budgetId BudgetID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "budget_id", chi.URLParam(r, "budget_id"), &budgetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "budget_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutUsersIdBudgetsBudgetIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutUsersIdBudgetsBudgetId(w, r, id, budgetId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersIdReminderPreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersIdReminderPreferences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersIdReminderPreferencesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersIdReminderPreferences(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutUsersIdReminderPreferences operation middleware
+func (siw *ServerInterfaceWrapper) PutUsersIdReminderPreferences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutUsersIdReminderPreferencesParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Tenant-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant-ID")]; found {
+		var XTenantID TenantID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Tenant-ID", valueList[0], &XTenantID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant-ID", Err: err})
+			return
+		}
+
+		params.XTenantID = &XTenantID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutUsersIdReminderPreferences(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/api-keys", wrapper.GetAdminApiKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/api-keys", wrapper.PostAdminApiKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/api-keys/{id}", wrapper.DeleteAdminApiKeysId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/analytics/cancellations", wrapper.GetAnalyticsCancellations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/analytics/churn", wrapper.GetAnalyticsChurn)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/analytics/cohorts", wrapper.GetAnalyticsCohorts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/analytics/mrr", wrapper.GetAnalyticsMrr)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/services", wrapper.GetServices)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/services", wrapper.PostServices)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/services/{service_id}", wrapper.DeleteServicesServiceId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/services/{service_id}", wrapper.GetServicesServiceId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/services/{service_id}", wrapper.PutServicesServiceId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/subscriptions", wrapper.GetSubscriptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/subscriptions", wrapper.PostSubscriptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/subscriptions/forecast", wrapper.GetSubscriptionsForecast)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/subscriptions/sum", wrapper.GetSubscriptionsSum)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/subscriptions/sum/monthly", wrapper.GetSubscriptionsSumMonthly)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/subscriptions/{id}", wrapper.DeleteSubscriptionsId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/subscriptions/{id}", wrapper.GetSubscriptionsId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/subscriptions/{id}", wrapper.PutSubscriptionsId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/subscriptions/{id}/cancel", wrapper.PostSubscriptionsIdCancel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/subscriptions/{id}/events", wrapper.GetSubscriptionsIdEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/subscriptions/{id}/pause", wrapper.PostSubscriptionsIdPause)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/subscriptions/{id}/resume", wrapper.PostSubscriptionsIdResume)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{id}/budget-status", wrapper.GetUsersIdBudgetStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{id}/budgets", wrapper.GetUsersIdBudgets)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{id}/budgets", wrapper.PostUsersIdBudgets)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/{id}/budgets/{budget_id}", wrapper.DeleteUsersIdBudgetsBudgetId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{id}/budgets/{budget_id}", wrapper.GetUsersIdBudgetsBudgetId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/users/{id}/budgets/{budget_id}", wrapper.PutUsersIdBudgetsBudgetId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{id}/reminder-preferences", wrapper.GetUsersIdReminderPreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/users/{id}/reminder-preferences", wrapper.PutUsersIdReminderPreferences)
+	})
+
+	return r
+}
+
+type BadRequestApplicationProblemPlusJSONResponse Problem
+
+type ConflictApplicationProblemPlusJSONResponse Problem
+
+type ForbiddenApplicationProblemPlusJSONResponse Problem
+
+type InternalErrorApplicationProblemPlusJSONResponse Problem
+
+type NotFoundApplicationProblemPlusJSONResponse Problem
+
+type TooManyRequestsResponseHeaders struct {
+	RateLimitLimit     int
+	RateLimitRemaining int
+	RateLimitReset     int
+	RetryAfter         int
+}
+type TooManyRequestsApplicationProblemPlusJSONResponse struct {
+	Body Problem
+
+	Headers TooManyRequestsResponseHeaders
+}
+
+type UnauthorizedResponseHeaders struct {
+	WWWAuthenticate string
+}
+type UnauthorizedApplicationProblemPlusJSONResponse struct {
+	Body Problem
+
+	Headers UnauthorizedResponseHeaders
+}
+
+type UnprocessableEntityApplicationProblemPlusJSONResponse Problem
+
+type GetAdminApiKeysRequestObject struct {
+}
+
+type GetAdminApiKeysResponseObject interface {
+	VisitGetAdminApiKeysResponse(w http.ResponseWriter) error
+}
+
+type GetAdminApiKeys200JSONResponse []APIKey
+
+func (response GetAdminApiKeys200JSONResponse) VisitGetAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminApiKeys401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetAdminApiKeys401ApplicationProblemPlusJSONResponse) VisitGetAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAdminApiKeys403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetAdminApiKeys403ApplicationProblemPlusJSONResponse) VisitGetAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminApiKeys429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetAdminApiKeys429ApplicationProblemPlusJSONResponse) VisitGetAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAdminApiKeys500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetAdminApiKeys500ApplicationProblemPlusJSONResponse) VisitGetAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeysRequestObject struct {
+	Body *PostAdminApiKeysJSONRequestBody
+}
+
+type PostAdminApiKeysResponseObject interface {
+	VisitPostAdminApiKeysResponse(w http.ResponseWriter) error
+}
+
+type PostAdminApiKeys201JSONResponse CreatedAPIKey
+
+func (response PostAdminApiKeys201JSONResponse) VisitPostAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeys400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostAdminApiKeys400ApplicationProblemPlusJSONResponse) VisitPostAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeys401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostAdminApiKeys401ApplicationProblemPlusJSONResponse) VisitPostAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostAdminApiKeys403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostAdminApiKeys403ApplicationProblemPlusJSONResponse) VisitPostAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeys422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableEntityApplicationProblemPlusJSONResponse
+}
+
+func (response PostAdminApiKeys422ApplicationProblemPlusJSONResponse) VisitPostAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminApiKeys429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostAdminApiKeys429ApplicationProblemPlusJSONResponse) VisitPostAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostAdminApiKeys500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostAdminApiKeys500ApplicationProblemPlusJSONResponse) VisitPostAdminApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminApiKeysIdRequestObject struct {
+	Id openapi_types.UUID `json:"id"`
+}
+
+type DeleteAdminApiKeysIdResponseObject interface {
+	VisitDeleteAdminApiKeysIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteAdminApiKeysId204Response struct {
+}
+
+func (response DeleteAdminApiKeysId204Response) VisitDeleteAdminApiKeysIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAdminApiKeysId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteAdminApiKeysId400ApplicationProblemPlusJSONResponse) VisitDeleteAdminApiKeysIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminApiKeysId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteAdminApiKeysId401ApplicationProblemPlusJSONResponse) VisitDeleteAdminApiKeysIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteAdminApiKeysId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteAdminApiKeysId403ApplicationProblemPlusJSONResponse) VisitDeleteAdminApiKeysIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminApiKeysId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteAdminApiKeysId404ApplicationProblemPlusJSONResponse) VisitDeleteAdminApiKeysIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAdminApiKeysId429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteAdminApiKeysId429ApplicationProblemPlusJSONResponse) VisitDeleteAdminApiKeysIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteAdminApiKeysId500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteAdminApiKeysId500ApplicationProblemPlusJSONResponse) VisitDeleteAdminApiKeysIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsCancellationsRequestObject struct {
+	Params GetAnalyticsCancellationsParams
+}
+
+type GetAnalyticsCancellationsResponseObject interface {
+	VisitGetAnalyticsCancellationsResponse(w http.ResponseWriter) error
+}
+
+type GetAnalyticsCancellations200JSONResponse CancellationReport
+
+func (response GetAnalyticsCancellations200JSONResponse) VisitGetAnalyticsCancellationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsCancellations400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsCancellations400ApplicationProblemPlusJSONResponse) VisitGetAnalyticsCancellationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsCancellations401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsCancellations401ApplicationProblemPlusJSONResponse) VisitGetAnalyticsCancellationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAnalyticsCancellations403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsCancellations403ApplicationProblemPlusJSONResponse) VisitGetAnalyticsCancellationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsCancellations429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsCancellations429ApplicationProblemPlusJSONResponse) VisitGetAnalyticsCancellationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAnalyticsCancellations500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsCancellations500ApplicationProblemPlusJSONResponse) VisitGetAnalyticsCancellationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsChurnRequestObject struct {
+	Params GetAnalyticsChurnParams
+}
+
+type GetAnalyticsChurnResponseObject interface {
+	VisitGetAnalyticsChurnResponse(w http.ResponseWriter) error
+}
+
+type GetAnalyticsChurn200JSONResponse ChurnReport
+
+func (response GetAnalyticsChurn200JSONResponse) VisitGetAnalyticsChurnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsChurn400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsChurn400ApplicationProblemPlusJSONResponse) VisitGetAnalyticsChurnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsChurn401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsChurn401ApplicationProblemPlusJSONResponse) VisitGetAnalyticsChurnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAnalyticsChurn403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsChurn403ApplicationProblemPlusJSONResponse) VisitGetAnalyticsChurnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsChurn429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsChurn429ApplicationProblemPlusJSONResponse) VisitGetAnalyticsChurnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAnalyticsChurn500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsChurn500ApplicationProblemPlusJSONResponse) VisitGetAnalyticsChurnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsCohortsRequestObject struct {
+	Params GetAnalyticsCohortsParams
+}
+
+type GetAnalyticsCohortsResponseObject interface {
+	VisitGetAnalyticsCohortsResponse(w http.ResponseWriter) error
+}
+
+type GetAnalyticsCohorts200JSONResponse CohortReport
+
+func (response GetAnalyticsCohorts200JSONResponse) VisitGetAnalyticsCohortsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsCohorts200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetAnalyticsCohorts200TextcsvResponse) VisitGetAnalyticsCohortsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetAnalyticsCohorts400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsCohorts400ApplicationProblemPlusJSONResponse) VisitGetAnalyticsCohortsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsCohorts401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsCohorts401ApplicationProblemPlusJSONResponse) VisitGetAnalyticsCohortsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAnalyticsCohorts403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsCohorts403ApplicationProblemPlusJSONResponse) VisitGetAnalyticsCohortsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsCohorts429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsCohorts429ApplicationProblemPlusJSONResponse) VisitGetAnalyticsCohortsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAnalyticsCohorts500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsCohorts500ApplicationProblemPlusJSONResponse) VisitGetAnalyticsCohortsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsMrrRequestObject struct {
+	Params GetAnalyticsMrrParams
+}
+
+type GetAnalyticsMrrResponseObject interface {
+	VisitGetAnalyticsMrrResponse(w http.ResponseWriter) error
+}
+
+type GetAnalyticsMrr200JSONResponse RevenueReport
+
+func (response GetAnalyticsMrr200JSONResponse) VisitGetAnalyticsMrrResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsMrr400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsMrr400ApplicationProblemPlusJSONResponse) VisitGetAnalyticsMrrResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsMrr401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsMrr401ApplicationProblemPlusJSONResponse) VisitGetAnalyticsMrrResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAnalyticsMrr403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsMrr403ApplicationProblemPlusJSONResponse) VisitGetAnalyticsMrrResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalyticsMrr429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsMrr429ApplicationProblemPlusJSONResponse) VisitGetAnalyticsMrrResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetAnalyticsMrr500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalyticsMrr500ApplicationProblemPlusJSONResponse) VisitGetAnalyticsMrrResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServicesRequestObject struct {
+	Params GetServicesParams
+}
+
+type GetServicesResponseObject interface {
+	VisitGetServicesResponse(w http.ResponseWriter) error
+}
+
+type GetServices200JSONResponse ServiceList
+
+func (response GetServices200JSONResponse) VisitGetServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServices400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetServices400ApplicationProblemPlusJSONResponse) VisitGetServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServices401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetServices401ApplicationProblemPlusJSONResponse) VisitGetServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetServices403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetServices403ApplicationProblemPlusJSONResponse) VisitGetServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServices429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetServices429ApplicationProblemPlusJSONResponse) VisitGetServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetServices500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetServices500ApplicationProblemPlusJSONResponse) VisitGetServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostServicesRequestObject struct {
+	Params PostServicesParams
+	Body   *PostServicesJSONRequestBody
+}
+
+type PostServicesResponseObject interface {
+	VisitPostServicesResponse(w http.ResponseWriter) error
+}
+
+type PostServices201JSONResponse Service
+
+func (response PostServices201JSONResponse) VisitPostServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostServices400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostServices400ApplicationProblemPlusJSONResponse) VisitPostServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostServices401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostServices401ApplicationProblemPlusJSONResponse) VisitPostServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostServices403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostServices403ApplicationProblemPlusJSONResponse) VisitPostServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostServices409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response PostServices409ApplicationProblemPlusJSONResponse) VisitPostServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostServices422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableEntityApplicationProblemPlusJSONResponse
+}
+
+func (response PostServices422ApplicationProblemPlusJSONResponse) VisitPostServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostServices429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostServices429ApplicationProblemPlusJSONResponse) VisitPostServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostServices500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostServices500ApplicationProblemPlusJSONResponse) VisitPostServicesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServicesServiceIdRequestObject struct {
+	ServiceId ServiceID `json:"service_id"`
+	Params    DeleteServicesServiceIdParams
+}
+
+type DeleteServicesServiceIdResponseObject interface {
+	VisitDeleteServicesServiceIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteServicesServiceId204Response struct {
+}
+
+func (response DeleteServicesServiceId204Response) VisitDeleteServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteServicesServiceId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteServicesServiceId400ApplicationProblemPlusJSONResponse) VisitDeleteServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServicesServiceId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteServicesServiceId401ApplicationProblemPlusJSONResponse) VisitDeleteServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteServicesServiceId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteServicesServiceId403ApplicationProblemPlusJSONResponse) VisitDeleteServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServicesServiceId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteServicesServiceId404ApplicationProblemPlusJSONResponse) VisitDeleteServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteServicesServiceId429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteServicesServiceId429ApplicationProblemPlusJSONResponse) VisitDeleteServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteServicesServiceId500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteServicesServiceId500ApplicationProblemPlusJSONResponse) VisitDeleteServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServicesServiceIdRequestObject struct {
+	ServiceId ServiceID `json:"service_id"`
+	Params    GetServicesServiceIdParams
+}
+
+type GetServicesServiceIdResponseObject interface {
+	VisitGetServicesServiceIdResponse(w http.ResponseWriter) error
+}
+
+type GetServicesServiceId200JSONResponse Service
+
+func (response GetServicesServiceId200JSONResponse) VisitGetServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServicesServiceId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetServicesServiceId400ApplicationProblemPlusJSONResponse) VisitGetServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServicesServiceId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetServicesServiceId401ApplicationProblemPlusJSONResponse) VisitGetServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetServicesServiceId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetServicesServiceId403ApplicationProblemPlusJSONResponse) VisitGetServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServicesServiceId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetServicesServiceId404ApplicationProblemPlusJSONResponse) VisitGetServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetServicesServiceId429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetServicesServiceId429ApplicationProblemPlusJSONResponse) VisitGetServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetServicesServiceId500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetServicesServiceId500ApplicationProblemPlusJSONResponse) VisitGetServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutServicesServiceIdRequestObject struct {
+	ServiceId ServiceID `json:"service_id"`
+	Params    PutServicesServiceIdParams
+	Body      *PutServicesServiceIdJSONRequestBody
+}
+
+type PutServicesServiceIdResponseObject interface {
+	VisitPutServicesServiceIdResponse(w http.ResponseWriter) error
+}
+
+type PutServicesServiceId200JSONResponse Service
+
+func (response PutServicesServiceId200JSONResponse) VisitPutServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutServicesServiceId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PutServicesServiceId400ApplicationProblemPlusJSONResponse) VisitPutServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutServicesServiceId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PutServicesServiceId401ApplicationProblemPlusJSONResponse) VisitPutServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PutServicesServiceId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PutServicesServiceId403ApplicationProblemPlusJSONResponse) VisitPutServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutServicesServiceId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PutServicesServiceId404ApplicationProblemPlusJSONResponse) VisitPutServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutServicesServiceId409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response PutServicesServiceId409ApplicationProblemPlusJSONResponse) VisitPutServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutServicesServiceId422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableEntityApplicationProblemPlusJSONResponse
+}
+
+func (response PutServicesServiceId422ApplicationProblemPlusJSONResponse) VisitPutServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutServicesServiceId429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PutServicesServiceId429ApplicationProblemPlusJSONResponse) VisitPutServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PutServicesServiceId500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PutServicesServiceId500ApplicationProblemPlusJSONResponse) VisitPutServicesServiceIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsRequestObject struct {
+	Params GetSubscriptionsParams
+}
+
+type GetSubscriptionsResponseObject interface {
+	VisitGetSubscriptionsResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptions200JSONResponse []Subscription
+
+func (response GetSubscriptions200JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptions400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptions400ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptions401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptions401ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSubscriptions403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptions403ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptions429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptions429ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSubscriptions500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptions500ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsRequestObject struct {
+	Params PostSubscriptionsParams
+	Body   *PostSubscriptionsJSONRequestBody
+}
+
+type PostSubscriptionsResponseObject interface {
+	VisitPostSubscriptionsResponse(w http.ResponseWriter) error
+}
+
+type PostSubscriptions201JSONResponse Subscription
+
+func (response PostSubscriptions201JSONResponse) VisitPostSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptions400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptions400ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptions401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptions401ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostSubscriptions403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptions403ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptions409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptions409ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptions422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableEntityApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptions422ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptions429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptions429ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostSubscriptions500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptions500ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsForecastRequestObject struct {
+	Params GetSubscriptionsForecastParams
+}
+
+type GetSubscriptionsForecastResponseObject interface {
+	VisitGetSubscriptionsForecastResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptionsForecast200JSONResponse Forecast
+
+func (response GetSubscriptionsForecast200JSONResponse) VisitGetSubscriptionsForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsForecast400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsForecast400ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsForecast401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsForecast401ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSubscriptionsForecast403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsForecast403ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsForecast429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsForecast429ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSubscriptionsForecast500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsForecast500ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsForecastResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsSumRequestObject struct {
+	Params GetSubscriptionsSumParams
+}
+
+type GetSubscriptionsSumResponseObject interface {
+	VisitGetSubscriptionsSumResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptionsSum200JSONResponse AggregationResult
+
+func (response GetSubscriptionsSum200JSONResponse) VisitGetSubscriptionsSumResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsSum400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsSum400ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsSumResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsSum401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsSum401ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsSumResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSubscriptionsSum403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsSum403ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsSumResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsSum429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsSum429ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsSumResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSubscriptionsSum500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsSum500ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsSumResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsSumMonthlyRequestObject struct {
+	Params GetSubscriptionsSumMonthlyParams
+}
+
+type GetSubscriptionsSumMonthlyResponseObject interface {
+	VisitGetSubscriptionsSumMonthlyResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptionsSumMonthly200JSONResponse MonthlyCostReport
+
+func (response GetSubscriptionsSumMonthly200JSONResponse) VisitGetSubscriptionsSumMonthlyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsSumMonthly400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsSumMonthly400ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsSumMonthlyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsSumMonthly401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsSumMonthly401ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsSumMonthlyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSubscriptionsSumMonthly403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsSumMonthly403ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsSumMonthlyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsSumMonthly429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsSumMonthly429ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsSumMonthlyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSubscriptionsSumMonthly500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsSumMonthly500ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsSumMonthlyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSubscriptionsIdRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params DeleteSubscriptionsIdParams
+}
+
+type DeleteSubscriptionsIdResponseObject interface {
+	VisitDeleteSubscriptionsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteSubscriptionsId204Response struct {
+}
+
+func (response DeleteSubscriptionsId204Response) VisitDeleteSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSubscriptionsId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSubscriptionsId400ApplicationProblemPlusJSONResponse) VisitDeleteSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSubscriptionsId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSubscriptionsId401ApplicationProblemPlusJSONResponse) VisitDeleteSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteSubscriptionsId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSubscriptionsId403ApplicationProblemPlusJSONResponse) VisitDeleteSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSubscriptionsId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSubscriptionsId404ApplicationProblemPlusJSONResponse) VisitDeleteSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSubscriptionsId429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSubscriptionsId429ApplicationProblemPlusJSONResponse) VisitDeleteSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteSubscriptionsId500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSubscriptionsId500ApplicationProblemPlusJSONResponse) VisitDeleteSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsIdRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetSubscriptionsIdParams
+}
+
+type GetSubscriptionsIdResponseObject interface {
+	VisitGetSubscriptionsIdResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptionsId200JSONResponse Subscription
+
+func (response GetSubscriptionsId200JSONResponse) VisitGetSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsId400ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsId401ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSubscriptionsId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsId403ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsId404ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsId429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsId429ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSubscriptionsId500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsId500ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSubscriptionsIdRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PutSubscriptionsIdParams
+	Body   *PutSubscriptionsIdJSONRequestBody
+}
+
+type PutSubscriptionsIdResponseObject interface {
+	VisitPutSubscriptionsIdResponse(w http.ResponseWriter) error
+}
+
+type PutSubscriptionsId204Response struct {
+}
+
+func (response PutSubscriptionsId204Response) VisitPutSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PutSubscriptionsId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PutSubscriptionsId400ApplicationProblemPlusJSONResponse) VisitPutSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSubscriptionsId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PutSubscriptionsId401ApplicationProblemPlusJSONResponse) VisitPutSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PutSubscriptionsId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PutSubscriptionsId403ApplicationProblemPlusJSONResponse) VisitPutSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSubscriptionsId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PutSubscriptionsId404ApplicationProblemPlusJSONResponse) VisitPutSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSubscriptionsId409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response PutSubscriptionsId409ApplicationProblemPlusJSONResponse) VisitPutSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSubscriptionsId422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableEntityApplicationProblemPlusJSONResponse
+}
+
+func (response PutSubscriptionsId422ApplicationProblemPlusJSONResponse) VisitPutSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSubscriptionsId429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PutSubscriptionsId429ApplicationProblemPlusJSONResponse) VisitPutSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PutSubscriptionsId500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PutSubscriptionsId500ApplicationProblemPlusJSONResponse) VisitPutSubscriptionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdCancelRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PostSubscriptionsIdCancelParams
+	Body   *PostSubscriptionsIdCancelJSONRequestBody
+}
+
+type PostSubscriptionsIdCancelResponseObject interface {
+	VisitPostSubscriptionsIdCancelResponse(w http.ResponseWriter) error
+}
+
+type PostSubscriptionsIdCancel200JSONResponse Subscription
+
+func (response PostSubscriptionsIdCancel200JSONResponse) VisitPostSubscriptionsIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdCancel400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdCancel400ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdCancel401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdCancel401ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostSubscriptionsIdCancel403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdCancel403ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdCancel404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdCancel404ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdCancel409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdCancel409ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdCancel422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableEntityApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdCancel422ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdCancel429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdCancel429ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostSubscriptionsIdCancel500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdCancel500ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsIdEventsRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetSubscriptionsIdEventsParams
+}
+
+type GetSubscriptionsIdEventsResponseObject interface {
+	VisitGetSubscriptionsIdEventsResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptionsIdEvents200JSONResponse SubscriptionEventList
+
+func (response GetSubscriptionsIdEvents200JSONResponse) VisitGetSubscriptionsIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsIdEvents400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsIdEvents400ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsIdEvents401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsIdEvents401ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSubscriptionsIdEvents403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsIdEvents403ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsIdEvents404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsIdEvents404ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsIdEvents429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsIdEvents429ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSubscriptionsIdEvents500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSubscriptionsIdEvents500ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdPauseRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PostSubscriptionsIdPauseParams
+	Body   *PostSubscriptionsIdPauseJSONRequestBody
+}
+
+type PostSubscriptionsIdPauseResponseObject interface {
+	VisitPostSubscriptionsIdPauseResponse(w http.ResponseWriter) error
+}
+
+type PostSubscriptionsIdPause200JSONResponse Subscription
+
+func (response PostSubscriptionsIdPause200JSONResponse) VisitPostSubscriptionsIdPauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdPause400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdPause400ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdPauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdPause401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdPause401ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdPauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostSubscriptionsIdPause403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdPause403ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdPauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdPause404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdPause404ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdPauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdPause422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableEntityApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdPause422ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdPauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdPause429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdPause429ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdPauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostSubscriptionsIdPause500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdPause500ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdPauseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdResumeRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PostSubscriptionsIdResumeParams
+	Body   *PostSubscriptionsIdResumeJSONRequestBody
+}
+
+type PostSubscriptionsIdResumeResponseObject interface {
+	VisitPostSubscriptionsIdResumeResponse(w http.ResponseWriter) error
+}
+
+type PostSubscriptionsIdResume200JSONResponse Subscription
+
+func (response PostSubscriptionsIdResume200JSONResponse) VisitPostSubscriptionsIdResumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdResume400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdResume400ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdResumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdResume401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdResume401ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdResumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostSubscriptionsIdResume403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdResume403ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdResumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdResume404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdResume404ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdResumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdResume422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableEntityApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdResume422ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdResumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsIdResume429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdResume429ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdResumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostSubscriptionsIdResume500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostSubscriptionsIdResume500ApplicationProblemPlusJSONResponse) VisitPostSubscriptionsIdResumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdBudgetStatusRequestObject struct {
+	Id     UserID `json:"id"`
+	Params GetUsersIdBudgetStatusParams
+}
+
+type GetUsersIdBudgetStatusResponseObject interface {
+	VisitGetUsersIdBudgetStatusResponse(w http.ResponseWriter) error
+}
+
+type GetUsersIdBudgetStatus200JSONResponse BudgetStatusReport
+
+func (response GetUsersIdBudgetStatus200JSONResponse) VisitGetUsersIdBudgetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdBudgetStatus400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdBudgetStatus400ApplicationProblemPlusJSONResponse) VisitGetUsersIdBudgetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdBudgetStatus401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdBudgetStatus401ApplicationProblemPlusJSONResponse) VisitGetUsersIdBudgetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUsersIdBudgetStatus403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdBudgetStatus403ApplicationProblemPlusJSONResponse) VisitGetUsersIdBudgetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdBudgetStatus429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdBudgetStatus429ApplicationProblemPlusJSONResponse) VisitGetUsersIdBudgetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUsersIdBudgetStatus500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdBudgetStatus500ApplicationProblemPlusJSONResponse) VisitGetUsersIdBudgetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdBudgetsRequestObject struct {
+	Id     UserID `json:"id"`
+	Params GetUsersIdBudgetsParams
+}
+
+type GetUsersIdBudgetsResponseObject interface {
+	VisitGetUsersIdBudgetsResponse(w http.ResponseWriter) error
+}
+
+type GetUsersIdBudgets200JSONResponse BudgetList
+
+func (response GetUsersIdBudgets200JSONResponse) VisitGetUsersIdBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdBudgets400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdBudgets400ApplicationProblemPlusJSONResponse) VisitGetUsersIdBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdBudgets401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdBudgets401ApplicationProblemPlusJSONResponse) VisitGetUsersIdBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUsersIdBudgets403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdBudgets403ApplicationProblemPlusJSONResponse) VisitGetUsersIdBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdBudgets429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdBudgets429ApplicationProblemPlusJSONResponse) VisitGetUsersIdBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUsersIdBudgets500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdBudgets500ApplicationProblemPlusJSONResponse) VisitGetUsersIdBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersIdBudgetsRequestObject struct {
+	Id     UserID `json:"id"`
+	Params PostUsersIdBudgetsParams
+	Body   *PostUsersIdBudgetsJSONRequestBody
+}
+
+type PostUsersIdBudgetsResponseObject interface {
+	VisitPostUsersIdBudgetsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersIdBudgets201JSONResponse Budget
+
+func (response PostUsersIdBudgets201JSONResponse) VisitPostUsersIdBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersIdBudgets400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersIdBudgets400ApplicationProblemPlusJSONResponse) VisitPostUsersIdBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersIdBudgets401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersIdBudgets401ApplicationProblemPlusJSONResponse) VisitPostUsersIdBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersIdBudgets403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersIdBudgets403ApplicationProblemPlusJSONResponse) VisitPostUsersIdBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersIdBudgets409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersIdBudgets409ApplicationProblemPlusJSONResponse) VisitPostUsersIdBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersIdBudgets422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableEntityApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersIdBudgets422ApplicationProblemPlusJSONResponse) VisitPostUsersIdBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersIdBudgets429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersIdBudgets429ApplicationProblemPlusJSONResponse) VisitPostUsersIdBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersIdBudgets500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersIdBudgets500ApplicationProblemPlusJSONResponse) VisitPostUsersIdBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersIdBudgetsBudgetIdRequestObject struct {
+	Id       UserID   `json:"id"`
+	BudgetId BudgetID `json:"budget_id"`
+	Params   DeleteUsersIdBudgetsBudgetIdParams
+}
+
+type DeleteUsersIdBudgetsBudgetIdResponseObject interface {
+	VisitDeleteUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteUsersIdBudgetsBudgetId204Response struct {
+}
+
+func (response DeleteUsersIdBudgetsBudgetId204Response) VisitDeleteUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteUsersIdBudgetsBudgetId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersIdBudgetsBudgetId400ApplicationProblemPlusJSONResponse) VisitDeleteUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersIdBudgetsBudgetId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersIdBudgetsBudgetId401ApplicationProblemPlusJSONResponse) VisitDeleteUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteUsersIdBudgetsBudgetId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersIdBudgetsBudgetId403ApplicationProblemPlusJSONResponse) VisitDeleteUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersIdBudgetsBudgetId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersIdBudgetsBudgetId404ApplicationProblemPlusJSONResponse) VisitDeleteUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersIdBudgetsBudgetId429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersIdBudgetsBudgetId429ApplicationProblemPlusJSONResponse) VisitDeleteUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteUsersIdBudgetsBudgetId500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersIdBudgetsBudgetId500ApplicationProblemPlusJSONResponse) VisitDeleteUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdBudgetsBudgetIdRequestObject struct {
+	Id       UserID   `json:"id"`
+	BudgetId BudgetID `json:"budget_id"`
+	Params   GetUsersIdBudgetsBudgetIdParams
+}
+
+type GetUsersIdBudgetsBudgetIdResponseObject interface {
+	VisitGetUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error
+}
+
+type GetUsersIdBudgetsBudgetId200JSONResponse Budget
+
+func (response GetUsersIdBudgetsBudgetId200JSONResponse) VisitGetUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdBudgetsBudgetId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdBudgetsBudgetId400ApplicationProblemPlusJSONResponse) VisitGetUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdBudgetsBudgetId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdBudgetsBudgetId401ApplicationProblemPlusJSONResponse) VisitGetUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUsersIdBudgetsBudgetId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdBudgetsBudgetId403ApplicationProblemPlusJSONResponse) VisitGetUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdBudgetsBudgetId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdBudgetsBudgetId404ApplicationProblemPlusJSONResponse) VisitGetUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdBudgetsBudgetId429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdBudgetsBudgetId429ApplicationProblemPlusJSONResponse) VisitGetUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUsersIdBudgetsBudgetId500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdBudgetsBudgetId500ApplicationProblemPlusJSONResponse) VisitGetUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersIdBudgetsBudgetIdRequestObject struct {
+	Id       UserID   `json:"id"`
+	BudgetId BudgetID `json:"budget_id"`
+	Params   PutUsersIdBudgetsBudgetIdParams
+	Body     *PutUsersIdBudgetsBudgetIdJSONRequestBody
+}
+
+type PutUsersIdBudgetsBudgetIdResponseObject interface {
+	VisitPutUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error
+}
+
+type PutUsersIdBudgetsBudgetId200JSONResponse Budget
+
+func (response PutUsersIdBudgetsBudgetId200JSONResponse) VisitPutUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersIdBudgetsBudgetId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersIdBudgetsBudgetId400ApplicationProblemPlusJSONResponse) VisitPutUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersIdBudgetsBudgetId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersIdBudgetsBudgetId401ApplicationProblemPlusJSONResponse) VisitPutUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PutUsersIdBudgetsBudgetId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersIdBudgetsBudgetId403ApplicationProblemPlusJSONResponse) VisitPutUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersIdBudgetsBudgetId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersIdBudgetsBudgetId404ApplicationProblemPlusJSONResponse) VisitPutUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersIdBudgetsBudgetId409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersIdBudgetsBudgetId409ApplicationProblemPlusJSONResponse) VisitPutUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersIdBudgetsBudgetId422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableEntityApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersIdBudgetsBudgetId422ApplicationProblemPlusJSONResponse) VisitPutUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersIdBudgetsBudgetId429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersIdBudgetsBudgetId429ApplicationProblemPlusJSONResponse) VisitPutUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PutUsersIdBudgetsBudgetId500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersIdBudgetsBudgetId500ApplicationProblemPlusJSONResponse) VisitPutUsersIdBudgetsBudgetIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdReminderPreferencesRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params GetUsersIdReminderPreferencesParams
+}
+
+type GetUsersIdReminderPreferencesResponseObject interface {
+	VisitGetUsersIdReminderPreferencesResponse(w http.ResponseWriter) error
+}
+
+type GetUsersIdReminderPreferences200JSONResponse ReminderPreferences
+
+func (response GetUsersIdReminderPreferences200JSONResponse) VisitGetUsersIdReminderPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdReminderPreferences400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdReminderPreferences400ApplicationProblemPlusJSONResponse) VisitGetUsersIdReminderPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdReminderPreferences401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdReminderPreferences401ApplicationProblemPlusJSONResponse) VisitGetUsersIdReminderPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUsersIdReminderPreferences403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdReminderPreferences403ApplicationProblemPlusJSONResponse) VisitGetUsersIdReminderPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersIdReminderPreferences429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdReminderPreferences429ApplicationProblemPlusJSONResponse) VisitGetUsersIdReminderPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUsersIdReminderPreferences500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersIdReminderPreferences500ApplicationProblemPlusJSONResponse) VisitGetUsersIdReminderPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersIdReminderPreferencesRequestObject struct {
+	Id     openapi_types.UUID `json:"id"`
+	Params PutUsersIdReminderPreferencesParams
+	Body   *PutUsersIdReminderPreferencesJSONRequestBody
+}
+
+type PutUsersIdReminderPreferencesResponseObject interface {
+	VisitPutUsersIdReminderPreferencesResponse(w http.ResponseWriter) error
+}
+
+type PutUsersIdReminderPreferences200JSONResponse ReminderPreferences
+
+func (response PutUsersIdReminderPreferences200JSONResponse) VisitPutUsersIdReminderPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersIdReminderPreferences400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersIdReminderPreferences400ApplicationProblemPlusJSONResponse) VisitPutUsersIdReminderPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersIdReminderPreferences401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersIdReminderPreferences401ApplicationProblemPlusJSONResponse) VisitPutUsersIdReminderPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("WWW-Authenticate", fmt.Sprint(response.Headers.WWWAuthenticate))
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PutUsersIdReminderPreferences403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersIdReminderPreferences403ApplicationProblemPlusJSONResponse) VisitPutUsersIdReminderPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersIdReminderPreferences422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableEntityApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersIdReminderPreferences422ApplicationProblemPlusJSONResponse) VisitPutUsersIdReminderPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersIdReminderPreferences429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersIdReminderPreferences429ApplicationProblemPlusJSONResponse) VisitPutUsersIdReminderPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("RateLimit-Limit", fmt.Sprint(response.Headers.RateLimitLimit))
+	w.Header().Set("RateLimit-Remaining", fmt.Sprint(response.Headers.RateLimitRemaining))
+	w.Header().Set("RateLimit-Reset", fmt.Sprint(response.Headers.RateLimitReset))
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PutUsersIdReminderPreferences500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersIdReminderPreferences500ApplicationProblemPlusJSONResponse) VisitPutUsersIdReminderPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Список API-ключей
+	// (GET /admin/api-keys)
+	GetAdminApiKeys(ctx context.Context, request GetAdminApiKeysRequestObject) (GetAdminApiKeysResponseObject, error)
+	// Выпустить API-ключ
+	// (POST /admin/api-keys)
+	PostAdminApiKeys(ctx context.Context, request PostAdminApiKeysRequestObject) (PostAdminApiKeysResponseObject, error)
+	// Отозвать API-ключ
+	// (DELETE /admin/api-keys/{id})
+	DeleteAdminApiKeysId(ctx context.Context, request DeleteAdminApiKeysIdRequestObject) (DeleteAdminApiKeysIdResponseObject, error)
+	// Причины отмены подписок
+	// (GET /analytics/cancellations)
+	GetAnalyticsCancellations(ctx context.Context, request GetAnalyticsCancellationsRequestObject) (GetAnalyticsCancellationsResponseObject, error)
+	// Отток подписчиков по месяцам
+	// (GET /analytics/churn)
+	GetAnalyticsChurn(ctx context.Context, request GetAnalyticsChurnRequestObject) (GetAnalyticsChurnResponseObject, error)
+	// Удержание подписок по когортам
+	// (GET /analytics/cohorts)
+	GetAnalyticsCohorts(ctx context.Context, request GetAnalyticsCohortsRequestObject) (GetAnalyticsCohortsResponseObject, error)
+	// Выручка по месяцам
+	// (GET /analytics/mrr)
+	GetAnalyticsMrr(ctx context.Context, request GetAnalyticsMrrRequestObject) (GetAnalyticsMrrResponseObject, error)
+	// Каталог сервисов
+	// (GET /services)
+	GetServices(ctx context.Context, request GetServicesRequestObject) (GetServicesResponseObject, error)
+	// Добавить сервис в каталог
+	// (POST /services)
+	PostServices(ctx context.Context, request PostServicesRequestObject) (PostServicesResponseObject, error)
+	// Удалить сервис
+	// (DELETE /services/{service_id})
+	DeleteServicesServiceId(ctx context.Context, request DeleteServicesServiceIdRequestObject) (DeleteServicesServiceIdResponseObject, error)
+	// Сервис каталога
+	// (GET /services/{service_id})
+	GetServicesServiceId(ctx context.Context, request GetServicesServiceIdRequestObject) (GetServicesServiceIdResponseObject, error)
+	// Изменить сервис
+	// (PUT /services/{service_id})
+	PutServicesServiceId(ctx context.Context, request PutServicesServiceIdRequestObject) (PutServicesServiceIdResponseObject, error)
+	// Список подписок
+	// (GET /subscriptions)
+	GetSubscriptions(ctx context.Context, request GetSubscriptionsRequestObject) (GetSubscriptionsResponseObject, error)
+	// Создать подписку
+	// (POST /subscriptions)
+	PostSubscriptions(ctx context.Context, request PostSubscriptionsRequestObject) (PostSubscriptionsResponseObject, error)
+	// Прогноз расходов на подписки
+	// (GET /subscriptions/forecast)
+	GetSubscriptionsForecast(ctx context.Context, request GetSubscriptionsForecastRequestObject) (GetSubscriptionsForecastResponseObject, error)
+	// Агрегация стоимости подписок
+	// (GET /subscriptions/sum)
+	GetSubscriptionsSum(ctx context.Context, request GetSubscriptionsSumRequestObject) (GetSubscriptionsSumResponseObject, error)
+	// Помесячная стоимость подписок
+	// (GET /subscriptions/sum/monthly)
+	GetSubscriptionsSumMonthly(ctx context.Context, request GetSubscriptionsSumMonthlyRequestObject) (GetSubscriptionsSumMonthlyResponseObject, error)
+	// Уд.лить подписку
+	// (DELETE /subscriptions/{id})
+	DeleteSubscriptionsId(ctx context.Context, request DeleteSubscriptionsIdRequestObject) (DeleteSubscriptionsIdResponseObject, error)
+	// Получить подписку по ID
+	// (GET /subscriptions/{id})
+	GetSubscriptionsId(ctx context.Context, request GetSubscriptionsIdRequestObject) (GetSubscriptionsIdResponseObject, error)
+	// Обновить подписку
+	// (PUT /subscriptions/{id})
+	PutSubscriptionsId(ctx context.Context, request PutSubscriptionsIdRequestObject) (PutSubscriptionsIdResponseObject, error)
+	// Отменить подписку
+	// (POST /subscriptions/{id}/cancel)
+	PostSubscriptionsIdCancel(ctx context.Context, request PostSubscriptionsIdCancelRequestObject) (PostSubscriptionsIdCancelResponseObject, error)
+	// События подписки
+	// (GET /subscriptions/{id}/events)
+	GetSubscriptionsIdEvents(ctx context.Context, request GetSubscriptionsIdEventsRequestObject) (GetSubscriptionsIdEventsResponseObject, error)
+	// Приостановить подписку
+	// (POST /subscriptions/{id}/pause)
+	PostSubscriptionsIdPause(ctx context.Context, request PostSubscriptionsIdPauseRequestObject) (PostSubscriptionsIdPauseResponseObject, error)
+	// Возобновить подписку
+	// (POST /subscriptions/{id}/resume)
+	PostSubscriptionsIdResume(ctx context.Context, request PostSubscriptionsIdResumeRequestObject) (PostSubscriptionsIdResumeResponseObject, error)
+	// Исполнение бюджетов за месяц
+	// (GET /users/{id}/budget-status)
+	GetUsersIdBudgetStatus(ctx context.Context, request GetUsersIdBudgetStatusRequestObject) (GetUsersIdBudgetStatusResponseObject, error)
+	// Бюджеты пользователя
+	// (GET /users/{id}/budgets)
+	GetUsersIdBudgets(ctx context.Context, request GetUsersIdBudgetsRequestObject) (GetUsersIdBudgetsResponseObject, error)
+	// Создать бюджет
+	// (POST /users/{id}/budgets)
+	PostUsersIdBudgets(ctx context.Context, request PostUsersIdBudgetsRequestObject) (PostUsersIdBudgetsResponseObject, error)
+	// Удалить бюджет
+	// (DELETE /users/{id}/budgets/{budget_id})
+	DeleteUsersIdBudgetsBudgetId(ctx context.Context, request DeleteUsersIdBudgetsBudgetIdRequestObject) (DeleteUsersIdBudgetsBudgetIdResponseObject, error)
+	// Бюджет пользователя
+	// (GET /users/{id}/budgets/{budget_id})
+	GetUsersIdBudgetsBudgetId(ctx context.Context, request GetUsersIdBudgetsBudgetIdRequestObject) (GetUsersIdBudgetsBudgetIdResponseObject, error)
+	// Изменить бюджет
+	// (PUT /users/{id}/budgets/{budget_id})
+	PutUsersIdBudgetsBudgetId(ctx context.Context, request PutUsersIdBudgetsBudgetIdRequestObject) (PutUsersIdBudgetsBudgetIdResponseObject, error)
+	// Настройки напоминаний пользователя
+	// (GET /users/{id}/reminder-preferences)
+	GetUsersIdReminderPreferences(ctx context.Context, request GetUsersIdReminderPreferencesRequestObject) (GetUsersIdReminderPreferencesResponseObject, error)
+	// Изменить настройки напоминаний
+	// (PUT /users/{id}/reminder-preferences)
+	PutUsersIdReminderPreferences(ctx context.Context, request PutUsersIdReminderPreferencesRequestObject) (PutUsersIdReminderPreferencesResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetAdminApiKeys operation middleware
+func (sh *strictHandler) GetAdminApiKeys(w http.ResponseWriter, r *http.Request) {
+	var request GetAdminApiKeysRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminApiKeys(ctx, request.(GetAdminApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminApiKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminApiKeysResponseObject); ok {
+		if err := validResponse.VisitGetAdminApiKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminApiKeys operation middleware
+func (sh *strictHandler) PostAdminApiKeys(w http.ResponseWriter, r *http.Request) {
+	var request PostAdminApiKeysRequestObject
+
+	var body PostAdminApiKeysJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminApiKeys(ctx, request.(PostAdminApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminApiKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAdminApiKeysResponseObject); ok {
+		if err := validResponse.VisitPostAdminApiKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAdminApiKeysId operation middleware
+func (sh *strictHandler) DeleteAdminApiKeysId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	var request DeleteAdminApiKeysIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAdminApiKeysId(ctx, request.(DeleteAdminApiKeysIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAdminApiKeysId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAdminApiKeysIdResponseObject); ok {
+		if err := validResponse.VisitDeleteAdminApiKeysIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAnalyticsCancellations operation middleware
+func (sh *strictHandler) GetAnalyticsCancellations(w http.ResponseWriter, r *http.Request, params GetAnalyticsCancellationsParams) {
+	var request GetAnalyticsCancellationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnalyticsCancellations(ctx, request.(GetAnalyticsCancellationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnalyticsCancellations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAnalyticsCancellationsResponseObject); ok {
+		if err := validResponse.VisitGetAnalyticsCancellationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAnalyticsChurn operation middleware
+func (sh *strictHandler) GetAnalyticsChurn(w http.ResponseWriter, r *http.Request, params GetAnalyticsChurnParams) {
+	var request GetAnalyticsChurnRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnalyticsChurn(ctx, request.(GetAnalyticsChurnRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnalyticsChurn")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAnalyticsChurnResponseObject); ok {
+		if err := validResponse.VisitGetAnalyticsChurnResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAnalyticsCohorts operation middleware
+func (sh *strictHandler) GetAnalyticsCohorts(w http.ResponseWriter, r *http.Request, params GetAnalyticsCohortsParams) {
+	var request GetAnalyticsCohortsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnalyticsCohorts(ctx, request.(GetAnalyticsCohortsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnalyticsCohorts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAnalyticsCohortsResponseObject); ok {
+		if err := validResponse.VisitGetAnalyticsCohortsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAnalyticsMrr operation middleware
+func (sh *strictHandler) GetAnalyticsMrr(w http.ResponseWriter, r *http.Request, params GetAnalyticsMrrParams) {
+	var request GetAnalyticsMrrRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnalyticsMrr(ctx, request.(GetAnalyticsMrrRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnalyticsMrr")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAnalyticsMrrResponseObject); ok {
+		if err := validResponse.VisitGetAnalyticsMrrResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetServices operation middleware
+func (sh *strictHandler) GetServices(w http.ResponseWriter, r *http.Request, params GetServicesParams) {
+	var request GetServicesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetServices(ctx, request.(GetServicesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetServices")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetServicesResponseObject); ok {
+		if err := validResponse.VisitGetServicesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostServices operation middleware
+func (sh *strictHandler) PostServices(w http.ResponseWriter, r *http.Request, params PostServicesParams) {
+	var request PostServicesRequestObject
+
+	request.Params = params
+
+	var body PostServicesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostServices(ctx, request.(PostServicesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostServices")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostServicesResponseObject); ok {
+		if err := validResponse.VisitPostServicesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSubscriptionsIdRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+// DeleteServicesServiceId operation middleware
+func (sh *strictHandler) DeleteServicesServiceId(w http.ResponseWriter, r *http.Request, serviceId ServiceID, params DeleteServicesServiceIdParams) {
+	var request DeleteServicesServiceIdRequestObject
+
+	request.ServiceId = serviceId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteServicesServiceId(ctx, request.(DeleteServicesServiceIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteServicesServiceId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteServicesServiceIdResponseObject); ok {
+		if err := validResponse.VisitDeleteServicesServiceIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSubscriptionsIdResponseObject interface {
-	VisitDeleteSubscriptionsIdResponse(w http.ResponseWriter) error
+// GetServicesServiceId operation middleware
+func (sh *strictHandler) GetServicesServiceId(w http.ResponseWriter, r *http.Request, serviceId ServiceID, params GetServicesServiceIdParams) {
+	var request GetServicesServiceIdRequestObject
+
+	request.ServiceId = serviceId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetServicesServiceId(ctx, request.(GetServicesServiceIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetServicesServiceId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetServicesServiceIdResponseObject); ok {
+		if err := validResponse.VisitGetServicesServiceIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSubscriptionsId204Response struct {
+// PutServicesServiceId operation middleware
+func (sh *strictHandler) PutServicesServiceId(w http.ResponseWriter, r *http.Request, serviceId ServiceID, params PutServicesServiceIdParams) {
+	var request PutServicesServiceIdRequestObject
+
+	request.ServiceId = serviceId
+	request.Params = params
+
+	var body PutServicesServiceIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutServicesServiceId(ctx, request.(PutServicesServiceIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutServicesServiceId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutServicesServiceIdResponseObject); ok {
+		if err := validResponse.VisitPutServicesServiceIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response DeleteSubscriptionsId204Response) VisitDeleteSubscriptionsIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+// GetSubscriptions operation middleware
+func (sh *strictHandler) GetSubscriptions(w http.ResponseWriter, r *http.Request, params GetSubscriptionsParams) {
+	var request GetSubscriptionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptions(ctx, request.(GetSubscriptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSubscriptionsResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSubscriptionsId400JSONResponse ErrorResponse
+// PostSubscriptions operation middleware
+func (sh *strictHandler) PostSubscriptions(w http.ResponseWriter, r *http.Request, params PostSubscriptionsParams) {
+	var request PostSubscriptionsRequestObject
 
-func (response DeleteSubscriptionsId400JSONResponse) VisitDeleteSubscriptionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	var body PostSubscriptionsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSubscriptions(ctx, request.(PostSubscriptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSubscriptions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostSubscriptionsResponseObject); ok {
+		if err := validResponse.VisitPostSubscriptionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSubscriptionsId404JSONResponse ErrorResponse
+// GetSubscriptionsForecast operation middleware
+func (sh *strictHandler) GetSubscriptionsForecast(w http.ResponseWriter, r *http.Request, params GetSubscriptionsForecastParams) {
+	var request GetSubscriptionsForecastRequestObject
 
-func (response DeleteSubscriptionsId404JSONResponse) VisitDeleteSubscriptionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptionsForecast(ctx, request.(GetSubscriptionsForecastRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptionsForecast")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSubscriptionsForecastResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionsForecastResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSubscriptionsId500JSONResponse ErrorResponse
+// GetSubscriptionsSum operation middleware
+func (sh *strictHandler) GetSubscriptionsSum(w http.ResponseWriter, r *http.Request, params GetSubscriptionsSumParams) {
+	var request GetSubscriptionsSumRequestObject
 
-func (response DeleteSubscriptionsId500JSONResponse) VisitDeleteSubscriptionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptionsSum(ctx, request.(GetSubscriptionsSumRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptionsSum")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSubscriptionsSumResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionsSumResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSubscriptionsIdRequestObject struct {
-	Id openapi_types.UUID `json:"id"`
+// GetSubscriptionsSumMonthly operation middleware
+func (sh *strictHandler) GetSubscriptionsSumMonthly(w http.ResponseWriter, r *http.Request, params GetSubscriptionsSumMonthlyParams) {
+	var request GetSubscriptionsSumMonthlyRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptionsSumMonthly(ctx, request.(GetSubscriptionsSumMonthlyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptionsSumMonthly")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSubscriptionsSumMonthlyResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionsSumMonthlyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSubscriptionsIdResponseObject interface {
-	VisitGetSubscriptionsIdResponse(w http.ResponseWriter) error
+// DeleteSubscriptionsId operation middleware
+func (sh *strictHandler) DeleteSubscriptionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params DeleteSubscriptionsIdParams) {
+	var request DeleteSubscriptionsIdRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSubscriptionsId(ctx, request.(DeleteSubscriptionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSubscriptionsId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteSubscriptionsIdResponseObject); ok {
+		if err := validResponse.VisitDeleteSubscriptionsIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSubscriptionsId200JSONResponse Subscription
+// GetSubscriptionsId operation middleware
+func (sh *strictHandler) GetSubscriptionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetSubscriptionsIdParams) {
+	var request GetSubscriptionsIdRequestObject
 
-func (response GetSubscriptionsId200JSONResponse) VisitGetSubscriptionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptionsId(ctx, request.(GetSubscriptionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptionsId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSubscriptionsIdResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionsIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSubscriptionsId400JSONResponse ErrorResponse
+// PutSubscriptionsId operation middleware
+func (sh *strictHandler) PutSubscriptionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PutSubscriptionsIdParams) {
+	var request PutSubscriptionsIdRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body PutSubscriptionsIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutSubscriptionsId(ctx, request.(PutSubscriptionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutSubscriptionsId")
+	}
 
-func (response GetSubscriptionsId400JSONResponse) VisitGetSubscriptionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutSubscriptionsIdResponseObject); ok {
+		if err := validResponse.VisitPutSubscriptionsIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSubscriptionsId404JSONResponse ErrorResponse
+// PostSubscriptionsIdCancel operation middleware
+func (sh *strictHandler) PostSubscriptionsIdCancel(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostSubscriptionsIdCancelParams) {
+	var request PostSubscriptionsIdCancelRequestObject
 
-func (response GetSubscriptionsId404JSONResponse) VisitGetSubscriptionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostSubscriptionsIdCancelJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetSubscriptionsId500JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSubscriptionsIdCancel(ctx, request.(PostSubscriptionsIdCancelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSubscriptionsIdCancel")
+	}
 
-func (response GetSubscriptionsId500JSONResponse) VisitGetSubscriptionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostSubscriptionsIdCancelResponseObject); ok {
+		if err := validResponse.VisitPostSubscriptionsIdCancelResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutSubscriptionsIdRequestObject struct {
-	Id   openapi_types.UUID `json:"id"`
-	Body *PutSubscriptionsIdJSONRequestBody
-}
+// GetSubscriptionsIdEvents operation middleware
+func (sh *strictHandler) GetSubscriptionsIdEvents(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetSubscriptionsIdEventsParams) {
+	var request GetSubscriptionsIdEventsRequestObject
 
-type PutSubscriptionsIdResponseObject interface {
-	VisitPutSubscriptionsIdResponse(w http.ResponseWriter) error
-}
+	request.Id = id
+	request.Params = params
 
-type PutSubscriptionsId204Response struct {
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptionsIdEvents(ctx, request.(GetSubscriptionsIdEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptionsIdEvents")
+	}
 
-func (response PutSubscriptionsId204Response) VisitPutSubscriptionsIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSubscriptionsIdEventsResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionsIdEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutSubscriptionsId400JSONResponse ErrorResponse
+// PostSubscriptionsIdPause operation middleware
+func (sh *strictHandler) PostSubscriptionsIdPause(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostSubscriptionsIdPauseParams) {
+	var request PostSubscriptionsIdPauseRequestObject
 
-func (response PutSubscriptionsId400JSONResponse) VisitPutSubscriptionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostSubscriptionsIdPauseJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type PutSubscriptionsId404JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSubscriptionsIdPause(ctx, request.(PostSubscriptionsIdPauseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSubscriptionsIdPause")
+	}
 
-func (response PutSubscriptionsId404JSONResponse) VisitPutSubscriptionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostSubscriptionsIdPauseResponseObject); ok {
+		if err := validResponse.VisitPostSubscriptionsIdPauseResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutSubscriptionsId422JSONResponse ErrorResponse
-
-func (response PutSubscriptionsId422JSONResponse) VisitPutSubscriptionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+// PostSubscriptionsIdResume operation middleware
+func (sh *strictHandler) PostSubscriptionsIdResume(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PostSubscriptionsIdResumeParams) {
+	var request PostSubscriptionsIdResumeRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Id = id
+	request.Params = params
 
-type PutSubscriptionsId500JSONResponse ErrorResponse
+	var body PostSubscriptionsIdResumeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response PutSubscriptionsId500JSONResponse) VisitPutSubscriptionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostSubscriptionsIdResume(ctx, request.(PostSubscriptionsIdResumeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostSubscriptionsIdResume")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Список подписок
-	// (GET /subscriptions)
-	GetSubscriptions(ctx context.Context, request GetSubscriptionsRequestObject) (GetSubscriptionsResponseObject, error)
-	// Создать подписку
-	// (POST /subscriptions)
-	PostSubscriptions(ctx context.Context, request PostSubscriptionsRequestObject) (PostSubscriptionsResponseObject, error)
-	// Агрегация стоимости подписок
-	// (GET /subscriptions/sum)
-	GetSubscriptionsSum(ctx context.Context, request GetSubscriptionsSumRequestObject) (GetSubscriptionsSumResponseObject, error)
-	// Уд.лить подписку
-	// (DELETE /subscriptions/{id})
-	DeleteSubscriptionsId(ctx context.Context, request DeleteSubscriptionsIdRequestObject) (DeleteSubscriptionsIdResponseObject, error)
-	// Получить подписку по ID
-	// (GET /subscriptions/{id})
-	GetSubscriptionsId(ctx context.Context, request GetSubscriptionsIdRequestObject) (GetSubscriptionsIdResponseObject, error)
-	// Обновить подписку
-	// (PUT /subscriptions/{id})
-	PutSubscriptionsId(ctx context.Context, request PutSubscriptionsIdRequestObject) (PutSubscriptionsIdResponseObject, error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostSubscriptionsIdResumeResponseObject); ok {
+		if err := validResponse.VisitPostSubscriptionsIdResumeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// GetUsersIdBudgetStatus operation middleware
+func (sh *strictHandler) GetUsersIdBudgetStatus(w http.ResponseWriter, r *http.Request, id UserID, params GetUsersIdBudgetStatusParams) {
+	var request GetUsersIdBudgetStatusRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.Id = id
+	request.Params = params
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersIdBudgetStatus(ctx, request.(GetUsersIdBudgetStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersIdBudgetStatus")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUsersIdBudgetStatusResponseObject); ok {
+		if err := validResponse.VisitGetUsersIdBudgetStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetSubscriptions operation middleware
-func (sh *strictHandler) GetSubscriptions(w http.ResponseWriter, r *http.Request, params GetSubscriptionsParams) {
-	var request GetSubscriptionsRequestObject
+// GetUsersIdBudgets operation middleware
+func (sh *strictHandler) GetUsersIdBudgets(w http.ResponseWriter, r *http.Request, id UserID, params GetUsersIdBudgetsParams) {
+	var request GetUsersIdBudgetsRequestObject
 
+	request.Id = id
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSubscriptions(ctx, request.(GetSubscriptionsRequestObject))
+		return sh.ssi.GetUsersIdBudgets(ctx, request.(GetUsersIdBudgetsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSubscriptions")
+		handler = middleware(handler, "GetUsersIdBudgets")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSubscriptionsResponseObject); ok {
-		if err := validResponse.VisitGetSubscriptionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetUsersIdBudgetsResponseObject); ok {
+		if err := validResponse.VisitGetUsersIdBudgetsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -781,11 +6311,14 @@ func (sh *strictHandler) GetSubscriptions(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// PostSubscriptions operation middleware
-func (sh *strictHandler) PostSubscriptions(w http.ResponseWriter, r *http.Request) {
-	var request PostSubscriptionsRequestObject
+// PostUsersIdBudgets operation middleware
+func (sh *strictHandler) PostUsersIdBudgets(w http.ResponseWriter, r *http.Request, id UserID, params PostUsersIdBudgetsParams) {
+	var request PostUsersIdBudgetsRequestObject
 
-	var body PostSubscriptionsJSONRequestBody
+	request.Id = id
+	request.Params = params
+
+	var body PostUsersIdBudgetsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -793,18 +6326,18 @@ func (sh *strictHandler) PostSubscriptions(w http.ResponseWriter, r *http.Reques
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostSubscriptions(ctx, request.(PostSubscriptionsRequestObject))
+		return sh.ssi.PostUsersIdBudgets(ctx, request.(PostUsersIdBudgetsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostSubscriptions")
+		handler = middleware(handler, "PostUsersIdBudgets")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostSubscriptionsResponseObject); ok {
-		if err := validResponse.VisitPostSubscriptionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersIdBudgetsResponseObject); ok {
+		if err := validResponse.VisitPostUsersIdBudgetsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -812,25 +6345,27 @@ func (sh *strictHandler) PostSubscriptions(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetSubscriptionsSum operation middleware
-func (sh *strictHandler) GetSubscriptionsSum(w http.ResponseWriter, r *http.Request, params GetSubscriptionsSumParams) {
-	var request GetSubscriptionsSumRequestObject
+// DeleteUsersIdBudgetsBudgetId operation middleware
+func (sh *strictHandler) DeleteUsersIdBudgetsBudgetId(w http.ResponseWriter, r *http.Request, id UserID, budgetId BudgetID, params DeleteUsersIdBudgetsBudgetIdParams) {
+	var request DeleteUsersIdBudgetsBudgetIdRequestObject
 
+	request.Id = id
+	request.BudgetId = budgetId
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSubscriptionsSum(ctx, request.(GetSubscriptionsSumRequestObject))
+		return sh.ssi.DeleteUsersIdBudgetsBudgetId(ctx, request.(DeleteUsersIdBudgetsBudgetIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSubscriptionsSum")
+		handler = middleware(handler, "DeleteUsersIdBudgetsBudgetId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSubscriptionsSumResponseObject); ok {
-		if err := validResponse.VisitGetSubscriptionsSumResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteUsersIdBudgetsBudgetIdResponseObject); ok {
+		if err := validResponse.VisitDeleteUsersIdBudgetsBudgetIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -838,25 +6373,27 @@ func (sh *strictHandler) GetSubscriptionsSum(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// DeleteSubscriptionsId operation middleware
-func (sh *strictHandler) DeleteSubscriptionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request DeleteSubscriptionsIdRequestObject
+// GetUsersIdBudgetsBudgetId operation middleware
+func (sh *strictHandler) GetUsersIdBudgetsBudgetId(w http.ResponseWriter, r *http.Request, id UserID, budgetId BudgetID, params GetUsersIdBudgetsBudgetIdParams) {
+	var request GetUsersIdBudgetsBudgetIdRequestObject
 
 	request.Id = id
+	request.BudgetId = budgetId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteSubscriptionsId(ctx, request.(DeleteSubscriptionsIdRequestObject))
+		return sh.ssi.GetUsersIdBudgetsBudgetId(ctx, request.(GetUsersIdBudgetsBudgetIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteSubscriptionsId")
+		handler = middleware(handler, "GetUsersIdBudgetsBudgetId")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteSubscriptionsIdResponseObject); ok {
-		if err := validResponse.VisitDeleteSubscriptionsIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetUsersIdBudgetsBudgetIdResponseObject); ok {
+		if err := validResponse.VisitGetUsersIdBudgetsBudgetIdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -864,25 +6401,61 @@ func (sh *strictHandler) DeleteSubscriptionsId(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// GetSubscriptionsId operation middleware
-func (sh *strictHandler) GetSubscriptionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request GetSubscriptionsIdRequestObject
+// PutUsersIdBudgetsBudgetId operation middleware
+func (sh *strictHandler) PutUsersIdBudgetsBudgetId(w http.ResponseWriter, r *http.Request, id UserID, budgetId BudgetID, params PutUsersIdBudgetsBudgetIdParams) {
+	var request PutUsersIdBudgetsBudgetIdRequestObject
+
+	request.Id = id
+	request.BudgetId = budgetId
+	request.Params = params
+
+	var body PutUsersIdBudgetsBudgetIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutUsersIdBudgetsBudgetId(ctx, request.(PutUsersIdBudgetsBudgetIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutUsersIdBudgetsBudgetId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutUsersIdBudgetsBudgetIdResponseObject); ok {
+		if err := validResponse.VisitPutUsersIdBudgetsBudgetIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUsersIdReminderPreferences operation middleware
+func (sh *strictHandler) GetUsersIdReminderPreferences(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params GetUsersIdReminderPreferencesParams) {
+	var request GetUsersIdReminderPreferencesRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSubscriptionsId(ctx, request.(GetSubscriptionsIdRequestObject))
+		return sh.ssi.GetUsersIdReminderPreferences(ctx, request.(GetUsersIdReminderPreferencesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSubscriptionsId")
+		handler = middleware(handler, "GetUsersIdReminderPreferences")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSubscriptionsIdResponseObject); ok {
-		if err := validResponse.VisitGetSubscriptionsIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetUsersIdReminderPreferencesResponseObject); ok {
+		if err := validResponse.VisitGetUsersIdReminderPreferencesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -890,13 +6463,14 @@ func (sh *strictHandler) GetSubscriptionsId(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// PutSubscriptionsId operation middleware
-func (sh *strictHandler) PutSubscriptionsId(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
-	var request PutSubscriptionsIdRequestObject
+// PutUsersIdReminderPreferences operation middleware
+func (sh *strictHandler) PutUsersIdReminderPreferences(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, params PutUsersIdReminderPreferencesParams) {
+	var request PutUsersIdReminderPreferencesRequestObject
 
 	request.Id = id
+	request.Params = params
 
-	var body PutSubscriptionsIdJSONRequestBody
+	var body PutUsersIdReminderPreferencesJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -904,18 +6478,18 @@ func (sh *strictHandler) PutSubscriptionsId(w http.ResponseWriter, r *http.Reque
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PutSubscriptionsId(ctx, request.(PutSubscriptionsIdRequestObject))
+		return sh.ssi.PutUsersIdReminderPreferences(ctx, request.(PutUsersIdReminderPreferencesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutSubscriptionsId")
+		handler = middleware(handler, "PutUsersIdReminderPreferences")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PutSubscriptionsIdResponseObject); ok {
-		if err := validResponse.VisitPutSubscriptionsIdResponse(w); err != nil {
+	} else if validResponse, ok := response.(PutUsersIdReminderPreferencesResponseObject); ok {
+		if err := validResponse.VisitPutUsersIdReminderPreferencesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
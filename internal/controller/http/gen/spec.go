@@ -18,30 +18,142 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xZ227bxhb9FWJOHs5BSGtI3/nm3A6MEyRBnBygsVVjLI7kCXjLzDCw6hKIE7R9aICk",
-	"P9Ci6AdUuRhx7Fr5hc0/KmYoW6RF2XLtyGkaPwgiOZp9mbXW3pveRI0oiKOQhlIgdxOJxjoNiP660Gpx",
-	"2iKSReFdKhJfqpsxj2LKJaN6iYwk8VcbkdDP6AYJYp8i13YwNlHAQhYkAXKxiWQ7pshFLJS0RTlKUxNx",
-	"+ihhnHrIXS7uUz9cHK09pA2JUhNd5ZRIupSsiQZnce7Qo4SKCo9o6K16RNKSP8h2LAc708hEYeL7ZE3d",
-	"lDyhJoqJlJSHyEVf/xsv29Z8/Vt7GVtO/T+Wg1dWvE0nvYQOXRKSs7ClXIo5a5SNTJ0Us4kE5Y9Zg66G",
-	"JDji4Fck9OiGccdPRJU1IQmXFXHh2YO4/mociaB8lXnlbWfwDLablFrOWtO2pmYd2yJ0pmnNzkzO0Nl5",
-	"ghtrBJmoGfGASOSiJGHe4OZHzrgU/EEC+w6UgqwCwXXOI36XijgKBa04ePVYlOP4P/GZpwFsNAnzqeca",
-	"fSPGOhEGCx+rNUYvlEF8HB9Tz2iVu0W0Dnrb0Ij2VkmZOEidpoVnLXv6no3dSexi/KCYaeW4JZnOIKfE",
-	"ux367SGummPjQg6fo2g40b3PhkKx9/HPcnw8zbl4CrKaRTSX0lHFi/v68am1/OPp9vRZQJdH4xlL+YpT",
-	"AW/ubMAbTV6PFVW1BwubkXLMowW5QnevL92zsi3Yzp7Aa9jJtgx4C3vZCwM68CZ7AtvwBjrZ97ADO+pJ",
-	"B/ZhP/sx+86ALrxSH/uwBx14D/sWfIAuvIUPahfYhY5apG7tZc/hHXThNXSyp7ANe7AN71WYTOr8FCFy",
-	"kF9j4c4iMtFjykXupz2BJ7DKchTTkMQMuWhyAk9M5lld1zCqicJO+k6LarQprOnqsOghF/2XyqXSQrUF",
-	"JwGVVNWV5U3ElMVHCeVtJZ4aC0VG6M7pXAhaAMQysZoL1g1szdc351KreDl1mkt7CIaqozoCpqrQynIb",
-	"kI2bNGzJdeQ609OaTwfX9tF4vlmwHmBrHjpW9gJeWvB79hNsraysrAj1YamPy/XLp/D2EOuDbubkJhs5",
-	"uW2s/05qTYfkpKh4VRk5ayUZZvhQBqvN9uv5+Zr1WcBktU37aFILGbVHz2jUbAo6xMTAsZ1waHWlhnlv",
-	"qCnuYKz7rCiUNNRsJ3Hss4bme+2hyJuyvmEmaaB/eInTJnLRv2r9uajWG4pqpZ4uPfSCcE7auZqWVfT2",
-	"/9SqqVO6cpwH5Sa4wuQV4hkHBTU10fQ4bS+GCn7E12pNuaF/oAuVSIKA8DZyEfzaqwRd2DVKpaELu7pA",
-	"94bJsjjficSAOvM8zCuR1z63GIdPmmm54KouJB3AnH1ujpShNpjr3FHvovE15Tjjs30/jHnUoEKoRtC4",
-	"Hkom258qyLvwTvVF2dPseRnmu9kzvbrcldSEErYRO5OlJBitOSmVrDJ2i6L70crVuExWNWF/n55qPL3T",
-	"WQvkcaQZfFP4pRiOpBMvy8NU9sLItrKn0IUd+AO6+vtORZUclI9N5qX5FOfTfNAsS8g1fb+kIoveEBFR",
-	"g1Mftr13OcOIfHE0GwT01OAceysyrvaO+6IrZe7eeGzfiqRxI0pC79OE/W/wdgL2YGdIdTRHK4OfH4Dx",
-	"2NrHixfjL3zo8+EX/TrsWfbDEEroG8biNT0fJVXjUfIZUuP8p7vh755Hmu4q6kvv/e8/l0xfBsBKRv8M",
-	"r2Bfv9weVuTS3v8VDuhZNnIzahDfyJ8jEyXcRy5alzJ2azVfPVuPhHTn8BxGaT39MwAA///HYmZmxR8A",
-	"AA==",
+	"H4sIAAAAAAAC/+x9bXPcNprgX0Hxpmp3a9gttiz5Ra6rOo2T7GoziVWy57IXW6dAJFrNERvoAKDkjk9V",
+	"sad28yFTm92t+7B1VTdbU/cD1snEE8eJPX+B/Y+uHgAkQRLsF71ZTno+TKwmCTwAnvc3PPZCNhwxSqgU",
+	"3sZjb4Q5HhJJuPrrV2l0QOTWO/DvmHob3gjLged7FA+Jt+Htq8d7ceT5HiefpjEnkbcheUp8T4QDMsTw",
+	"YZ/xIZbehpem6s0RlpJwGO1/PsCd/mbnvaBza/fxzZOO/efaIn/2Vk9+4fmeHI8ALCF5TA+8kxPf24rI",
+	"cMQkoeH4fTIGaCIiQh6PZMwAgjtJTKjsHBBKOJYkQodkjOQASzTEh0QgTiSPiUAC90kXbaq/x+g4lgMk",
+	"BwQJPCTqE0wjtM+iMTogUuhHknESIU7EiFFBym8KkGRnh4wSPCYRGhAcEX67Oqb6AlMmB4TrwWMA6Lck",
+	"BEDV07XVVV/NjXPIBnFC1DD9mAuJ4FSIkPClkHGSIJ5SGtMDDedacKuL3idjgTCHOUcS9RlHq2towFIu",
+	"ug+p5+tz1wCWJ2/tawc21j7vIX70a0IP5MDbWF1f971hTPO/e64zukf4URySViwT+vlVRrP7hGJq6KSK",
+	"YHf5AabxZxj+RJIhHEp1dDHtors0GaNRgiWAjnA0jKnw0fGACYJCTiJCZYwTfTiUSbTPUhqpQZBUE/qI",
+	"EhKhWN5W50aOCB8zShBJhEYC/RoK2ZAI1OdsqH61xp5yxP/Q0avqbL3jTdvn5m78RhDeeppX9hRPfC8n",
+	"Vs37cLSjqQf+ChmVhKp/4tEoiUN1oisjzvYTMvzlbwWc9mNrIb/gpO9teP9lpeSvK/qpWNnWX+lJq/jy",
+	"KxyhfNoT37vDaD+Jw0sFoZjzxPfeY3w/jiJCLxOAclLg4FQSTnHyLueMXyYU+cQI+BPhSANw4nsfMvke",
+	"UOJlAvMhk0hPCtyGsQ8wHRs0EZcJx33GEMyNisl9wzgUGDtYkl/Hw1h21P9XpzQEF1NJDghXnKJ8f4cM",
+	"cQySaaFvBJFNlnuPhIxGAqVUxoliePtpeEiUEOynSYLwAY5p1/NnzAMCtbPZl4TPM0cuaYd4jPaJURyi",
+	"GbMobklxKgeMx5+RS0WpyryVU/zoo486m6kcECphduI8kpJvqjWMOAuJEHg/Ie9SGcvx5S7Fmh6Z+eE1",
+	"8y0Mvbm9ZTTAEWcjwmWs2XzICSh+e1hWxE+EJenIeEiaksIHGTZbEOYS77FHHuHhKIFn+3GSxPSgQx6N",
+	"GJeEu74acdKPHzVR7j2l0IUDzHEoCReI9RXiHZKxj1JBlGbAScgOaCwIiiXgXjm1ONz7+NNr7/X2/wG7",
+	"ZuXkiB1O3waaJglscC60m2OwhMw6xR1458T3tGayF0fNhWq1I18bUG2u+HTRh0C/oOtU1SZ4UcB662cy",
+	"E+ZUEL7nPs8Z357YaswDrdeoEy+O0OyIb+PYbjEO2wdNHmDYPDjg5EDRxg4RaSKbWCqZxMleyLQyUpxq",
+	"bzUIlIIdD9OhtxH4LkZmQ2mN44JE23sOIsGSHDA+rkzuHcURYS5kqtJU+cFqsHq9E6x3gt79XrBxLdgI",
+	"go89341tpyW6JBc8xbTXAtik4sOYyutrXnOn/NzQEJWvH3h3Dw9hlVtHsed77wOO7vpeLMlQONhhMS7m",
+	"HI/VqJIbBc482WcsIVgpN+koOv+NmoLSc6Bw/nW+k8UCKsdaAb0dkzYTwuW7R0YG1EWoRIwi40Mgj0JC",
+	"IhKBEUOl2LBs2CGjxnQOB5gekJzxiXS/GA9Jxg4RAz1NC3wY1C+NbsB4/R3OB8Qg2a1htSlUxfvSwTEP",
+	"6i1KJXVqvrY2J6KeAcfV2qsABusdwLeqsfXXwYNe59bu/+o9CDqru3/TWQ0ePowet9m/NhbZTiE9W4lK",
+	"MxjPr2PhYD4FqRX/mCZg9EhNQqzjuhqrHRTL9Kui7aZGn2SMxIjQCHwpanVd9FEsByyVCKMcEVAMtvcR",
+	"CGucJBWELcQ30NttjaiMEuuLOooLkO/qR8OmUBILSSIX3k7HRMtB0zPyo91BY2FbdSM+MNugV+/5M5Fx",
+	"mpiqMt86o1BPEAjXYtvyFfrg3BhhDm6QA8oAZhRiQW6j/LSNH634ogLqLO6+kC9L7eyW/lRtbLsoiEgf",
+	"K0Hfx4kgdX12Rzn5kOK36uTBxacZrtqAGloAT5P4kCBsWFvBB9XZFK5CFFMhCY5gCFAUucJe7GbBGq3q",
+	"IqtGRWr8KVR0T2KZiiZJXwpfrXs1cglA8l3qu2iskB6GDivosro+J5vN99It9s/Av7ltJ1dXqIxilBAh",
+	"lLi7jahSKo8IOh4Qaq8sFigHsLK8eVfXrtJMkQUVGWAvxNqtYuhZKLVDwIBqQ6xFpYUe06W8TRWX0+Vg",
+	"LvtykFxLuoNpSBJL1lRXQ/p9EsIJ7kXGDK+dOBayHZl1OCFpvBOmnBMqkTFG0YjwmEVof4wMV9KkX664",
+	"tworXj+1guB7lMmaJXzvOJbhgBh3dh8PY+0Lpw35FDitVWx8B3XGCb+jkEXEV+I0XzKLiLCFJ5BAyGg/",
+	"PkhzEVE1lyVje+TRiFARH5EqTNfXZsc0bEQwwLaff4I1+E2rSz112wfrnV7Q6a2fwj44G1Z13yhqTMGE",
+	"9rNzHQ+OIPqSm/b2cdW2p5jCgOpXj2XWqd5hKXUZ1PnPpWixFKTeVM3doYYZlaicVmiFQK8Fxed+aqfY",
+	"9kLF22v6xYwSNh9DrQxjHY/e01kH0iY5FrMymkfsEB7K2VIV8ws5azQk+TjOhQ1STj/IEaO6IKyR2NDu",
+	"vvHtWq6jWRp5CIOTqH2EWQMk7IDtqVH2OK5RedANbgW3bJbF0v3EcNqCChzj03S4327K3jgzOyLHrQue",
+	"uWMjTo5ilor6CDV7pnxYVUj3SZ9xUiHVXm8hjMlJxHH2LdA1V+w++OZptuJjG4Up4BYgsRK3ZxnzZmQn",
+	"RGzQDozD91wcRtM0KHQJGBEJiXmTswa9s3NWiWNKola8MdBU0zl6Plr1Ubfb1dALy7ulAVU/V03fa/5q",
+	"xdSditp13saJJDRXW5p7KAaYk9qOERwqjSJfIYqrxtWDoHtj3Q+66zZUZ+APDes7/qzKg9ami9w28QPD",
+	"WOdk70U7BrYRBRZ7rO/ew1xPVxP7pTLPqE7uKKYFZfaQsmMKkZEaPl47Kz7qw1uAbNX7M0lWr7sc3rlz",
+	"yt+sA3etNtJcQbYF3TjnFMjaMetVAatUEK7CVCgWIiXK3qrl/lSP7vr+tX4v7JHOGl6/1lkL1291cK+/",
+	"1gn6AVmPVvdv4l7PEfGaFg6wBg+uB70+IZ3V/X6vs3ZjtdfB5Hq/c+P6tevkxi0chPt49uC1M80VMtiZ",
+	"9vO02VjrqRIaFdaJS2+tx+ZOi92jARYun+O2solFEUCIOQL6GvE4JD4SaThAWIB1wglBksc4QYwjTFFM",
+	"JWdRGkpw/0axUEqpj2KKGI8I76L7RVxDMWZhJRPa8Qzw+CmGScmjkuTrkYtcpCNCI3HbcIWDNMFcg4pU",
+	"2J3Y4gBYiMn6moect2GDXNxUjd/uFVaPb+cehcJ3bf420OXmpbHJS9cuTthBc09icHnTQ2UZ6rRHlRBp",
+	"iEzlSR7HgtTcF2uzQqS+xwklxziZtRc75jXLlImjdn91fTlMQe8KXd1GsRTKv404GSUYnPu2laNXNJPS",
+	"6/ZVPXChJtBoipMYK40Gt+y+X3Wn+wp24QK+coTFoahVKCaXL612LN7/wDQij9B2kgrnYoA6HDzg7Cr+",
+	"5THEmqVahlatxbUzyqhMWcFJcrfvbTyYjp/m/RO/zk0PXanP2wkGEngkQSp10ZZyC1Mm88xlOMoQ07+S",
+	"kM4kBsD+isSp6cuG6ZrL2tXphCTE4uzGQT5Qi33gtyVMrN1cMGHCwOXPypzIAYLwT3N1B5wdt2jKmhPG",
+	"NEzS3EeJhUiHJELwlVbamyGEgv0uzOdI5AYkt0ETRg9EnmUMuglFOJVsiGUcIsMp0QBrXMERBIzGRLqh",
+	"bPf4zKJ+i8ecKpehPkDDbaQ3sNwT3xzStMNt8bRciCOiYnu2GFn2K8qdD0YVLTUF3/xTSFQ45rTy0Z1X",
+	"/lfwegE6Ow2ZVamsvgWug9miRziJo23MsYPqYkVyhAIID/I09E9TwsdFvqPne1BZAYNbBgT8Mlc+n8XH",
+	"He+PWEyd2aN/f+/uh8g8zSVorJeCjnCSwl8ijkglsRSgqloIK9Ond/lo9VMUMSKAsw+xDAcVtZE8GnEi",
+	"RMzovOp+TEsnrOuMjEZ4hwl5SaRzcTg5Y33n5Pmyd2x+kltduyDRto1TQaabaI4A0girnFQ1yW0E9ppV",
+	"nKQeKlPETqGuK/ucKDlYi130ztsGbNU0b50NEesSySbWYvNaN3wu03jmvh+bnKRYXvV9dyU7V9ci6z4x",
+	"O2Tt+ed7cs1DUYbwnI6Ks/Owpn43U7u7KItpLjzOIXbis8ncb5yyKqlBEZE4TkSuuey8dwfduBncQHaZ",
+	"ANLaX7eR6aY/ri44l5gg5ZSWWoq5ze0tiPtLjlVGa2PbYyokprWd91aqmohtBvK4w0mfcEJDd9ayFut7",
+	"qq7Woce9CzV7hYzvxySJdELXUcwSle+lPega5LnVtopi5HTfqwkbZnCwv05u9a/3Outhb7+zFt4gnVv9",
+	"ddJZ3w+ia2Gvv4pv3GihZZPoVRW3TSyVsUxq22tYHVIg60rJPo4T4nRy6B8qp2MQRayUA3QIoNYCJ1VD",
+	"cvU0B7ZYngu5d8gwphHh28XgjlP+aBCHA8TNq6JI/NRluGBpD9gxGkJhVYShHBe00y7aKT7AnCBBVFUE",
+	"Z+lBmQ5NSTIjr0UzTjLEcVJ8QQmJhP7NlT4KMOzpaGRzLe8AgPqhnZStik8A3NvoRhtjvmFFb64HsxIe",
+	"FHjVo4Y9+2/mz27IhnXX/poDYQiNhMs3D7Dm68BVGQifTDG79/SSZ44qC/PefOBrp7FyOufuX8anTGfM",
+	"7UXhV5+J0mzHSTLuzs7mLGYzm1Zfrhv9C9dpFcC/Y8d1sExBO6wXgdBQbC2mKREb+tmIJXE4RtQkQgMQ",
+	"JocflqI8icW3Q3YEzJHw4Z4JeQK75IjRkLQkvsHLZtu76E6exVMzo/Nia+MXcJGHBtO2LQFiz/cAyqop",
+	"qX5xcbESbocep/BZe2fhxXous9kmGLuaLLBqR0dXg4XCm2ZRU854eh2H2S9Tv9EU1NMiOtfPpCiZrIZZ",
+	"IaPTTlA7qspuz9rTBmwVjcke2b3voIe3GgJaTZ+qQBs1WXuGlPd2ETX6rHt34lzTEaEpactZ4g5vyQc7",
+	"OzqjHaMxwdUA9+rNm7Pdnnk+y1CPbiddz/zUKF7gRax/3pv9OXk0wlS4Pl6d/fGF+EYacMzhNqZE7kGW",
+	"0NB1OuYB+iWqLBZ1kLXt8Fd1J6unGLTMe9zYt9M6cWAcX+FXOW4VM+qn1Tz86k7stmP3OfmBKrRyhhyo",
+	"HZPOkEsr0KJgMyDpwNt1IImJYDroE8KGjQLJ7D+zV9m32fPs5eQJyv4j+2Hyz5Mnnm/7+NEHaSLjxQon",
+	"r0LRqWaUYQ2Gnd/8yvWyYaJ7LbGZOQorTl1YPiOcciF1pkeERozvpbympA+kHImNlZVRkoruWAHW5am3",
+	"SAF1jmfWASxWfmow+DwKCs1QZ6goNCO0CnKLqmrNg1Trp0rdm7HzyhwU3R5KjIhKehK3W+rhalVvpyPZ",
+	"6WlU1VY9n212Pg46t7JnnclX2b90sv+c/Gv25OFD8fBh5+HDX+7+8hfe4vVz51rWaJN2UY5nSLvWCebe",
+	"XbS22ruhykiqVTThOP+7ktlS2W0zpr09m52Pdx9fc0voBhep9boQKU6KGtTmbGunqL2cxU8u/NwXZiW2",
+	"W6cGYLB2c67IlZNSLVOwtR6nqNaZt0BghnRa7wQ35qrkqVetuFwdl5I15xZTM8FT7vwpyXauWASjaMAS",
+	"nY2ieqApW8ZKqJs7kQ0m905awSwT25YpgWdNCXxzuXf3F0snlMxHcR9hOu6eJsHuCiSzlY73qTqMte6y",
+	"2HWKVnh+POnysu2mZfk4U++K3VtQt7Q2s/CJOevbcUK4nHU2jU4ps6RFbxX09SDYCNYvooHOYgRZgFzE",
+	"ZYyRWeZV1doLOCxO10GaCMyM1kmNszgXjb9xwGfQ/ZuU1/SgSizdXW1iaumaxl23gQDOKE1I4f6Pc8nh",
+	"A9cnkeHttr/cz4PpUcp11wf1t24eq6rFGgnU5iD1U8+I70g9MbX6ORze7hyM4DeKqhbO/l9m+l89sb6+",
+	"TKm/kin1msQiZLZrIU3k5vkmibjF8NS8d7XdYcpjOb4H6KGpdXMUv0/G0I6yaCjc7FS8ub1l2lDnyKu+",
+	"Ul1DCOaE59/vq7/ey4/97z+6nzfohK/003IUMD91v8mY9pkjCPvuvfudyZPs+eTz7JvsBbhSvs1+mHyF",
+	"smfZnyafZ8+zP2XPJv+UvchewJNn2avs1eTLyT+i7HX2Nfzfq+yH7Fn2ffaqk/0le519m/0FRsleZs/g",
+	"Jfjph8nvs++y19k32bPJ0+x59kP2PPu+yEyoFoXm5w5ZLh4Y1VxoOHvdoBvAZrARoXgUexvetW7QvaZP",
+	"W3ukV5Q7eAWP4g5UqcFPphUgcGZlx25F3ob3t0Ruwpv6XIRX65q8GgRTOn82O37OxZqKwoa6FG50Ar37",
+	"Pry1FvTahiyAXan0P1UfXZv9UaU18drqrdlf1Jv1nvjeehDM/q7a99gmDlUHYpPFg9yVD5UfNr6XD3Z9",
+	"T6TDIeZjb8PL/mjw7HX2EnClk71UDsAvFG6pxGXhOPltJppHr1b1K0iYXuTUp3pOHFWfJ1X+InlKThqI",
+	"1ztnEPL6G1eLbP2ClvEju5BGs2qpwkqIQYN3RsFDpxBsjmO3uo5fJiKvzjNNs8fv20wE/zb5MvvL5HeT",
+	"J5On2YvJ08nvK6SgJqvxxJXHcXSipUBCJGlSyDvqd5tGtnQP+/JOjQdvV1P83QaVrTnEoO4dfMVxPFib",
+	"/UXR2v1tRuw/TJ5mr7PvtMrgRmuKk7GMQ7FS6Rlkyfx677yUmmtNKh+ALirtjhlju+2QX1FhdYVI2SVT",
+	"NzOEtpjVITEnSJlSee5VU/3Iob9TAb5BZ64TKF9ZKe7sOPENTealQYYoq56ighhPqx+7J7HSgS5qCsv3",
+	"dRUuS2nZ7KrBMO9tMmeOPTk4XHB+ekSz79QUnfXqageXywhLTvYfk8+zF5MvshdgNqHs9eRp9mP2XP9h",
+	"20uvs5cNxgaZPa0MrdaCSV2cU80VNU0RcciZ0GwqFebt8mKdDfOjTlGVKCF5f5aKQe+ozOyiWjslFY2o",
+	"N84R0ztFwVVBJl8VQdUu4fYXXaQUEaG00Nv2osQIA6OVujK0txroUcVMfqv29GL5bLsSdGF897Km/Gmy",
+	"OKvj15K3LcDb/jB5qvS0lxVWppjdS/D3qJ8R8LvJk8lXk3/KnmU/Nphc2ZvJyeb+lrN05OokrkgOHJHq",
+	"TrBP4P8/Ab/jJ5J9YpS6mNudw4wKB8csfH3ZGLisNQC+xblmtCVTOfo+Ske5l7MW2sDKxU4PEoKGWPL4",
+	"kSqZUF+ZyQQaYKhiJ8eE53wLVVuj5UWPdqkBT6lV8ViZ9RSMUmmxpibtv4biSH2ktwcYOUZ37v13JNVt",
+	"NKb7OGfHaFQswtzZF7IkHVL1u4ZkFgM2533uLBgQ4BI5oWSXONkbY7tte61VbxuSMv3st4LZsTfzZyiO",
+	"vN1L5ut21zqVyU8eyRUApDJEGUAwrAC64/kKmfd65r+rD6lpi+iv+dDjLwigy18QBA9poHOT/FW/1w2g",
+	"1/ND6r6abylW5hMr/w+yOiefZ39W0YYX2fOGpmwECwiZP2WvJ59PnrpEi8m7d4qVvMcXB5eFimdxnal+",
+	"Fl3aL8ogY2nq5SIk4ryaq9IhFW2qEf5KIKgNyTXndFhMyNX9GDHNGyCzfi7SZrVM6aLfaJV+wIz82yew",
+	"QvPqvlouJccws49S61020s4PU1OgX4A1yYGJrQuzLljh/risl4AAolVr4COhZSMsTsUT9eJjYf/aMAYg",
+	"RxJZBQrnr/9/wPlS+19q/80KlaX+vzij/rfJl5PPJ7+bfAHR3nZl374Wx+3KMC9UuanOQdHZAoBxPmLq",
+	"A6gEhjcZLa/QaajP6gJiG19z1pNnO1g3K5jahUreQyPRAXNSdm6EmwndjCZfyvkzmXypnu/UXU5VQXCh",
+	"hGXXryzJagGy+j8qT+JZ9gOoN8jOzQCj2g5u11J5SlnZRR8yJI9ZedGWqcRWhKXNXNzI/KkhfatNmo/a",
+	"QmYWaVXqfkDlyL+ch5wgWH8O9LR7MTH+WkXUJYf3i4qu1sD+VQ9lzkFd9i3eb1N8vyTl/w1ZUtkzRb0Q",
+	"x6wmWn2Dspc2rVfF5crjMp2wFrKfQvf3Z1y5pygvpYb2QLc/JGSUmxZ2dqHnO1MDcoo0/3VlBywi6ma8",
+	"m8+S0/GsOL6GcRnHP09bHLCzib4wflt23RXCkeAyWO7d95cId04I90ebQVa4Y/YMZhilszSfqtLSwt3A",
+	"mQC6y2l0GnJs9JqqEmMxWac2k75hsrgaKtCl0KNJIv+pEeXPQ2f69+w7nSLhFDpKQ6r3lW6VQ5UXz90k",
+	"d2UlnblA8OqmMb2h0vo2OIuCjCaAurinaCMWqP/N6rmzUAbdebYmXSCr7jwbW7mnLW7Mb87Zq2/qrOZs",
+	"7hlYvy9IyxSNY5txaLuXUbphc5FFCjiWnq2mclcN4VWz36YVbZwjJ5/x7lZEhiMmCQ3HqnJi9yJLRFyl",
+	"pJftSapg99KddJVVoz+qpPhvTVJ8td5u8juHcrTSt26rccZetjmD0kUSqQvXVfTb3HGobZ1HJsNI+GXG",
+	"VVEEnOcgMUp8d2mwudp/CFHlWKJ01EXvq2v+8qwmYaq6dQthXeGsDCsIRKsKZYZwaAqT4RXH6KpQt9oq",
+	"Fh0wxCgaYSGN9VdMaDKf9M00eyPCQ0Kl7yhHZv3aZM6krBgAVvlmWCIzmskgkMckOTIh7sILnp9IWwDJ",
+	"nrG4a+hSVNcrp4kWtzs40owWbRTrnqGKBO6ZajrJBWsk81xks1Q6Fs3AB/fRK2CeaPJ59mzyZPKPinWq",
+	"HNVX2bMaK81euFipSIdTkvGHwuqVPTWHSPHXehefIo1nA2FZHUn9odpth+yIqOhcmTjDOCKJIBXupbhx",
+	"QvARvAr8qrg2IyqSPyFMoO5MQeTTFCeGkbI+ai56xTRqm4dh3UuHy/SaZZHT25YDtHlwwMmBqXESabLk",
+	"sAtx2H+pdoqYfIVURfLr7EX2Y/ZaVye3VDu1cpsphZxCTmWwGxb7LNMntRpqbpTDh8AajbJZVTFzBbja",
+	"30Z3F4opZCqg+8VUi6Yh1nmlyUJdsswly3zbWGbzyrgly1xEKc1eF6mSX4AK6mCak9/PxTTn6+hQYT1n",
+	"jTz+5No/fMjQHUMVy0D+uWWOdIvEkaaryp8vaPfzxtTg0ry8ywyWc2XtP6iseDfuqx/Q1jtWNkszU+Rn",
+	"TQPnH21pb9w5V7TFITGWKSZvcRzlD9nXyh34Tat4cqtZpteQvkDNlYKvu6U0y9U38rvgdJRE26Txkb75",
+	"yrdur2q59i0PtICbUJmu2vz8a3hWtsed/m1+770kfOiD47B5i5augKnfd1cLefyNnzc30qX3sugR2zaI",
+	"sr3z6/aVaWou3FemtO6qhIYpXBBO7Ew76xZI81LIIgjgbA9wETEywaOiZSyEggBU1YQpBVdBv4s2qekq",
+	"XIErxHCB+H7REcrdrqkRft6K9DEvufK59BZ6U+mDPzuN6GfC2/MOS4vydn3rY6vf8e9iodpkO3qc+4gl",
+	"kb77mAu5oZglDKabjCg/ZRGcLtgwMK/yuW4wD/00OFHYDAxaN/F3zYgkY4fqRsF5PI1b0bt6bUtT6pwZ",
+	"R9mvf2lTXXTiy9eTL1Wb06/mitYqklbKQbu2tp1KR+/y/LoepdyUjvSGllZRnXzTmSf3gSvyVb/EUvfT",
+	"FekQuu1u23HY4qZeHf41oQV4Q6tDSawrFGLaBDO/TQiYQIJHCFN9jRqjZE4tRoGyVGLOyA7ULi51mMvi",
+	"QG+jSqJ6QJpA6LPTGZ6af7SzsnepuXxMc48y7hlTZF2yPIuJqa4tJgGlJUVFXcGcW355THVOjqNvg16y",
+	"nDO3LLEv1V7ynCXPcfRmUanCr+dydKkWUJrPaDukU17JNjUHo8xDK9op6gHyhzCyr65zTSWJUBIfWq2w",
+	"krHOOd7nBB9G7LjgKs0UYpKPq1LghK+5kJDqGhlVstJiB6lOWFuRvqDsXn5V2sVVD8B8U/Iy8qu825mV",
+	"VVi0DhU+109f4XORRpC9ocv4/+Lk+e+TJ/punOxV9jzvcvf15J+zb7M/Z89VHsA3KPsOUlOLjkotxCpm",
+	"NrkTI0IjcBsrShFV4rSICzy3SVIjPu3aNU3gqPW6Gsd0JYp1Dnx5odN0QrwcGrx4/F/2NloU7/+1RPHJ",
+	"ly3XQ02+mtLjSGGtCibkWXeMFgisutOaPlkKZRnVBrQu8c8f+eh4EIcDI0wUzru7K7aptW8Kmc9fldRL",
+	"eEOlZ3ryZdHZW1V0ZgmpNoG08lj/Y2+uzLQqMen/XHBrDEtLm/GmAWfZfugqtB+q4l57Btlbi1HBJbDW",
+	"ZVTiIpSZ6apMS5bX24SnV0L1uAz6WCZ2/YR6B03VVjgZxjQivDPipE84odN6FO+Yl0VhOiNlA5vO5VWb",
+	"WcX41UXmqi+67piuKpLyvu+q4TpYMYJIRBklejTKUA6VmG5I5/BsW7Avw/vn4lxvbuzSxF+ABv+vKrB+",
+	"qqquv4fwvCqxVvLxR7gGzdzq8P1ZROYS+y8iqtSC+JcnleekvbdDRP80BOirOanZO5l+A2zz6ld95yvh",
+	"Rzm9Vg/51yzECdLPPd9LeWJus99YWUng2YAJuXEzuBl4J7sn/38AlWJ8BgTcAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
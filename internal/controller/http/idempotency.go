@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/config"
+	"subscription-service/internal/controller/http/gen"
+	"subscription-service/internal/pkg/logctx"
+	"subscription-service/internal/port"
+)
+
+const (
+	idempotencyKeyHeader      = "Idempotency-Key"
+	idempotentReplayedHeader  = "Idempotent-Replayed"
+	maxIdempotencyKeyLength   = 255
+	maxIdempotentRequestBytes = 1 << 20
+)
+
+// idempotentRoutes lists the routes honoring Idempotency-Key. POST /admin/api-keys is
+// left out on purpose: its response carries the plaintext key, which must not be stored.
+var idempotentRoutes = map[string]bool{
+	"POST /subscriptions": true,
+}
+
+// idempotency replays stored responses of requests retried with the same Idempotency-Key.
+// It must run after authenticate: keys are scoped to the tenant and the principal. The
+// first request takes a lease on the key; duplicates arriving while it runs get a 409.
+// Responses below 500 are stored, server errors release the key so the client can retry.
+func (r *Server) idempotency(store port.IdempotencyStore, cfg config.IdempotencyConfig) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			key := req.Header.Get(idempotencyKeyHeader)
+			principal, authenticated := entity.PrincipalFromContext(req.Context())
+
+			if key == "" || !authenticated || !idempotentRoutes[routeKey(req)] {
+				next.ServeHTTP(w, req)
+				return
+			}
+
+			if len(key) > maxIdempotencyKeyLength {
+				r.writeError(w, req, invalidParam(idempotencyKeyHeader, gen.Header, "must be at most 255 characters"))
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxIdempotentRequestBytes))
+			if err != nil {
+				r.writeError(w, req, &problemError{
+					problem: problemMalformedRequest,
+					detail:  "request body can't be read",
+					cause:   err,
+				})
+				return
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+
+			tenantID, _ := entity.TenantFromContext(req.Context())
+			scope := tenantID + ":" + principal.Subject
+
+			// The token tells this lease from the one of a duplicate that takes the key over
+			// after the lease expires.
+			token := uuid.NewString()
+
+			stored, err := store.Acquire(req.Context(), scope, key, token, fingerprint(req, body), cfg.Lease)
+			switch {
+			case errors.Is(err, port.ErrIdempotencyKeyReused):
+				r.writeError(w, req, &problemError{problem: problemIdempotencyKeyReused, detail: err.Error()})
+				return
+			case errors.Is(err, port.ErrIdempotencyInProgress):
+				w.Header().Set("Retry-After", "1")
+				r.writeError(w, req, &problemError{problem: problemIdempotencyInProgress, detail: err.Error()})
+				return
+			case err != nil:
+				r.writeError(w, req, err)
+				return
+			}
+
+			if stored != nil {
+				replay(w, *stored)
+				return
+			}
+
+			buf := new(bytes.Buffer)
+			ww := middleware.NewWrapResponseWriter(w, req.ProtoMajor)
+			ww.Tee(buf)
+
+			next.ServeHTTP(ww, req)
+
+			// The response is already sent: a client that went away must still find it.
+			ctx := context.WithoutCancel(req.Context())
+			logger := logctx.Logger(ctx, r.logger)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			if status >= http.StatusInternalServerError {
+				if err := store.Release(ctx, scope, key, token); err != nil {
+					logger.Error("release idempotency key", zap.Error(err))
+				}
+				return
+			}
+
+			resp := entity.IdempotentResponse{
+				StatusCode:  status,
+				ContentType: w.Header().Get("Content-Type"),
+				Body:        buf.Bytes(),
+			}
+			err = store.Complete(ctx, scope, key, token, resp, cfg.TTL)
+			switch {
+			case errors.Is(err, port.ErrIdempotencyLeaseLost):
+				// The duplicate that took the key over stores its own response.
+				logger.Warn("idempotent response not stored", zap.Error(err))
+			case err != nil:
+				logger.Error("store idempotent response", zap.Error(err))
+			}
+		})
+	}
+}
+
+// fingerprint identifies the request a key was first used with.
+func fingerprint(req *http.Request, body []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte(req.Method + " " + req.URL.Path + "\n"))
+	h.Write(body)
+
+	return h.Sum(nil)
+}
+
+func replay(w http.ResponseWriter, resp entity.IdempotentResponse) {
+	if resp.ContentType != "" {
+		w.Header().Set("Content-Type", resp.ContentType)
+	}
+	w.Header().Set(idempotentReplayedHeader, "true")
+	w.WriteHeader(resp.StatusCode)
+	_, _ = w.Write(resp.Body)
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"subscription-service/internal/pkg/logctx"
+)
+
+const (
+	requestIDHeader = "X-Request-ID"
+
+	bodyLogSampleRate  = 100
+	maxLoggedBodyBytes = 2048
+	redactedValue      = "[REDACTED]"
+)
+
+//nolint:gochecknoglobals // compiled once.
+var validRequestID = regexp.MustCompile(`^[a-zA-Z0-9._:\-]{1,128}$`)
+
+//nolint:gochecknoglobals // read-only set of body fields hidden from logs.
+var redactedFields = map[string]struct{}{
+	"user_id":       {},
+	"email":         {},
+	"password":      {},
+	"token":         {},
+	"secret":        {},
+	"api_key":       {},
+	"authorization": {},
+}
+
+// requestID accepts a well-formed X-Request-ID from the client or generates a new one,
+// stores it in the request context and echoes it back in the response.
+func requestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(requestIDHeader)
+		if !validRequestID.MatchString(id) {
+			id = uuid.NewString()
+		}
+
+		w.Header().Set(requestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(logctx.WithRequestID(r.Context(), id)))
+	})
+}
+
+// accessLog writes one structured line per request. Request and response bodies are
+// logged only at debug level, for a sample of requests and with sensitive fields redacted.
+func accessLog(logger *zap.Logger) func(http.Handler) http.Handler {
+	var counter atomic.Uint64
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			logBodies := logger.Core().Enabled(zapcore.DebugLevel) &&
+				counter.Add(1)%bodyLogSampleRate == 1
+
+			var reqBody, respBody *bytes.Buffer
+			if logBodies {
+				reqBody, respBody = new(bytes.Buffer), new(bytes.Buffer)
+				r.Body = teeBody(r.Body, reqBody)
+				ww.Tee(&limitedWriter{buf: respBody, limit: maxLoggedBodyBytes})
+			}
+
+			next.ServeHTTP(ww, r)
+
+			route := r.URL.Path
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+				route = rctx.RoutePattern()
+			}
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			fields := []zap.Field{
+				zap.String("method", r.Method),
+				zap.String("route", route),
+				zap.Int("status", status),
+				zap.Int("bytes", ww.BytesWritten()),
+				zap.Duration("duration", time.Since(start)),
+				zap.String("remote_addr", r.RemoteAddr),
+			}
+
+			if logBodies {
+				fields = append(fields,
+					zap.String("request_body", redactBody(reqBody.Bytes())),
+					zap.String("response_body", redactBody(respBody.Bytes())),
+				)
+			}
+
+			logctx.Logger(r.Context(), logger).Info("http request", fields...)
+		})
+	}
+}
+
+type limitedWriter struct {
+	buf   *bytes.Buffer
+	limit int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if free := w.limit - w.buf.Len(); free > 0 {
+		w.buf.Write(p[:min(len(p), free)])
+	}
+
+	return len(p), nil
+}
+
+type teeReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func teeBody(body io.ReadCloser, buf *bytes.Buffer) io.ReadCloser {
+	if body == nil || body == http.NoBody {
+		return body
+	}
+
+	return teeReadCloser{
+		Reader: io.TeeReader(body, &limitedWriter{buf: buf, limit: maxLoggedBodyBytes}),
+		Closer: body,
+	}
+}
+
+// redactBody hides sensitive fields of a JSON body. Non JSON bodies are replaced
+// with a placeholder because they can't be inspected.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return "[non-JSON or truncated body]"
+	}
+
+	redacted, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return redactedValue
+	}
+
+	return string(redacted)
+}
+
+func redactValue(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, field := range val {
+			if _, ok := redactedFields[k]; ok {
+				val[k] = redactedValue
+				continue
+			}
+			val[k] = redactValue(field)
+		}
+		return val
+	case []any:
+		for i := range val {
+			val[i] = redactValue(val[i])
+		}
+		return val
+	default:
+		return v
+	}
+}
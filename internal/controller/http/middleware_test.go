@@ -0,0 +1,173 @@
+package handler_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	handler "subscription-service/internal/controller/http"
+	"subscription-service/internal/pkg/logctx"
+)
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		wantKept bool
+	}{
+		{"well-formed", "req-42.retry:1_a", true},
+		{"longest", strings.Repeat("a", 128), true},
+		{"missing", "", false},
+		{"too long", strings.Repeat("a", 129), false},
+		{"space", "req 42", false},
+		{"markup", "<script>", false},
+		{"line break", "req\n42", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var seen string
+			h := handler.RequestID(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				seen = logctx.RequestID(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/subscriptions", nil)
+			req.Header.Set("X-Request-ID", tt.header)
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			echoed := rec.Header().Get("X-Request-ID")
+			if echoed != seen {
+				t.Errorf("echoed request id %q, the handler saw %q", echoed, seen)
+			}
+			if tt.wantKept {
+				if echoed != tt.header {
+					t.Errorf("request id %q, want %q kept", echoed, tt.header)
+				}
+			} else if _, err := uuid.Parse(echoed); err != nil {
+				t.Errorf("request id %q, want a generated UUID: %v", echoed, err)
+			}
+		})
+	}
+}
+
+// serveLogged sends requests with body through requestID and accessLog logging at
+// level, to a handler that answers with respBody, and returns the logged entries.
+func serveLogged(t *testing.T, level zapcore.Level, requests int, body, respBody string) []observer.LoggedEntry {
+	t.Helper()
+
+	core, logs := observer.New(level)
+	h := handler.RequestID(handler.AccessLog(zap.New(core))(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.Copy(io.Discard, r.Body)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, respBody)
+		})))
+
+	for range requests {
+		req := httptest.NewRequest(http.MethodPost, "/subscriptions", strings.NewReader(body))
+		req.Header.Set("X-Request-ID", "req-42")
+		h.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	return logs.AllUntimed()
+}
+
+func TestAccessLog(t *testing.T) {
+	const body = `{"service_name": "Okko", "user_id": "60601fee-2bf1-4721-ae6f-7636e79a0cba"}`
+
+	t.Run("fields", func(t *testing.T) {
+		entries := serveLogged(t, zapcore.InfoLevel, 1, body, `{}`)
+		if len(entries) != 1 {
+			t.Fatalf("logged %d entries, want 1", len(entries))
+		}
+
+		fields := entries[0].ContextMap()
+		if fields["request_id"] != "req-42" || fields["method"] != http.MethodPost ||
+			fields["route"] != "/subscriptions" || fields["status"] != int64(http.StatusCreated) {
+			t.Errorf("logged fields %v", fields)
+		}
+		if _, ok := fields["request_body"]; ok {
+			t.Errorf("bodies logged above the debug level: %v", fields)
+		}
+	})
+
+	t.Run("sampling", func(t *testing.T) {
+		// The first request and every hundredth after it log their bodies.
+		entries := serveLogged(t, zapcore.DebugLevel, 201, body, `{}`)
+		if len(entries) != 201 {
+			t.Fatalf("logged %d entries, want 201", len(entries))
+		}
+
+		var sampled []int
+		for i, e := range entries {
+			if _, ok := e.ContextMap()["request_body"]; ok {
+				sampled = append(sampled, i)
+			}
+		}
+		if len(sampled) != 3 || sampled[0] != 0 || sampled[1] != 100 || sampled[2] != 200 {
+			t.Errorf("bodies logged for requests %v, want 0, 100 and 200", sampled)
+		}
+	})
+
+	t.Run("redaction", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			respBody    string
+			wantRequest string
+			wantResp    string
+		}{
+			{"json", `{"id": "42", "token": "t0p"}`,
+				`{"service_name":"Okko","user_id":"[REDACTED]"}`, `{"id":"42","token":"[REDACTED]"}`},
+			{"truncated response", `{"id": "` + strings.Repeat("a", 2048) + `"}`,
+				`{"service_name":"Okko","user_id":"[REDACTED]"}`, "[non-JSON or truncated body]"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				entries := serveLogged(t, zapcore.DebugLevel, 1, body, tt.respBody)
+				if len(entries) != 1 {
+					t.Fatalf("logged %d entries, want 1", len(entries))
+				}
+
+				fields := entries[0].ContextMap()
+				if fields["request_body"] != tt.wantRequest || fields["response_body"] != tt.wantResp {
+					t.Errorf("logged request body %v and response body %v, want %s and %s",
+						fields["request_body"], fields["response_body"], tt.wantRequest, tt.wantResp)
+				}
+			})
+		}
+	})
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"empty", "", ""},
+		{"no sensitive fields", `{"price": 400}`, `{"price":400}`},
+		{"top level", `{"email": "a@b.c", "price": 400}`, `{"email":"[REDACTED]","price":400}`},
+		{"nested", `{"owner": {"password": "p", "name": "n"}}`, `{"owner":{"name":"n","password":"[REDACTED]"}}`},
+		{"in an array", `[{"api_key": "k"}, {"secret": {"a": 1}}]`, `[{"api_key":"[REDACTED]"},{"secret":"[REDACTED]"}]`},
+		{"not json", "user_id=42", "[non-JSON or truncated body]"},
+		{"truncated", `{"user_id": "6060`, "[non-JSON or truncated body]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := handler.RedactBody([]byte(tt.body)); got != tt.want {
+				t.Errorf("redactBody(%s) = %s, want %s", tt.body, got, tt.want)
+			}
+		})
+	}
+}
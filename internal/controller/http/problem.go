@@ -0,0 +1,294 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/usecase"
+	"subscription-service/internal/controller/http/gen"
+	"subscription-service/internal/pkg/logctx"
+	pkg "subscription-service/internal/pkg/utils"
+)
+
+const (
+	problemContentType = "application/problem+json"
+	problemTypeBaseURI = "/problems/"
+
+	internalErrorDetail = "the server failed to process the request"
+)
+
+// problemType identifies a class of RFC 7807 errors returned by the API.
+type problemType string
+
+const (
+	problemValidation            problemType = "validation-error"
+	problemMalformedRequest      problemType = "malformed-request"
+	problemUnauthorized          problemType = "unauthorized"
+	problemForbidden             problemType = "forbidden"
+	problemTenantRequired        problemType = "tenant-required"
+	problemNotFound              problemType = "not-found"
+	problemMethodNotAllowed      problemType = "method-not-allowed"
+	problemAlreadyExists         problemType = "already-exists"
+	problemInvalidData           problemType = "invalid-subscription-data"
+	problemSubscriptionEnded     problemType = "subscription-ended"
+	problemInvalidReminderPrefs  problemType = "invalid-reminder-preferences"
+	problemBudgetExists          problemType = "budget-already-exists"
+	problemInvalidBudget         problemType = "invalid-budget"
+	problemServiceExists         problemType = "service-already-exists"
+	problemInvalidService        problemType = "invalid-service"
+	problemBudgetExceeded        problemType = "budget-exceeded"
+	problemInvalidAPIKey         problemType = "invalid-api-key-data"
+	problemRateLimited           problemType = "rate-limited"
+	problemIdempotencyKeyReused  problemType = "idempotency-key-reused"
+	problemIdempotencyInProgress problemType = "idempotency-in-progress"
+	problemInternal              problemType = "internal-error"
+)
+
+func (p problemType) status() int {
+	switch p {
+	case problemValidation, problemMalformedRequest, problemTenantRequired:
+		return http.StatusBadRequest
+	case problemUnauthorized:
+		return http.StatusUnauthorized
+	case problemForbidden:
+		return http.StatusForbidden
+	case problemNotFound:
+		return http.StatusNotFound
+	case problemMethodNotAllowed:
+		return http.StatusMethodNotAllowed
+	case problemAlreadyExists, problemBudgetExists, problemServiceExists, problemSubscriptionEnded,
+		problemIdempotencyInProgress:
+		return http.StatusConflict
+	case problemInvalidData, problemInvalidReminderPrefs, problemInvalidBudget, problemBudgetExceeded,
+		problemInvalidService, problemInvalidAPIKey, problemIdempotencyKeyReused:
+		return http.StatusUnprocessableEntity
+	case problemRateLimited:
+		return http.StatusTooManyRequests
+	case problemInternal:
+		return http.StatusInternalServerError
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func (p problemType) title() string {
+	switch p {
+	case problemValidation:
+		return "Request validation failed"
+	case problemMalformedRequest:
+		return "Malformed request"
+	case problemUnauthorized:
+		return "Authentication required"
+	case problemForbidden:
+		return "Access denied"
+	case problemTenantRequired:
+		return "Tenant is required"
+	case problemNotFound:
+		return "Resource not found"
+	case problemMethodNotAllowed:
+		return "Method not allowed"
+	case problemAlreadyExists:
+		return "Subscription already exists"
+	case problemInvalidData:
+		return "Invalid subscription data"
+	case problemSubscriptionEnded:
+		return "Subscription has ended"
+	case problemInvalidReminderPrefs:
+		return "Invalid reminder preferences"
+	case problemBudgetExists:
+		return "Budget already exists"
+	case problemInvalidBudget:
+		return "Invalid budget"
+	case problemBudgetExceeded:
+		return "Budget exceeded"
+	case problemServiceExists:
+		return "Service already exists"
+	case problemInvalidService:
+		return "Invalid service"
+	case problemInvalidAPIKey:
+		return "Invalid API key data"
+	case problemRateLimited:
+		return "Too many requests"
+	case problemIdempotencyKeyReused:
+		return "Idempotency key reused"
+	case problemIdempotencyInProgress:
+		return "Request is still in progress"
+	case problemInternal:
+		return "Internal server error"
+	default:
+		return http.StatusText(p.status())
+	}
+}
+
+// problemError is an error that already knows how it is presented to clients.
+type problemError struct {
+	problem       problemType
+	detail        string
+	invalidParams []gen.InvalidParam
+	cause         error
+}
+
+func (e *problemError) Error() string {
+	if e.cause != nil {
+		return string(e.problem) + ": " + e.detail + ": " + e.cause.Error()
+	}
+
+	return string(e.problem) + ": " + e.detail
+}
+
+func (e *problemError) Unwrap() error {
+	return e.cause
+}
+
+func invalidParam(name string, in gen.InvalidParamIn, reason string) error {
+	return &problemError{
+		problem:       problemValidation,
+		detail:        "request does not match the API contract",
+		invalidParams: []gen.InvalidParam{{Name: name, In: in, Reason: reason}},
+	}
+}
+
+// problemFromError maps use case sentinel errors to problem types. The detail of a
+// sentinel keeps what the use case added to it, such as the phase or pause at fault,
+// but not the context handlers wrap it in. Details of unknown errors are never exposed
+// because they may contain driver messages.
+func problemFromError(err error) *problemError {
+	var pErr *problemError
+	if errors.As(err, &pErr) {
+		return pErr
+	}
+
+	sentinels := []struct {
+		err     error
+		problem problemType
+	}{
+		{usecase.ErrNotFound, problemNotFound},
+		{usecase.ErrSubscriptionNotFound, problemNotFound},
+		{usecase.ErrSubscriptionAlreadyExists, problemAlreadyExists},
+		{usecase.ErrInvalidSubscriptionData, problemInvalidData},
+		{usecase.ErrUnknownCancelReason, problemInvalidData},
+		{usecase.ErrSubscriptionEnded, problemSubscriptionEnded},
+		{usecase.ErrInvalidReminderPreferences, problemInvalidReminderPrefs},
+		{usecase.ErrBudgetNotFound, problemNotFound},
+		{usecase.ErrBudgetAlreadyExists, problemBudgetExists},
+		{usecase.ErrInvalidBudget, problemInvalidBudget},
+		{usecase.ErrBudgetExceeded, problemBudgetExceeded},
+		{usecase.ErrServiceNotFound, problemNotFound},
+		{usecase.ErrServiceAlreadyExists, problemServiceExists},
+		{usecase.ErrInvalidService, problemInvalidService},
+		{usecase.ErrUnauthenticated, problemUnauthorized},
+		{usecase.ErrForbidden, problemForbidden},
+		{usecase.ErrTenantRequired, problemTenantRequired},
+		{usecase.ErrAPIKeyNotFound, problemNotFound},
+		{usecase.ErrInvalidAPIKeyData, problemInvalidAPIKey},
+	}
+
+	for _, s := range sentinels {
+		if errors.Is(err, s.err) {
+			return &problemError{problem: s.problem, detail: sentinelDetail(err, s.err)}
+		}
+	}
+
+	return &problemError{problem: problemInternal, detail: internalErrorDetail}
+}
+
+// sentinelDetail returns the message of err from its sentinel on: use cases put the
+// sentinel first and explain it after, while handlers prefix the operation.
+func sentinelDetail(err, sentinel error) string {
+	msg := err.Error()
+	if i := strings.Index(msg, sentinel.Error()); i >= 0 {
+		return msg[i:]
+	}
+
+	return sentinel.Error()
+}
+
+// writeError logs err and writes it as problem+json.
+func (r *Server) writeError(w http.ResponseWriter, req *http.Request, err error) {
+	p := problemFromError(err)
+
+	logger := logctx.Logger(req.Context(), r.logger)
+	if p.problem.status() >= http.StatusInternalServerError {
+		logger.Error("request failed", zap.String("path", req.URL.Path), zap.Error(err))
+	} else {
+		logger.Debug("request rejected", zap.String("path", req.URL.Path), zap.Error(err))
+	}
+
+	writeProblem(w, req, p, logger)
+}
+
+func writeProblem(w http.ResponseWriter, req *http.Request, p *problemError, logger *zap.Logger) {
+	status := p.problem.status()
+
+	body := gen.Problem{
+		Type:     problemTypeBaseURI + string(p.problem),
+		Title:    p.problem.title(),
+		Status:   status,
+		Detail:   pkg.PointerTo(p.detail),
+		Instance: pkg.PointerTo(req.URL.Path),
+	}
+
+	if requestID := logctx.RequestID(req.Context()); requestID != "" {
+		body.RequestId = &requestID
+	}
+
+	if len(p.invalidParams) > 0 {
+		body.InvalidParams = &p.invalidParams
+	}
+
+	if status == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", authChallenge)
+	}
+
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logger.Error("write problem response", zap.Error(err))
+	}
+}
+
+func (r *Server) requestErrorHandler(w http.ResponseWriter, req *http.Request, err error) {
+	r.writeError(w, req, &problemError{
+		problem: problemMalformedRequest,
+		detail:  "request body is not valid JSON",
+		cause:   err,
+	})
+}
+
+func (r *Server) responseErrorHandler(w http.ResponseWriter, req *http.Request, err error) {
+	r.writeError(w, req, err)
+}
+
+// paramErrorHandler handles parameters that the generated router failed to bind.
+func (r *Server) paramErrorHandler(w http.ResponseWriter, req *http.Request, err error) {
+	var (
+		formatErr   *gen.InvalidParamFormatError
+		requiredErr *gen.RequiredParamError
+	)
+
+	paramIn := func(name string) gen.InvalidParamIn {
+		if chi.URLParam(req, name) != "" {
+			return gen.Path
+		}
+		return gen.Query
+	}
+
+	switch {
+	case errors.As(err, &formatErr):
+		r.writeError(w, req, invalidParam(formatErr.ParamName, paramIn(formatErr.ParamName), "invalid format"))
+	case errors.As(err, &requiredErr):
+		r.writeError(w, req, invalidParam(requiredErr.ParamName, paramIn(requiredErr.ParamName), "parameter is required"))
+	default:
+		r.writeError(w, req, &problemError{
+			problem: problemMalformedRequest,
+			detail:  "request parameters are malformed",
+			cause:   err,
+		})
+	}
+}
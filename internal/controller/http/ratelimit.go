@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/config"
+	"subscription-service/internal/pkg/logctx"
+	"subscription-service/internal/port"
+)
+
+type rateLimitCheck struct {
+	key   string
+	limit entity.RateLimit
+}
+
+// tightestDecisionKey keeps the most restrictive decision of the client stage for the
+// principal stage to compare its own with.
+type tightestDecisionKey struct{}
+
+// rateLimitByClient applies the token bucket of the client IP. It runs before
+// authenticate so that requests with bad credentials are limited too and can't be used
+// to guess keys or flood the key lookups.
+func (r *Server) rateLimitByClient(limiter port.RateLimiter, cfg config.RateLimitConfig) func(http.Handler) http.Handler {
+	return r.rateLimit(limiter, func(req *http.Request) []rateLimitCheck {
+		if cfg.PerIP.Requests <= 0 {
+			return nil
+		}
+		return []rateLimitCheck{{key: "ip:" + clientIP(req), limit: toRateLimit(cfg.PerIP)}}
+	})
+}
+
+// rateLimitByPrincipal applies the token buckets of the authenticated principal and of
+// expensive routes. It must run after authenticate.
+func (r *Server) rateLimitByPrincipal(limiter port.RateLimiter, cfg config.RateLimitConfig) func(http.Handler) http.Handler {
+	return r.rateLimit(limiter, func(req *http.Request) []rateLimitCheck {
+		return principalRateLimitChecks(req, cfg)
+	})
+}
+
+// rateLimit applies the token buckets of checks. The most restrictive bucket of all the
+// stages is reported in the RateLimit-* headers. Errors of the limiter fail open.
+func (r *Server) rateLimit(
+	limiter port.RateLimiter,
+	checks func(*http.Request) []rateLimitCheck,
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			tightest, _ := req.Context().Value(tightestDecisionKey{}).(*entity.RateLimitDecision)
+
+			for _, check := range checks(req) {
+				decision, err := limiter.Allow(req.Context(), check.key, check.limit)
+				if err != nil {
+					logctx.Logger(req.Context(), r.logger).Error("rate limiter failed", zap.Error(err))
+					continue
+				}
+
+				if tightest == nil || decision.Remaining < tightest.Remaining || !decision.Allowed {
+					tightest = &decision
+				}
+
+				if !decision.Allowed {
+					break
+				}
+			}
+
+			if tightest == nil {
+				next.ServeHTTP(w, req)
+				return
+			}
+
+			setRateLimitHeaders(w.Header(), *tightest)
+
+			if !tightest.Allowed {
+				w.Header().Set("Retry-After", strconv.Itoa(max(ceilSeconds(tightest.RetryAfter), 1)))
+				r.writeError(w, req, &problemError{
+					problem: problemRateLimited,
+					detail:  "rate limit exceeded, retry later",
+				})
+				return
+			}
+
+			next.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), tightestDecisionKey{}, tightest)))
+		})
+	}
+}
+
+func principalRateLimitChecks(req *http.Request, cfg config.RateLimitConfig) []rateLimitCheck {
+	client := "ip:" + clientIP(req)
+
+	var checks []rateLimitCheck
+
+	if principal, ok := entity.PrincipalFromContext(req.Context()); ok {
+		client = "principal:" + principal.Subject
+		if cfg.PerPrincipal.Requests > 0 {
+			checks = append(checks, rateLimitCheck{key: client, limit: toRateLimit(cfg.PerPrincipal)})
+		}
+	}
+
+	if route := routeKey(req); route != "" {
+		if limit, ok := cfg.Routes[route]; ok && limit.Requests > 0 {
+			checks = append(checks, rateLimitCheck{key: "route:" + route + ":" + client, limit: toRateLimit(limit)})
+		}
+	}
+
+	return checks
+}
+
+// routeKey resolves the route pattern before the router has matched the request, so
+// that path parameters don't create a bucket per resource.
+func routeKey(req *http.Request) string {
+	rctx := chi.RouteContext(req.Context())
+	if rctx == nil || rctx.Routes == nil {
+		return ""
+	}
+
+	pattern := rctx.Routes.Find(chi.NewRouteContext(), req.Method, req.URL.Path)
+	if pattern == "" {
+		return ""
+	}
+
+	return req.Method + " " + pattern
+}
+
+// clientIP uses the address of the peer. Forwarding headers are not trusted because
+// clients can set them freely.
+func clientIP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+
+	return host
+}
+
+func setRateLimitHeaders(h http.Header, d entity.RateLimitDecision) {
+	h.Set("RateLimit-Limit", strconv.Itoa(d.Limit))
+	h.Set("RateLimit-Remaining", strconv.Itoa(d.Remaining))
+	h.Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(d.Reset)))
+}
+
+func toRateLimit(l config.RateLimit) entity.RateLimit {
+	return entity.RateLimit{Burst: l.Requests, Period: l.Per}
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
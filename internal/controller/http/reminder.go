@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/controller/http/gen"
+)
+
+func (r *Server) GetUsersIdReminderPreferences(
+	ctx context.Context,
+	request gen.GetUsersIdReminderPreferencesRequestObject,
+) (gen.GetUsersIdReminderPreferencesResponseObject, error) {
+	prefs, err := r.reminderUsecase.Preferences(ctx, request.Id.String())
+	if err != nil {
+		return nil, fmt.Errorf("get reminder preferences: %w", err)
+	}
+
+	return gen.GetUsersIdReminderPreferences200JSONResponse(toReminderPreferences(*prefs)), nil
+}
+
+func (r *Server) PutUsersIdReminderPreferences(
+	ctx context.Context,
+	request gen.PutUsersIdReminderPreferencesRequestObject,
+) (gen.PutUsersIdReminderPreferencesResponseObject, error) {
+	req := entity.ReminderPreferences{
+		UserID:       request.Id.String(),
+		Renewals:     request.Body.Renewals,
+		Ends:         request.Body.Ends,
+		PriceChanges: request.Body.PriceChanges,
+	}
+	if request.Body.DaysBefore != nil {
+		req.DaysBefore = *request.Body.DaysBefore
+	}
+	if request.Body.Email != nil {
+		req.Email = *request.Body.Email
+	}
+
+	prefs, err := r.reminderUsecase.SetPreferences(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("set reminder preferences: %w", err)
+	}
+
+	return gen.PutUsersIdReminderPreferences200JSONResponse(toReminderPreferences(*prefs)), nil
+}
+
+func toReminderPreferences(prefs entity.ReminderPreferences) gen.ReminderPreferences {
+	resp := gen.ReminderPreferences{
+		DaysBefore:   &prefs.DaysBefore,
+		Renewals:     prefs.Renewals,
+		Ends:         prefs.Ends,
+		PriceChanges: prefs.PriceChanges,
+	}
+	if prefs.Email != "" {
+		resp.Email = &prefs.Email
+	}
+
+	return resp
+}
@@ -2,44 +2,64 @@ package handler
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"time"
 
-	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 
 	"subscription-service/internal/app/entity"
 	"subscription-service/internal/app/usecase"
+	"subscription-service/internal/config"
 	"subscription-service/internal/controller/http/gen"
 	pkg "subscription-service/internal/pkg/utils"
+	"subscription-service/internal/port"
 )
 
 var _ gen.StrictServerInterface = (*Server)(nil)
 
-const (
-	defaultReadTimeout     = time.Second * 10
-	defaultHeadReadTimeout = time.Second * 5
-	defaultWriteTimeout    = time.Second * 15
-	defaultIdleTimeout     = time.Minute * 2
-)
+const monthLayout = "01-2006"
+
+// maxPeriodMonths bounds the period of the monthly cost breakdown.
+const maxPeriodMonths = 120
 
 type Server struct {
-	address    string
-	subUsecase usecase.SubscriptionUseCase
-	pool       *pgxpool.Pool
-	logger     *zap.Logger
+	cfg              config.HTTPConfig
+	subUsecase       usecase.SubscriptionUseCase
+	authUsecase      usecase.AuthUseCase
+	reminderUsecase  usecase.ReminderUseCase
+	budgetUsecase    usecase.BudgetUseCase
+	analyticsUsecase usecase.AnalyticsUseCase
+	catalogUsecase   usecase.CatalogUseCase
+	pool             *pgxpool.Pool
+	logger           *zap.Logger
 }
 
-func NewServer(address string, subUsecase usecase.SubscriptionUseCase, pool *pgxpool.Pool, logger *zap.Logger) *Server {
+func NewServer(
+	cfg config.HTTPConfig,
+	subUsecase usecase.SubscriptionUseCase,
+	authUsecase usecase.AuthUseCase,
+	reminderUsecase usecase.ReminderUseCase,
+	budgetUsecase usecase.BudgetUseCase,
+	analyticsUsecase usecase.AnalyticsUseCase,
+	catalogUsecase usecase.CatalogUseCase,
+	pool *pgxpool.Pool,
+	logger *zap.Logger,
+) *Server {
 	return &Server{
-		address:    address,
-		subUsecase: subUsecase,
-		pool:       pool,
-		logger:     logger,
+		cfg:              cfg,
+		subUsecase:       subUsecase,
+		authUsecase:      authUsecase,
+		reminderUsecase:  reminderUsecase,
+		budgetUsecase:    budgetUsecase,
+		analyticsUsecase: analyticsUsecase,
+		catalogUsecase:   catalogUsecase,
+		pool:             pool,
+		logger:           logger,
 	}
 }
 
@@ -62,50 +82,34 @@ func (r *Server) GetSubscriptions(
 		filter.Price = &price64
 	}
 
-	t_s, err := time.Parse("01-2006", *request.Params.StartDate)
-	if err != nil {
-		if errors.Is(err, &time.ParseError{}) {
-			return gen.GetSubscriptions400JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
+	if request.Params.StartDate != nil {
+		startDate, err := parseMonthParam("start_date", gen.Query, *request.Params.StartDate)
+		if err != nil {
+			return nil, err
 		}
-		return gen.GetSubscriptions500JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
+		filter.StartDate = &startDate
 	}
 
-	startDateWithDay := time.Date(t_s.Year(), t_s.Month(), 1, 0, 0, 0, 0, time.UTC)
-
-	t_e, err := time.Parse("01-2006", *request.Params.EndDate)
-	if err != nil {
-		if errors.Is(err, &time.ParseError{}) {
-			return gen.GetSubscriptions400JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
+	if request.Params.EndDate != nil {
+		endDate, err := parseMonthParam("end_date", gen.Query, *request.Params.EndDate)
+		if err != nil {
+			return nil, err
 		}
-		return gen.GetSubscriptions500JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
+		filter.EndDate = &endDate
 	}
 
-	endDateWithDay := time.Date(t_e.Year(), t_e.Month(), 1, 0, 0, 0, 0, time.UTC)
-
-	filter.StartDate = &startDateWithDay
-	filter.EndDate = &endDateWithDay
-
 	filter.Limit = request.Params.Limit
 	filter.Offset = request.Params.Offset
 
 	subs, err := r.subUsecase.List(ctx, *filter)
 	if err != nil {
-		return gen.GetSubscriptions500JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
+		return nil, fmt.Errorf("list subscriptions: %w", err)
 	}
 
 	resp := make([]gen.Subscription, len(subs))
 
 	for i, s := range subs {
-		resp[i] = gen.Subscription{
-			Id:          pkg.UUID(s.ID),
-			ServiceName: s.Title,
-			Price:       int(s.Price),
-			UserId:      *pkg.UUID(s.UserID),
-			StartDate:   s.StartDate.String(),
-			EndDate:     pkg.PointerTo(s.EndDate.String()),
-			CreatedAt:   pkg.PointerTo(time.UnixMilli(s.CreatedAt)),
-			UpdatedAt:   pkg.PointerTo(time.UnixMilli(s.UpdatedAt)),
-		}
+		resp[i] = toSubscription(s)
 	}
 	return gen.GetSubscriptions200JSONResponse(resp), nil
 }
@@ -116,61 +120,52 @@ func (r *Server) PostSubscriptions(
 ) (gen.PostSubscriptionsResponseObject, error) {
 	filter := new(entity.CreateSubscriptionRequest)
 	filter.Title = request.Body.ServiceName
+	if request.Body.ServiceId != nil {
+		filter.ServiceID = pkg.PointerTo(request.Body.ServiceId.String())
+	}
 
 	filter.UserID = request.Body.UserId.String()
-	filter.Price = int64(request.Body.Price)
+	filter.Price = entity.NoPrice
+	if request.Body.Price != nil {
+		filter.Price = int64(*request.Body.Price)
+	}
 
-	t_s, err := time.Parse("01-2006", request.Body.StartDate)
+	startDate, err := parseMonthParam("start_date", gen.Body, request.Body.StartDate)
 	if err != nil {
-		if errors.Is(err, &time.ParseError{}) {
-			return gen.PostSubscriptions400JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
-		}
-		return gen.PostSubscriptions500JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
+		return nil, err
 	}
+	filter.StartDate = startDate
 
-	startDateWithDay := time.Date(t_s.Year(), t_s.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if request.Body.EndDate != nil {
+		endDate, err := parseMonthParam("end_date", gen.Body, *request.Body.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		if endDate.Before(startDate) {
+			return nil, invalidParam("end_date", gen.Body, "must not be before start_date")
+		}
+		filter.EndDate = &endDate
+	}
 
-	t_e, err := time.Parse("01-2006", *request.Body.EndDate)
+	filter.Phases, err = parsePhases(request.Body.Phases)
 	if err != nil {
-		if errors.Is(err, &time.ParseError{}) {
-			return gen.PostSubscriptions400JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
-		}
-		return gen.PostSubscriptions500JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
+		return nil, err
 	}
 
-	endDateWithDay := time.Date(t_e.Year(), t_e.Month(), 1, 0, 0, 0, 0, time.UTC)
+	filter.Renewal, err = parseRenewal(request.Body.Renewal)
+	if err != nil {
+		return nil, err
+	}
 
-	filter.StartDate = startDateWithDay
-	filter.EndDate = &endDateWithDay
 	filter.CreatedAt = time.Now().UnixMilli()
 	filter.UpdatedAt = time.Now().UnixMilli()
 
 	s, err := r.subUsecase.Create(ctx, *filter)
 	if err != nil {
-		if errors.Is(err, usecase.ErrSubscriptionAlreadyExists) {
-			return gen.PostSubscriptions400JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
-		}
-		return gen.PostSubscriptions500JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
-	}
-	created := time.UnixMilli(s.CreatedAt)
-	updated := time.UnixMilli(s.UpdatedAt)
-
-	return gen.PostSubscriptions201JSONResponse{
-		Id:          pkg.PointerTo(*pkg.UUID(s.ID)),
-		ServiceName: s.Title,
-		Price:       int(s.Price),
-		StartDate:   s.StartDate.Format("01-2006"),
-		EndDate: func() *string {
-			if s.EndDate != nil {
-				str := s.EndDate.Format("01-2006")
-				return &str
-			}
-			return nil
-		}(),
-		UserId:    *pkg.UUID(s.UserID),
-		CreatedAt: &created,
-		UpdatedAt: &updated,
-	}, nil
+		return nil, fmt.Errorf("create subscription: %w", err)
+	}
+
+	return gen.PostSubscriptions201JSONResponse(toSubscription(*s)), nil
 }
 
 func (r *Server) GetSubscriptionsSum(
@@ -183,46 +178,119 @@ func (r *Server) GetSubscriptionsSum(
 		filter.UserID = pkg.PointerTo(request.Params.UserId.String())
 	}
 
-	t_s, err := time.Parse("01-2006", request.Params.StartDate)
+	startDate, err := parseMonthParam("start_date", gen.Query, request.Params.StartDate)
 	if err != nil {
-		if errors.Is(err, &time.ParseError{}) {
-			return gen.GetSubscriptionsSum400JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
-		}
-		return gen.GetSubscriptionsSum500JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
+		return nil, err
 	}
 
-	startDateWithDay := time.Date(t_s.Year(), t_s.Month(), 1, 0, 0, 0, 0, time.UTC)
-
-	t_e, err := time.Parse("01-2006", request.Params.EndDate)
+	endDate, err := parseMonthParam("end_date", gen.Query, request.Params.EndDate)
 	if err != nil {
-		if errors.Is(err, &time.ParseError{}) {
-			return gen.GetSubscriptionsSum400JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
-		}
-		return gen.GetSubscriptionsSum500JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
+		return nil, err
 	}
 
-	endDateWithDay := time.Date(t_e.Year(), t_e.Month(), 1, 0, 0, 0, 0, time.UTC)
-
-	filter.StartDate = &startDateWithDay
-	filter.EndDate = &endDateWithDay
+	filter.StartDate = &startDate
+	filter.EndDate = &endDate
 
 	sum, err := r.subUsecase.Sum(ctx, *filter)
 	if err != nil {
-		return gen.GetSubscriptionsSum500JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
+		return nil, fmt.Errorf("sum subscriptions: %w", err)
 	}
 
 	return gen.GetSubscriptionsSum200JSONResponse(gen.AggregationResult{TotalCost: int(sum)}), nil
 }
 
-func (r *Server) DeleteSubscriptionsId(ctx context.Context, request gen.DeleteSubscriptionsIdRequestObject) (gen.DeleteSubscriptionsIdResponseObject, error) {
-	err := r.subUsecase.Delete(ctx, request.Id.String())
+func (r *Server) GetSubscriptionsSumMonthly(
+	ctx context.Context,
+	request gen.GetSubscriptionsSumMonthlyRequestObject,
+) (gen.GetSubscriptionsSumMonthlyResponseObject, error) {
+	filter := entity.PeriodFilter{Title: request.Params.ServiceName}
+	if request.Params.UserId != nil {
+		filter.UserID = pkg.PointerTo(request.Params.UserId.String())
+	}
+
+	var err error
+
+	filter.From, err = parseMonthParam("start_date", gen.Query, request.Params.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.To, err = parseMonthParam("end_date", gen.Query, request.Params.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	switch months := entity.MonthsBetween(filter.From, filter.To); {
+	case months < 1:
+		return nil, invalidParam("end_date", gen.Query, "must not be before start_date")
+	case months > maxPeriodMonths:
+		return nil, invalidParam("end_date", gen.Query, fmt.Sprintf("the period spans at most %d months", maxPeriodMonths))
+	}
+
+	costs, err := r.subUsecase.MonthlyCosts(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("monthly costs: %w", err)
+	}
+
+	resp := gen.MonthlyCostReport{Months: make([]gen.MonthlyCost, len(costs))}
+	for i, c := range costs {
+		resp.Months[i] = gen.MonthlyCost{Month: formatMonth(c.Month), TotalCost: int(c.Cost)}
+		resp.TotalCost += int(c.Cost)
+	}
+
+	return gen.GetSubscriptionsSumMonthly200JSONResponse(resp), nil
+}
+
+func (r *Server) GetSubscriptionsForecast(
+	ctx context.Context,
+	request gen.GetSubscriptionsForecastRequestObject,
+) (gen.GetSubscriptionsForecastResponseObject, error) {
+	req := entity.ForecastRequest{Now: time.Now(), Months: 12}
+	if request.Params.UserId != nil {
+		req.UserID = pkg.PointerTo(request.Params.UserId.String())
+	}
+	if request.Params.Months != nil {
+		req.Months = *request.Params.Months
+	}
+	if request.Params.GrowthPercent != nil {
+		req.GrowthPercent = *request.Params.GrowthPercent
+	}
+
+	forecast, err := r.subUsecase.Forecast(ctx, req)
 	if err != nil {
-		r.logger.Error("delete subscription", zap.Error(err))
+		return nil, fmt.Errorf("forecast: %w", err)
+	}
 
-		if errors.Is(err, usecase.ErrNotFound) {
-			return gen.DeleteSubscriptionsId404JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
+	resp := gen.Forecast{Months: make([]gen.ForecastMonth, len(forecast))}
+	for i, m := range forecast {
+		month := gen.ForecastMonth{
+			Month:         formatMonth(m.Month),
+			TotalCost:     int(m.Cost),
+			Subscriptions: make([]gen.ForecastItem, len(m.Items)),
+		}
+		for j, item := range m.Items {
+			month.Subscriptions[j] = gen.ForecastItem{
+				SubscriptionId: *pkg.UUID(item.SubscriptionID),
+				ServiceName:    item.Title,
+				Price:          int(item.Price),
+				Renewed:        item.Renewed,
+				Grown:          item.Grown,
+			}
 		}
-		return gen.DeleteSubscriptionsId500JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
+		resp.Months[i] = month
+		resp.TotalCost += int(m.Cost)
+	}
+
+	return gen.GetSubscriptionsForecast200JSONResponse(resp), nil
+}
+
+func (r *Server) DeleteSubscriptionsId(
+	ctx context.Context,
+	request gen.DeleteSubscriptionsIdRequestObject,
+) (gen.DeleteSubscriptionsIdResponseObject, error) {
+	err := r.subUsecase.Delete(ctx, request.Id.String())
+	if err != nil {
+		return nil, fmt.Errorf("delete subscription: %w", err)
 	}
 	return gen.DeleteSubscriptionsId204Response{}, nil
 }
@@ -233,29 +301,10 @@ func (r *Server) GetSubscriptionsId(
 ) (gen.GetSubscriptionsIdResponseObject, error) {
 	sub, err := r.subUsecase.Read(ctx, request.Id.String())
 	if err != nil {
-		r.logger.Error("get subscription", zap.Error(err))
-
-		if errors.Is(err, usecase.ErrNotFound) {
-			return gen.GetSubscriptionsId404JSONResponse{}, nil
-		}
-		return nil, err
+		return nil, fmt.Errorf("get subscription: %w", err)
 	}
 
-	userID := pkg.UUID(sub.UserID)
-
-	created := time.UnixMilli(sub.CreatedAt)
-	updated := time.UnixMilli(sub.UpdatedAt)
-
-	return gen.GetSubscriptionsId200JSONResponse{
-		Id:          pkg.UUID(sub.ID),
-		ServiceName: sub.Title,
-		Price:       int(sub.Price),
-		StartDate:   sub.StartDate.String(),
-		EndDate:     pkg.PointerTo(sub.EndDate.String()),
-		UserId:      *userID,
-		CreatedAt:   &created,
-		UpdatedAt:   &updated,
-	}, nil
+	return gen.GetSubscriptionsId200JSONResponse(toSubscription(*sub)), nil
 }
 
 func (r *Server) PutSubscriptionsId(
@@ -264,30 +313,37 @@ func (r *Server) PutSubscriptionsId(
 ) (gen.PutSubscriptionsIdResponseObject, error) {
 	sub := new(entity.UpdateSubscriptionRequest)
 	sub.Title = request.Body.ServiceName
+	if request.Body.ServiceId != nil {
+		sub.ServiceID = pkg.PointerTo(request.Body.ServiceId.String())
+	}
 	price := int64(request.Body.Price)
 	sub.Price = price
-	t_s, err := time.Parse("01-2006", request.Body.StartDate)
-	if err != nil {
-		r.logger.Error("parse start date", zap.Error(err))
 
-		if errors.Is(err, &time.ParseError{}) {
-			return gen.PutSubscriptionsId400JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
-		}
-		return gen.PutSubscriptionsId400JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
+	startDate, err := parseMonthParam("start_date", gen.Body, request.Body.StartDate)
+	if err != nil {
+		return nil, err
 	}
-	sub.StartDate = time.Date(t_s.Year(), t_s.Month(), 1, 0, 0, 0, 0, time.UTC)
+	sub.StartDate = startDate
+
 	if request.Body.EndDate != nil {
-		t_e, err := time.Parse("01-2006", *request.Body.EndDate)
+		endDate, err := parseMonthParam("end_date", gen.Body, *request.Body.EndDate)
 		if err != nil {
-			r.logger.Error("parse end date", zap.Error(err))
-
-			if errors.Is(err, usecase.ErrInvalidSubscriptionData) {
-				return gen.PutSubscriptionsId404JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
-			}
+			return nil, err
+		}
+		if endDate.Before(startDate) {
+			return nil, invalidParam("end_date", gen.Body, "must not be before start_date")
 		}
-		endDateWithDay := time.Date(t_e.Year(), t_e.Month(), 1,
-			0, 0, 0, 0, time.UTC)
-		sub.EndDate = &endDateWithDay
+		sub.EndDate = &endDate
+	}
+
+	sub.Phases, err = parsePhases(request.Body.Phases)
+	if err != nil {
+		return nil, err
+	}
+
+	sub.Renewal, err = parseRenewal(request.Body.Renewal)
+	if err != nil {
+		return nil, err
 	}
 
 	sub.ID = request.Id.String()
@@ -295,69 +351,430 @@ func (r *Server) PutSubscriptionsId(
 
 	err = r.subUsecase.Update(ctx, *sub)
 	if err != nil {
-		r.logger.Error("update subscription", zap.Error(err))
+		return nil, fmt.Errorf("update subscription: %w", err)
+	}
+
+	return gen.PutSubscriptionsId204Response{}, nil
+}
+
+func (r *Server) PostSubscriptionsIdPause(
+	ctx context.Context,
+	request gen.PostSubscriptionsIdPauseRequestObject,
+) (gen.PostSubscriptionsIdPauseResponseObject, error) {
+	now := time.Now()
+	req := entity.PauseRequest{
+		ID:        request.Id.String(),
+		StartDate: entity.MonthStart(now),
+		UpdatedAt: now.UnixMilli(),
+	}
 
-		if errors.Is(err, usecase.ErrSubscriptionAlreadyExists) {
-			return gen.PutSubscriptionsId400JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
+	var err error
+
+	if request.Body.StartDate != nil {
+		req.StartDate, err = parseMonthParam("start_date", gen.Body, *request.Body.StartDate)
+		if err != nil {
+			return nil, err
 		}
-		if errors.Is(err, usecase.ErrNotFound) {
-			return gen.PutSubscriptionsId404JSONResponse{Errors: pkg.PointerTo(err.Error())}, nil
+	}
+
+	if request.Body.EndDate != nil {
+		endDate, err := parseMonthParam("end_date", gen.Body, *request.Body.EndDate)
+		if err != nil {
+			return nil, err
 		}
+		req.EndDate = &endDate
+	}
 
-		return nil, err
+	sub, err := r.subUsecase.Pause(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("pause subscription: %w", err)
 	}
 
-	return gen.PutSubscriptionsId204Response{}, nil
+	return gen.PostSubscriptionsIdPause200JSONResponse(toSubscription(*sub)), nil
 }
 
-func requestErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
-	responseErr(w, err.Error(), http.StatusInternalServerError)
+func (r *Server) PostSubscriptionsIdResume(
+	ctx context.Context,
+	request gen.PostSubscriptionsIdResumeRequestObject,
+) (gen.PostSubscriptionsIdResumeResponseObject, error) {
+	now := time.Now()
+	req := entity.ResumeRequest{
+		ID:        request.Id.String(),
+		Month:     entity.MonthStart(now),
+		UpdatedAt: now.UnixMilli(),
+	}
+
+	if request.Body.ResumeDate != nil {
+		month, err := parseMonthParam("resume_date", gen.Body, *request.Body.ResumeDate)
+		if err != nil {
+			return nil, err
+		}
+		req.Month = month
+	}
+
+	sub, err := r.subUsecase.Resume(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("resume subscription: %w", err)
+	}
+
+	return gen.PostSubscriptionsIdResume200JSONResponse(toSubscription(*sub)), nil
 }
 
-//nolint:revive // ok.
-func responseErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
-	responseErr(w, err.Error(), http.StatusInternalServerError)
+func (r *Server) PostSubscriptionsIdCancel(
+	ctx context.Context,
+	request gen.PostSubscriptionsIdCancelRequestObject,
+) (gen.PostSubscriptionsIdCancelResponseObject, error) {
+	req := entity.CancelRequest{
+		ID:     request.Id.String(),
+		Reason: request.Body.Reason,
+		Now:    time.Now(),
+	}
+
+	if request.Body.Note != nil {
+		req.Note = *request.Body.Note
+	}
+
+	if request.Body.EffectiveDate != nil {
+		effective, err := parseMonthParam("effective_date", gen.Body, *request.Body.EffectiveDate)
+		if err != nil {
+			return nil, err
+		}
+		req.EffectiveDate = &effective
+	}
+
+	sub, err := r.subUsecase.Cancel(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("cancel subscription: %w", err)
+	}
+
+	return gen.PostSubscriptionsIdCancel200JSONResponse(toSubscription(*sub)), nil
 }
 
-func responseErr(w http.ResponseWriter, errStr string, status int) {
-	w.WriteHeader(status)
-	w.Header().Set("Content-Type", "application/json")
-	resp := gen.ErrorResponse{
-		Errors: &errStr,
+func (r *Server) GetAnalyticsCancellations(
+	ctx context.Context,
+	request gen.GetAnalyticsCancellationsRequestObject,
+) (gen.GetAnalyticsCancellationsResponseObject, error) {
+	filter := entity.CancellationFilter{Title: request.Params.ServiceName}
+	if request.Params.UserId != nil {
+		filter.UserID = pkg.PointerTo(request.Params.UserId.String())
 	}
 
-	jsonResp, err := json.Marshal(resp)
+	if request.Params.StartDate != nil {
+		from, err := parseMonthParam("start_date", gen.Query, *request.Params.StartDate)
+		if err != nil {
+			return nil, err
+		}
+		filter.From = &from
+	}
+
+	if request.Params.EndDate != nil {
+		to, err := parseMonthParam("end_date", gen.Query, *request.Params.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		if filter.From != nil && to.Before(*filter.From) {
+			return nil, invalidParam("end_date", gen.Query, "must not be before start_date")
+		}
+		filter.To = &to
+	}
+
+	counts, err := r.subUsecase.CountCancellations(ctx, filter)
 	if err != nil {
-		log.Fatalf("Error happened in JSON marshal. Err: %s", err)
+		return nil, fmt.Errorf("count cancellations: %w", err)
 	}
-	_, err = w.Write(jsonResp)
+
+	resp := gen.CancellationReport{Items: make([]gen.CancellationCount, len(counts))}
+	for i, c := range counts {
+		resp.Items[i] = gen.CancellationCount{
+			Month:       formatMonth(c.Month),
+			ServiceName: c.Title,
+			Reason:      c.Reason,
+			Count:       int(c.Count),
+		}
+		resp.Total += int(c.Count)
+	}
+
+	return gen.GetAnalyticsCancellations200JSONResponse(resp), nil
+}
+
+func (r *Server) GetSubscriptionsIdEvents(
+	ctx context.Context,
+	request gen.GetSubscriptionsIdEventsRequestObject,
+) (gen.GetSubscriptionsIdEventsResponseObject, error) {
+	events, err := r.subUsecase.Events(ctx, request.Id.String())
 	if err != nil {
-		log.Fatalf("Error happened in JSON marshal. Err: %s", err)
+		return nil, fmt.Errorf("list subscription events: %w", err)
+	}
+
+	resp := gen.SubscriptionEventList{Items: make([]gen.SubscriptionEvent, len(events))}
+	for i, e := range events {
+		resp.Items[i] = gen.SubscriptionEvent{
+			Id:        *pkg.UUID(e.ID),
+			Type:      gen.SubscriptionEventType(e.Type),
+			CreatedAt: time.UnixMilli(e.CreatedAt).UTC(),
+		}
+		if r := e.Renewal; r != nil {
+			resp.Items[i].Renewal = &gen.RenewalEvent{
+				PreviousEndDate: formatMonth(r.PreviousEndDate),
+				EndDate:         formatMonth(r.EndDate),
+				TermMonths:      r.TermMonths,
+			}
+		}
+		if a := e.BudgetAlert; a != nil {
+			resp.Items[i].BudgetAlert = &gen.BudgetAlertEvent{
+				BudgetId: *pkg.UUID(a.BudgetID),
+				Month:    formatMonth(a.Month),
+				Limit:    a.Limit,
+				Cost:     a.Cost,
+			}
+			if a.Category != "" {
+				resp.Items[i].BudgetAlert.Category = &a.Category
+			}
+		}
+	}
+
+	return gen.GetSubscriptionsIdEvents200JSONResponse(resp), nil
+}
+
+// RouterOption tunes the router built by Server.Router.
+type RouterOption func(*routerOptions)
+
+type routerOptions struct {
+	validateResponses bool
+	limiter           port.RateLimiter
+	rateLimit         config.RateLimitConfig
+	idempotencyStore  port.IdempotencyStore
+	idempotency       config.IdempotencyConfig
+}
+
+// WithResponseValidation makes the router check every response against the OpenAPI
+// contract. Intended for tests: drift from the spec is turned into a 500.
+func WithResponseValidation() RouterOption {
+	return func(o *routerOptions) {
+		o.validateResponses = true
 	}
 }
 
-func (r *Server) Start() {
+// WithRateLimit limits requests with the given backend. Without it requests are not
+// rate limited.
+func WithRateLimit(limiter port.RateLimiter, cfg config.RateLimitConfig) RouterOption {
+	return func(o *routerOptions) {
+		o.limiter = limiter
+		o.rateLimit = cfg
+	}
+}
+
+// WithIdempotency honors the Idempotency-Key header on POST /subscriptions, keeping
+// responses in the given store.
+func WithIdempotency(store port.IdempotencyStore, cfg config.IdempotencyConfig) RouterOption {
+	return func(o *routerOptions) {
+		o.idempotencyStore = store
+		o.idempotency = cfg
+	}
+}
+
+func (r *Server) Router(opts ...RouterOption) (http.Handler, error) {
+	var options routerOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	spec, err := loadSpec()
+	if err != nil {
+		return nil, err
+	}
+
 	srv := gen.NewStrictHandlerWithOptions(
 		r,
-		[]gen.StrictMiddlewareFunc{},
+		[]gen.StrictMiddlewareFunc{r.authorize},
 		gen.StrictHTTPServerOptions{
-			RequestErrorHandlerFunc:  requestErrorHandler,
-			ResponseErrorHandlerFunc: responseErrorHandler,
+			RequestErrorHandlerFunc:  r.requestErrorHandler,
+			ResponseErrorHandlerFunc: r.responseErrorHandler,
 		},
 	)
-	handler := gen.Handler(srv)
 
 	router := chi.NewRouter()
-	router.Mount("/", handler)
+	router.Use(requestID, accessLog(r.logger))
+
+	if options.validateResponses {
+		validator, err := r.responseValidator(spec)
+		if err != nil {
+			return nil, err
+		}
+		router.Use(validator)
+	}
+
+	if options.limiter != nil {
+		router.Use(r.rateLimitByClient(options.limiter, options.rateLimit))
+	}
+
+	router.Use(r.authenticate)
+
+	if options.limiter != nil {
+		router.Use(r.rateLimitByPrincipal(options.limiter, options.rateLimit))
+	}
+
+	if options.idempotencyStore != nil {
+		router.Use(r.idempotency(options.idempotencyStore, options.idempotency))
+	}
+
+	router.Use(r.requestValidator(spec))
+
+	return gen.HandlerWithOptions(srv, gen.ChiServerOptions{
+		BaseRouter:       router,
+		ErrorHandlerFunc: r.paramErrorHandler,
+	}), nil
+}
+
+func (r *Server) Start(opts ...RouterOption) {
+	handler, err := r.Router(opts...)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	s := http.Server{
-		Addr:              r.address,
-		Handler:           router,
-		ReadTimeout:       defaultReadTimeout,
-		ReadHeaderTimeout: defaultHeadReadTimeout,
-		WriteTimeout:      defaultWriteTimeout,
-		IdleTimeout:       defaultIdleTimeout,
+		Addr:              r.cfg.Address,
+		Handler:           handler,
+		ReadTimeout:       r.cfg.ReadTimeout,
+		ReadHeaderTimeout: r.cfg.ReadHeaderTimeout,
+		WriteTimeout:      r.cfg.WriteTimeout,
+		IdleTimeout:       r.cfg.IdleTimeout,
 	}
 
 	log.Fatal(s.ListenAndServe())
 }
+
+func toSubscription(s entity.Subscription) gen.Subscription {
+	var endDate *string
+	if s.EndDate != nil {
+		endDate = pkg.PointerTo(formatMonth(*s.EndDate))
+	}
+
+	var phases *[]gen.Phase
+	if len(s.Phases) > 0 {
+		list := make([]gen.Phase, len(s.Phases))
+		for i, p := range s.Phases {
+			list[i] = gen.Phase{StartDate: formatMonth(p.StartDate), EndDate: formatMonth(p.EndDate), Price: int(p.Price)}
+		}
+		phases = &list
+	}
+
+	var pauses *[]gen.Pause
+	if len(s.Pauses) > 0 {
+		list := make([]gen.Pause, len(s.Pauses))
+		for i, p := range s.Pauses {
+			list[i] = gen.Pause{StartDate: formatMonth(p.StartDate)}
+			if p.EndDate != nil {
+				list[i].EndDate = pkg.PointerTo(formatMonth(*p.EndDate))
+			}
+		}
+		pauses = &list
+	}
+
+	var renewal *gen.Renewal
+	if s.Renewal != nil {
+		renewal = &gen.Renewal{Policy: gen.Auto, TermMonths: pkg.PointerTo(s.Renewal.TermMonths)}
+	}
+
+	var cancellation *gen.Cancellation
+	if c := s.Cancellation; c != nil {
+		cancellation = &gen.Cancellation{
+			EffectiveDate: formatMonth(c.EffectiveDate),
+			Reason:        c.Reason,
+			Note:          c.Note,
+			CancelledAt:   time.UnixMilli(c.CancelledAt).UTC(),
+		}
+	}
+
+	var serviceID *uuid.UUID
+	if s.ServiceID != nil {
+		serviceID = pkg.UUID(*s.ServiceID)
+	}
+
+	return gen.Subscription{
+		Id:           pkg.UUID(s.ID),
+		ServiceName:  s.Title,
+		ServiceId:    serviceID,
+		Price:        int(s.Price),
+		UserId:       *pkg.UUID(s.UserID),
+		StartDate:    formatMonth(s.StartDate),
+		EndDate:      endDate,
+		Phases:       phases,
+		Renewal:      renewal,
+		Pauses:       pauses,
+		Status:       pkg.PointerTo(gen.SubscriptionStatus(s.StatusAt(time.Now()))),
+		Cancellation: cancellation,
+		CreatedAt:    pkg.PointerTo(time.UnixMilli(s.CreatedAt).UTC()),
+		UpdatedAt:    pkg.PointerTo(time.UnixMilli(s.UpdatedAt).UTC()),
+	}
+}
+
+// parseMonth parses the API "MM-YYYY" representation into the first day of that month.
+func parseMonth(month string) (time.Time, error) {
+	t, err := time.Parse(monthLayout, month)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid month %q, expected MM-YYYY", month)
+	}
+
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC), nil
+}
+
+// parsePhases converts the phases of a request body; their order and continuity are
+// checked by the use case.
+func parsePhases(phases *[]gen.Phase) ([]entity.Phase, error) {
+	if phases == nil || len(*phases) == 0 {
+		return nil, nil
+	}
+
+	parsed := make([]entity.Phase, len(*phases))
+	for i, p := range *phases {
+		start, err := parseMonthParam(fmt.Sprintf("phases[%d].start_date", i), gen.Body, p.StartDate)
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseMonthParam(fmt.Sprintf("phases[%d].end_date", i), gen.Body, p.EndDate)
+		if err != nil {
+			return nil, err
+		}
+
+		parsed[i] = entity.Phase{StartDate: start, EndDate: end, Price: int64(p.Price)}
+	}
+
+	return parsed, nil
+}
+
+// parseRenewal converts the renewal policy of a request body; without one the
+// subscription doesn't renew.
+func parseRenewal(renewal *gen.Renewal) (*entity.Renewal, error) {
+	if renewal == nil {
+		return nil, nil
+	}
+
+	switch renewal.Policy {
+	case gen.None:
+		if renewal.TermMonths != nil {
+			return nil, invalidParam("renewal.term_months", gen.Body, "must be omitted with policy none")
+		}
+		return nil, nil
+	case gen.Auto:
+		if renewal.TermMonths == nil {
+			return nil, invalidParam("renewal.term_months", gen.Body, "is required with policy auto")
+		}
+		return &entity.Renewal{TermMonths: *renewal.TermMonths}, nil
+	default:
+		return nil, invalidParam("renewal.policy", gen.Body, fmt.Sprintf("unknown policy %q", renewal.Policy))
+	}
+}
+
+func parseMonthParam(name string, in gen.InvalidParamIn, month string) (time.Time, error) {
+	t, err := parseMonth(month)
+	if err != nil {
+		return time.Time{}, invalidParam(name, in, err.Error())
+	}
+
+	return t, nil
+}
+
+func formatMonth(t time.Time) string {
+	return t.Format(monthLayout)
+}
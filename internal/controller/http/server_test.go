@@ -0,0 +1,512 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"subscription-service/internal/adapter/ratelimit"
+	repo "subscription-service/internal/adapter/repo/mock"
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/app/usecase"
+	"subscription-service/internal/config"
+	handler "subscription-service/internal/controller/http"
+	"subscription-service/internal/controller/http/gen"
+	"subscription-service/internal/port"
+)
+
+type stubUsecase struct {
+	usecase.SubscriptionUseCase
+
+	subs    []entity.Subscription
+	tenant  string
+	created int
+}
+
+func (s *stubUsecase) Create(_ context.Context, post entity.CreateSubscriptionRequest) (*entity.Subscription, error) {
+	s.created++
+	return &entity.Subscription{
+		ID:        uuid.NewString(),
+		Title:     post.Title,
+		Price:     post.Price,
+		UserID:    post.UserID,
+		StartDate: post.StartDate,
+	}, nil
+}
+
+func (s *stubUsecase) Read(context.Context, string) (*entity.Subscription, error) {
+	return nil, fmt.Errorf("read: %w", usecase.ErrNotFound)
+}
+
+func (s *stubUsecase) List(ctx context.Context, _ entity.ListSubscriptionFilter) ([]entity.Subscription, error) {
+	s.tenant, _ = entity.TenantFromContext(ctx)
+	return s.subs, nil
+}
+
+const (
+	userKey  = "sk_user"
+	adminKey = "sk_admin"
+
+	userTenant = "6b3f1c1e-4a53-4c59-a1f4-0f0e5d2b8a11"
+)
+
+type stubAuth struct {
+	usecase.AuthUseCase
+}
+
+func (s *stubAuth) AuthenticateAPIKey(_ context.Context, key string) (entity.Principal, error) {
+	switch key {
+	case userKey:
+		return entity.Principal{
+			Subject:  "api_key:user",
+			UserID:   uuid.NewString(),
+			TenantID: userTenant,
+			Roles:    []entity.Role{entity.RoleUser},
+			Method:   entity.AuthMethodAPIKey,
+		}, nil
+	case adminKey:
+		return entity.Principal{Subject: "api_key:admin", Roles: []entity.Role{entity.RoleAdmin}, Method: entity.AuthMethodAPIKey}, nil
+	default:
+		return entity.Principal{}, usecase.ErrUnauthenticated
+	}
+}
+
+func (s *stubAuth) AuthenticateToken(context.Context, string) (entity.Principal, error) {
+	return entity.Principal{}, usecase.ErrUnauthenticated
+}
+
+func (s *stubAuth) ListAPIKeys(context.Context) ([]entity.APIKey, error) {
+	return nil, nil
+}
+
+func (s *stubUsecase) Sum(context.Context, entity.ListSubscriptionFilter) (int64, error) {
+	return 0, nil
+}
+
+func newRouter(t *testing.T, uc usecase.SubscriptionUseCase, opts ...handler.RouterOption) http.Handler {
+	t.Helper()
+
+	opts = append(opts, handler.WithResponseValidation())
+
+	router, err := handler.NewServer(config.HTTPConfig{Address: ":0"}, uc, &stubAuth{}, nil, nil, nil, nil, nil, zap.NewNop()).Router(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return router
+}
+
+func TestRequestValidationListsEveryField(t *testing.T) {
+	router := newRouter(t, &stubUsecase{})
+
+	body := `{"service_name": "Yandex Plus", "price": -1, "start_date": "13-2025"}`
+	req := httptest.NewRequest(http.MethodPost, "/subscriptions", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-API-Key", userKey)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d: %s", rec.Code, rec.Body)
+	}
+
+	if ct := rec.Header().Get("Content-Type"); ct != "application/problem+json" {
+		t.Errorf("expected problem+json content type, got %q", ct)
+	}
+
+	var resp gen.Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.InvalidParams == nil {
+		t.Fatal("expected invalid params")
+	}
+
+	pointers := map[string]bool{}
+	for _, f := range *resp.InvalidParams {
+		if f.Pointer != nil {
+			pointers[*f.Pointer] = true
+		}
+	}
+
+	for _, want := range []string{"/price", "/start_date", "/user_id"} {
+		if !pointers[want] {
+			t.Errorf("expected violation for %s, got %+v", want, *resp.InvalidParams)
+		}
+	}
+}
+
+func TestListWithoutDateFilters(t *testing.T) {
+	end := time.Date(2025, time.December, 1, 0, 0, 0, 0, time.UTC)
+	router := newRouter(t, &stubUsecase{subs: []entity.Subscription{{
+		ID:        uuid.NewString(),
+		Title:     "Yandex Plus",
+		Price:     400,
+		UserID:    uuid.NewString(),
+		StartDate: time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   &end,
+		CreatedAt: time.Now().UnixMilli(),
+		UpdatedAt: time.Now().UnixMilli(),
+	}}})
+
+	req := httptest.NewRequest(http.MethodGet, "/subscriptions?service_name=Yandex%20Plus", nil)
+	req.Header.Set("X-API-Key", userKey)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+	}
+
+	var resp []gen.Subscription
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resp) != 1 || resp[0].StartDate != "07-2025" || *resp[0].EndDate != "12-2025" {
+		t.Errorf("unexpected response %s", rec.Body)
+	}
+}
+
+func TestResponseValidationDetectsDrift(t *testing.T) {
+	router := newRouter(t, &stubUsecase{subs: []entity.Subscription{{
+		ID:        uuid.NewString(),
+		Title:     "Yandex Plus",
+		Price:     -400,
+		UserID:    uuid.NewString(),
+		StartDate: time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC),
+	}}})
+
+	req := httptest.NewRequest(http.MethodGet, "/subscriptions", nil)
+	req.Header.Set("X-API-Key", userKey)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("expected drift to be reported with status 500, got %d: %s", rec.Code, rec.Body)
+	}
+}
+
+func TestErrorsAreProblemDetails(t *testing.T) {
+	router := newRouter(t, &stubUsecase{})
+
+	req := httptest.NewRequest(http.MethodGet, "/subscriptions/"+uuid.NewString(), nil)
+	req.Header.Set("X-Request-ID", "test-request")
+	req.Header.Set("X-API-Key", userKey)
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %d: %s", rec.Code, rec.Body)
+	}
+
+	var resp gen.Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Type != "/problems/not-found" || resp.Status != http.StatusNotFound {
+		t.Errorf("unexpected problem %+v", resp)
+	}
+	if resp.Detail == nil || *resp.Detail != usecase.ErrNotFound.Error() {
+		t.Errorf("expected the detail without the handler context, got %v", resp.Detail)
+	}
+	if resp.RequestId == nil || *resp.RequestId != "test-request" {
+		t.Errorf("expected request id to be echoed, got %v", resp.RequestId)
+	}
+	if resp.Instance == nil || *resp.Instance != req.URL.Path {
+		t.Errorf("expected instance %s, got %v", req.URL.Path, resp.Instance)
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	router := newRouter(t, &stubUsecase{})
+
+	tests := []struct {
+		name   string
+		target string
+		header string
+		value  string
+		status int
+	}{
+		{"missing credentials", "/subscriptions", "", "", http.StatusUnauthorized},
+		{"unknown api key", "/subscriptions", "X-API-Key", "sk_unknown", http.StatusUnauthorized},
+		{"unsupported scheme", "/subscriptions", "Authorization", "Basic dXNlcjpwYXNz", http.StatusUnauthorized},
+		{"invalid bearer token", "/subscriptions", "Authorization", "Bearer token", http.StatusUnauthorized},
+		{"user key", "/subscriptions", "X-API-Key", userKey, http.StatusOK},
+		{"user key on admin endpoint", "/admin/api-keys", "X-API-Key", userKey, http.StatusForbidden},
+		{"admin key on admin endpoint", "/admin/api-keys", "X-API-Key", adminKey, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.value)
+			}
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.status {
+				t.Fatalf("expected status %d, got %d: %s", tt.status, rec.Code, rec.Body)
+			}
+
+			if tt.status == http.StatusUnauthorized && rec.Header().Get("WWW-Authenticate") == "" {
+				t.Error("expected WWW-Authenticate challenge")
+			}
+		})
+	}
+}
+
+func TestTenantResolution(t *testing.T) {
+	otherTenant := uuid.NewString()
+
+	tests := []struct {
+		name       string
+		key        string
+		tenant     string
+		status     int
+		wantTenant string
+	}{
+		{"from user credentials", userKey, "", http.StatusOK, userTenant},
+		{"matching header", userKey, userTenant, http.StatusOK, userTenant},
+		{"user switching tenant", userKey, otherTenant, http.StatusForbidden, ""},
+		{"platform admin choosing tenant", adminKey, otherTenant, http.StatusOK, otherTenant},
+		{"platform admin without tenant", adminKey, "", http.StatusOK, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &stubUsecase{}
+			router := newRouter(t, uc)
+
+			req := httptest.NewRequest(http.MethodGet, "/subscriptions", nil)
+			req.Header.Set("X-API-Key", tt.key)
+			if tt.tenant != "" {
+				req.Header.Set("X-Tenant-ID", tt.tenant)
+			}
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.status {
+				t.Fatalf("expected status %d, got %d: %s", tt.status, rec.Code, rec.Body)
+			}
+			if uc.tenant != tt.wantTenant {
+				t.Errorf("expected tenant %q, got %q", tt.wantTenant, uc.tenant)
+			}
+		})
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	router := newRouter(t, &stubUsecase{}, handler.WithRateLimit(ratelimit.NewMemory(), config.RateLimitConfig{
+		PerPrincipal: config.RateLimit{Requests: 3, Per: time.Minute},
+		PerIP:        config.RateLimit{Requests: 100, Per: time.Minute},
+		Routes: map[string]config.RateLimit{
+			"GET /subscriptions/sum": {Requests: 1, Per: time.Minute},
+		},
+	}))
+
+	send := func(target string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		req.Header.Set("X-API-Key", userKey)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	sum := "/subscriptions/sum?start_date=01-2025&end_date=12-2025"
+
+	if rec := send(sum); rec.Header().Get("RateLimit-Remaining") != "0" {
+		t.Errorf("expected the route bucket to be reported, got remaining %q", rec.Header().Get("RateLimit-Remaining"))
+	}
+
+	if rec := send(sum); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected expensive route to be limited, got %d: %s", rec.Code, rec.Body)
+	}
+
+	if rec := send("/subscriptions"); rec.Code != http.StatusOK {
+		t.Fatalf("expected cheap route to be allowed, got %d: %s", rec.Code, rec.Body)
+	}
+
+	rec := send("/subscriptions")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected principal limit to be exhausted, got %d: %s", rec.Code, rec.Body)
+	}
+
+	if rec.Header().Get("Retry-After") != "20" {
+		t.Errorf("expected Retry-After 20, got %q", rec.Header().Get("Retry-After"))
+	}
+	if rec.Header().Get("RateLimit-Limit") != "3" || rec.Header().Get("RateLimit-Remaining") != "0" {
+		t.Errorf("unexpected RateLimit headers %v", rec.Header())
+	}
+}
+
+func TestRateLimitBeforeAuthentication(t *testing.T) {
+	router := newRouter(t, &stubUsecase{}, handler.WithRateLimit(ratelimit.NewMemory(), config.RateLimitConfig{
+		PerPrincipal: config.RateLimit{Requests: 100, Per: time.Minute},
+		PerIP:        config.RateLimit{Requests: 3, Per: time.Minute},
+	}))
+
+	send := func(key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/subscriptions", nil)
+		req.Header.Set("X-API-Key", key)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Guessed keys are charged to the client IP although they never authenticate.
+	for i := range 3 {
+		if rec := send(fmt.Sprintf("sk_guess_%d", i)); rec.Code != http.StatusUnauthorized {
+			t.Fatalf("guess %d: expected status 401, got %d: %s", i, rec.Code, rec.Body)
+		}
+	}
+
+	if rec := send("sk_guess_3"); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected guesses to be limited, got %d: %s", rec.Code, rec.Body)
+	}
+	if rec := send(userKey); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected the client to stay limited with a valid key, got %d: %s", rec.Code, rec.Body)
+	}
+}
+
+func TestIdempotency(t *testing.T) {
+	const (
+		key  = "7d5a1f0e-retry"
+		body = `{"service_name": "Yandex Plus", "price": 400,` +
+			` "user_id": "60601fee-2bf1-4721-ae6f-7636e79a0cba", "start_date": "07-2025"}`
+	)
+
+	stored := entity.IdempotentResponse{
+		StatusCode:  http.StatusCreated,
+		ContentType: "application/json",
+		Body: []byte(`{"id": "8c1f3c1e-4a53-4c59-a1f4-0f0e5d2b8a11", "service_name": "Yandex Plus",` +
+			` "price": 400, "user_id": "60601fee-2bf1-4721-ae6f-7636e79a0cba", "start_date": "07-2025",` +
+			` "status": "active", "created_at": "2025-07-15T10:30:00Z", "updated_at": "2025-07-15T10:30:00Z"}`),
+	}
+
+	tests := []struct {
+		name        string
+		setup       func(store *repo.MockIdempotencyStore)
+		status      int
+		wantCreated int
+		replayed    bool
+	}{
+		{
+			name: "first request is stored",
+			setup: func(store *repo.MockIdempotencyStore) {
+				var token string
+				store.EXPECT().
+					Acquire(gomock.Any(), userTenant+":api_key:user", key, gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _, leaseToken string, _ []byte, _ time.Duration) (
+						*entity.IdempotentResponse, error,
+					) {
+						token = leaseToken
+						return nil, nil
+					})
+				store.EXPECT().
+					Complete(gomock.Any(), gomock.Any(), key, gomock.Any(), gomock.Any(), 24*time.Hour).
+					DoAndReturn(func(
+						_ context.Context, _, _, leaseToken string, resp entity.IdempotentResponse, _ time.Duration,
+					) error {
+						if leaseToken == "" || leaseToken != token {
+							t.Errorf("completed with token %q, acquired with %q", leaseToken, token)
+						}
+						if resp.StatusCode != http.StatusCreated || len(resp.Body) == 0 {
+							t.Errorf("unexpected stored response %d %q", resp.StatusCode, resp.Body)
+						}
+						return nil
+					})
+			},
+			status:      http.StatusCreated,
+			wantCreated: 1,
+		},
+		{
+			name: "lease lost while running",
+			setup: func(store *repo.MockIdempotencyStore) {
+				store.EXPECT().
+					Acquire(gomock.Any(), gomock.Any(), key, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil)
+				store.EXPECT().
+					Complete(gomock.Any(), gomock.Any(), key, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(port.ErrIdempotencyLeaseLost)
+			},
+			status:      http.StatusCreated,
+			wantCreated: 1,
+		},
+		{
+			name: "retry is replayed",
+			setup: func(store *repo.MockIdempotencyStore) {
+				store.EXPECT().
+					Acquire(gomock.Any(), gomock.Any(), key, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&stored, nil)
+			},
+			status:   http.StatusCreated,
+			replayed: true,
+		},
+		{
+			name: "key reused with another body",
+			setup: func(store *repo.MockIdempotencyStore) {
+				store.EXPECT().
+					Acquire(gomock.Any(), gomock.Any(), key, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, port.ErrIdempotencyKeyReused)
+			},
+			status: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "duplicate while in flight",
+			setup: func(store *repo.MockIdempotencyStore) {
+				store.EXPECT().
+					Acquire(gomock.Any(), gomock.Any(), key, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, port.ErrIdempotencyInProgress)
+			},
+			status: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := repo.NewMockIdempotencyStore(ctrl)
+			tt.setup(store)
+
+			uc := &stubUsecase{}
+			router := newRouter(t, uc, handler.WithIdempotency(store, config.DefaultIdempotencyConfig()))
+
+			req := httptest.NewRequest(http.MethodPost, "/subscriptions", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-API-Key", userKey)
+			req.Header.Set("Idempotency-Key", key)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.status {
+				t.Fatalf("expected status %d, got %d: %s", tt.status, rec.Code, rec.Body)
+			}
+			if uc.created != tt.wantCreated {
+				t.Errorf("expected %d subscriptions created, got %d", tt.wantCreated, uc.created)
+			}
+			if replayed := rec.Header().Get("Idempotent-Replayed") == "true"; replayed != tt.replayed {
+				t.Errorf("expected replayed %v, got %v", tt.replayed, replayed)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/controller/http/gen"
+	pkg "subscription-service/internal/pkg/utils"
+)
+
+func (r *Server) GetServices(
+	ctx context.Context,
+	request gen.GetServicesRequestObject,
+) (gen.GetServicesResponseObject, error) {
+	services, err := r.catalogUsecase.List(ctx, entity.ServiceFilter{Category: request.Params.Category})
+	if err != nil {
+		return nil, fmt.Errorf("list services: %w", err)
+	}
+
+	resp := gen.ServiceList{Items: make([]gen.Service, len(services))}
+	for i, s := range services {
+		resp.Items[i] = toService(s)
+	}
+
+	return gen.GetServices200JSONResponse(resp), nil
+}
+
+func (r *Server) PostServices(
+	ctx context.Context,
+	request gen.PostServicesRequestObject,
+) (gen.PostServicesResponseObject, error) {
+	service := fromServiceRequest(*request.Body)
+	service.CreatedAt = time.Now().UnixMilli()
+	service.UpdatedAt = service.CreatedAt
+
+	created, err := r.catalogUsecase.Create(ctx, service)
+	if err != nil {
+		return nil, fmt.Errorf("create service: %w", err)
+	}
+
+	return gen.PostServices201JSONResponse(toService(*created)), nil
+}
+
+func (r *Server) GetServicesServiceId(
+	ctx context.Context,
+	request gen.GetServicesServiceIdRequestObject,
+) (gen.GetServicesServiceIdResponseObject, error) {
+	service, err := r.catalogUsecase.Read(ctx, request.ServiceId.String())
+	if err != nil {
+		return nil, fmt.Errorf("get service: %w", err)
+	}
+
+	return gen.GetServicesServiceId200JSONResponse(toService(*service)), nil
+}
+
+func (r *Server) PutServicesServiceId(
+	ctx context.Context,
+	request gen.PutServicesServiceIdRequestObject,
+) (gen.PutServicesServiceIdResponseObject, error) {
+	service := fromServiceRequest(*request.Body)
+	service.ID = request.ServiceId.String()
+	service.UpdatedAt = time.Now().UnixMilli()
+
+	updated, err := r.catalogUsecase.Update(ctx, service)
+	if err != nil {
+		return nil, fmt.Errorf("update service: %w", err)
+	}
+
+	return gen.PutServicesServiceId200JSONResponse(toService(*updated)), nil
+}
+
+func (r *Server) DeleteServicesServiceId(
+	ctx context.Context,
+	request gen.DeleteServicesServiceIdRequestObject,
+) (gen.DeleteServicesServiceIdResponseObject, error) {
+	if err := r.catalogUsecase.Delete(ctx, request.ServiceId.String()); err != nil {
+		return nil, fmt.Errorf("delete service: %w", err)
+	}
+
+	return gen.DeleteServicesServiceId204Response{}, nil
+}
+
+func fromServiceRequest(body gen.ServiceRequest) entity.Service {
+	service := entity.Service{Name: body.Name, DefaultPrice: body.DefaultPrice}
+	if body.Aliases != nil {
+		service.Aliases = *body.Aliases
+	}
+	if body.Category != nil {
+		service.Category = *body.Category
+	}
+	if body.VendorUrl != nil {
+		service.VendorURL = *body.VendorUrl
+	}
+	if body.Currency != nil {
+		service.Currency = *body.Currency
+	}
+
+	return service
+}
+
+func toService(s entity.Service) gen.Service {
+	resp := gen.Service{
+		Id:           *pkg.UUID(s.ID),
+		Name:         s.Name,
+		Aliases:      s.Aliases,
+		DefaultPrice: s.DefaultPrice,
+		Currency:     s.Currency,
+		CreatedAt:    time.UnixMilli(s.CreatedAt).UTC(),
+		UpdatedAt:    time.UnixMilli(s.UpdatedAt).UTC(),
+	}
+	if resp.Aliases == nil {
+		resp.Aliases = []string{}
+	}
+	if s.Category != "" {
+		resp.Category = &s.Category
+	}
+	if s.VendorURL != "" {
+		resp.VendorUrl = &s.VendorURL
+	}
+
+	return resp
+}
@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/go-chi/chi/v5/middleware"
+	nethttpmiddleware "github.com/oapi-codegen/nethttp-middleware"
+
+	"subscription-service/internal/controller/http/gen"
+	pkg "subscription-service/internal/pkg/utils"
+)
+
+func loadSpec() (*openapi3.T, error) {
+	spec, err := gen.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("load openapi spec: %w", err)
+	}
+
+	// The service may be reached by any host name, so servers are not part of the contract.
+	spec.Servers = nil
+
+	return spec, nil
+}
+
+// requestValidator rejects requests that violate api/openapi.yaml before they reach handlers.
+func (r *Server) requestValidator(spec *openapi3.T) func(http.Handler) http.Handler {
+	return nethttpmiddleware.OapiRequestValidatorWithOptions(spec, &nethttpmiddleware.Options{
+		Options: openapi3filter.Options{
+			MultiError:         true,
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		},
+		DoNotValidateServers: true,
+		ErrorHandlerWithOpts: func(
+			_ context.Context,
+			err error,
+			w http.ResponseWriter,
+			req *http.Request,
+			opts nethttpmiddleware.ErrorHandlerOpts,
+		) {
+			if opts.MatchedRoute == nil {
+				problem := problemNotFound
+				if errors.Is(err, routers.ErrMethodNotAllowed) {
+					problem = problemMethodNotAllowed
+				}
+				r.writeError(w, req, &problemError{problem: problem, detail: "no such operation", cause: err})
+				return
+			}
+
+			r.writeError(w, req, &problemError{
+				problem:       problemValidation,
+				detail:        "request does not match the API contract",
+				invalidParams: invalidParams(err),
+				cause:         err,
+			})
+		},
+	})
+}
+
+// invalidParams flattens kin-openapi validation errors into one entry per violated field.
+func invalidParams(err error) []gen.InvalidParam {
+	// MultiError implements As by matching its first element, so it is unwrapped
+	// with a type assertion to keep every element.
+	if multi, ok := err.(openapi3.MultiError); ok { //nolint:errorlint // see above.
+		var fields []gen.InvalidParam
+		for _, e := range multi {
+			fields = append(fields, invalidParams(e)...)
+		}
+		return fields
+	}
+
+	var reqErr *openapi3filter.RequestError
+	if !errors.As(err, &reqErr) {
+		return []gen.InvalidParam{{Name: "request", In: gen.Body, Reason: err.Error()}}
+	}
+
+	if reqErr.Parameter != nil {
+		return []gen.InvalidParam{{
+			Name:   reqErr.Parameter.Name,
+			In:     gen.InvalidParamIn(reqErr.Parameter.In),
+			Reason: parameterReason(reqErr),
+		}}
+	}
+
+	var schemaErrs []*openapi3.SchemaError
+	collectSchemaErrors(reqErr.Err, &schemaErrs)
+
+	if len(schemaErrs) == 0 {
+		reason := reqErr.Reason
+		if reqErr.Err != nil {
+			reason = reqErr.Err.Error()
+		}
+		return []gen.InvalidParam{{Name: "body", In: gen.Body, Pointer: pkg.PointerTo(""), Reason: reason}}
+	}
+
+	fields := make([]gen.InvalidParam, 0, len(schemaErrs))
+	for _, se := range schemaErrs {
+		path := se.JSONPointer()
+		name := "body"
+		if len(path) > 0 {
+			name = strings.Join(path, ".")
+		}
+		fields = append(fields, gen.InvalidParam{
+			Name:    name,
+			In:      gen.Body,
+			Pointer: pkg.PointerTo(jsonPointer(path)),
+			Reason:  se.Reason,
+		})
+	}
+
+	return fields
+}
+
+func collectSchemaErrors(err error, out *[]*openapi3.SchemaError) {
+	if multi, ok := err.(openapi3.MultiError); ok { //nolint:errorlint // see invalidParams.
+		for _, e := range multi {
+			collectSchemaErrors(e, out)
+		}
+		return
+	}
+
+	var se *openapi3.SchemaError
+	if errors.As(err, &se) {
+		*out = append(*out, se)
+	}
+}
+
+func parameterReason(reqErr *openapi3filter.RequestError) string {
+	var se *openapi3.SchemaError
+	if errors.As(reqErr.Err, &se) {
+		return se.Reason
+	}
+	if reqErr.Err != nil {
+		return reqErr.Err.Error()
+	}
+
+	return reqErr.Reason
+}
+
+// jsonPointer builds an RFC 6901 pointer from path segments.
+func jsonPointer(path []string) string {
+	var b strings.Builder
+	for _, p := range path {
+		b.WriteByte('/')
+		b.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(p))
+	}
+
+	return b.String()
+}
+
+// responseValidator buffers every response and checks it against the spec. It is meant for
+// tests: a response that drifts from the contract is replaced with a 500 describing the drift.
+func (r *Server) responseValidator(spec *openapi3.T) (func(http.Handler) http.Handler, error) {
+	router, err := gorillamux.NewRouter(spec)
+	if err != nil {
+		return nil, fmt.Errorf("build openapi router: %w", err)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			route, pathParams, err := router.FindRoute(req)
+			if err != nil {
+				next.ServeHTTP(w, req)
+				return
+			}
+
+			buf := new(bytes.Buffer)
+			ww := middleware.NewWrapResponseWriter(w, req.ProtoMajor)
+			ww.Tee(buf)
+			ww.Discard()
+
+			next.ServeHTTP(ww, req)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			err = validateResponse(req, route, pathParams, status, w.Header(), buf.Bytes())
+			if err != nil {
+				w.Header().Del("Content-Length")
+				r.writeError(w, req, &problemError{
+					problem: problemInternal,
+					detail:  "response does not match the API contract: " + err.Error(),
+					cause:   err,
+				})
+				return
+			}
+
+			w.WriteHeader(status)
+			_, _ = w.Write(buf.Bytes())
+		})
+	}, nil
+}
+
+func validateResponse(
+	r *http.Request,
+	route *routers.Route,
+	pathParams map[string]string,
+	status int,
+	header http.Header,
+	body []byte,
+) error {
+	return openapi3filter.ValidateResponse(r.Context(), &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: pathParams,
+			Route:      route,
+		},
+		Status: status,
+		Header: header,
+		Body:   io.NopCloser(bytes.NewReader(body)),
+		Options: &openapi3filter.Options{
+			IncludeResponseStatus: true,
+			MultiError:            true,
+		},
+	})
+}
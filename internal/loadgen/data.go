@@ -0,0 +1,222 @@
+package loadgen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"subscription-service/pkg/client"
+)
+
+// service is an entry of the catalog subscriptions are generated from. Popular services
+// come first and are picked more often, so users often hold several of them at once and
+// some creates overlap an existing subscription to the same service.
+type service struct {
+	name  string
+	price int
+}
+
+var catalog = []service{
+	{"Yandex Plus", 399},
+	{"Kinopoisk", 299},
+	{"Okko", 299},
+	{"Ivi", 399},
+	{"Kion", 249},
+	{"Wink", 349},
+	{"START", 299},
+	{"VK Музыка", 199},
+	{"Premier", 299},
+	{"Amediateka", 599},
+}
+
+// First month of the generated data and the number of months it spans.
+const (
+	firstYear = 2023
+	months    = 48
+)
+
+// generator produces the data of requests. It is used by one goroutine, the scheduler,
+// so that a seed reproduces the same sequence of requests.
+type generator struct {
+	rnd   *rand.Rand
+	users []uuid.UUID
+}
+
+func newGenerator(seed uint64, users int) *generator {
+	rnd := rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15))
+
+	g := &generator{rnd: rnd, users: make([]uuid.UUID, users)}
+	for i := range g.users {
+		// Derived from the seed rather than random, so that a rerun hits the same users.
+		var id uuid.UUID
+		for j := range id {
+			id[j] = byte(rnd.Uint32())
+		}
+		id[6] = id[6]&0x0f | 0x40
+		id[8] = id[8]&0x3f | 0x80
+		g.users[i] = id
+	}
+
+	return g
+}
+
+func (g *generator) user() uuid.UUID {
+	return g.users[g.rnd.IntN(len(g.users))]
+}
+
+// service picks from the catalog with weights falling off as 1/(rank+1).
+func (g *generator) service() service {
+	total := 0.0
+	for i := range catalog {
+		total += 1 / float64(i+1)
+	}
+
+	x := g.rnd.Float64() * total
+	for i, s := range catalog {
+		x -= 1 / float64(i+1)
+		if x < 0 {
+			return s
+		}
+	}
+
+	return catalog[len(catalog)-1]
+}
+
+// month returns a month of the generated span in the MM-YYYY format of the API.
+func month(index int) string {
+	return fmt.Sprintf("%02d-%d", index%12+1, firstYear+index/12)
+}
+
+func (g *generator) price(base int) int {
+	switch n := g.rnd.IntN(10); {
+	case n == 0:
+		return base / 2 // promo
+	case n == 1:
+		return base * 2 // family plan
+	default:
+		return base
+	}
+}
+
+func (g *generator) create() created {
+	s := g.service()
+	start := g.rnd.IntN(months)
+	price := g.price(s.price)
+
+	req := client.CreateSubscriptionRequest{
+		ServiceName: s.name,
+		Price:       &price,
+		UserId:      g.user(),
+		StartDate:   month(start),
+	}
+
+	// Most subscriptions renew until cancelled; the rest last from one month to two
+	// years.
+	if g.rnd.IntN(10) < 4 {
+		end := month(min(start+g.rnd.IntN(24), months-1))
+		req.EndDate = &end
+	}
+
+	return created{start: start, req: req}
+}
+
+func (g *generator) list() client.GetSubscriptionsParams {
+	limit := 50
+	params := client.GetSubscriptionsParams{Limit: &limit}
+
+	if g.rnd.IntN(10) < 6 {
+		user := g.user()
+		params.UserId = &user
+	}
+	if g.rnd.IntN(10) < 3 {
+		name := g.service().name
+		params.ServiceName = &name
+	}
+	if g.rnd.IntN(10) < 2 {
+		start := g.rnd.IntN(months)
+		from, to := month(start), month(min(start+12, months-1))
+		params.StartDate, params.EndDate = &from, &to
+	}
+
+	return params
+}
+
+func (g *generator) sum() client.GetSubscriptionsSumParams {
+	start := g.rnd.IntN(months)
+
+	params := client.GetSubscriptionsSumParams{
+		StartDate: month(start),
+		EndDate:   month(min(start+3+g.rnd.IntN(22), months-1)),
+	}
+
+	if g.rnd.IntN(10) < 5 {
+		user := g.user()
+		params.UserId = &user
+	}
+	if g.rnd.IntN(10) < 3 {
+		name := g.service().name
+		params.ServiceName = &name
+	}
+
+	return params
+}
+
+// update changes the tariff of a subscription and sometimes ends it.
+func (g *generator) update(sub created) client.UpdateSubscriptionRequest {
+	req := client.UpdateSubscriptionRequest{
+		ServiceName: sub.req.ServiceName,
+		Price:       g.price(*sub.req.Price),
+		StartDate:   sub.req.StartDate,
+		EndDate:     sub.req.EndDate,
+	}
+
+	if req.EndDate == nil && g.rnd.IntN(10) < 3 {
+		end := month(min(sub.start+1+g.rnd.IntN(12), months-1))
+		req.EndDate = &end
+	}
+
+	return req
+}
+
+// created is a subscription a create of the run made, a target for updates. start is
+// the index of its first month.
+type created struct {
+	id    uuid.UUID
+	start int
+	req   client.CreateSubscriptionRequest
+}
+
+// pool keeps the latest created subscriptions.
+type pool struct {
+	mu   sync.Mutex
+	subs []created
+	next int
+}
+
+const poolSize = 10000
+
+func (p *pool) add(sub created) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.subs) < poolSize {
+		p.subs = append(p.subs, sub)
+		return
+	}
+
+	p.subs[p.next] = sub
+	p.next = (p.next + 1) % poolSize
+}
+
+func (p *pool) pick(rnd *rand.Rand) (created, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.subs) == 0 {
+		return created{}, false
+	}
+
+	return p.subs[rnd.IntN(len(p.subs))], true
+}
@@ -0,0 +1,239 @@
+// Package loadgen implements the loadgen command, which drives the subscription API with
+// a mix of operations at a target rate and reports latencies, errors and throughput.
+package loadgen
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+
+	"subscription-service/pkg/client"
+)
+
+// Exit codes.
+const (
+	ExitOK      = 0
+	ExitFailure = 1
+	ExitUsage   = 2
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// Options are the process environment of a run.
+type Options struct {
+	Stdout    io.Writer
+	Stderr    io.Writer
+	LookupEnv func(string) (string, bool)
+	// HTTPClient is used for API calls; a client with a transport sized for the
+	// concurrency when nil.
+	HTTPClient client.HttpRequestDoer
+}
+
+// Config is the load to generate.
+type Config struct {
+	Server string
+	APIKey string
+	Token  string
+	Tenant string
+
+	// RPS is the target rate of requests per second. Requests are started on schedule
+	// regardless of how long the previous ones take; when all workers are busy and as
+	// many requests wait for them, the request is dropped and counted.
+	RPS         float64
+	Concurrency int
+	// Duration bounds the run; Requests, when positive, stops it earlier.
+	Duration time.Duration
+	Requests int
+	Timeout  time.Duration
+	Mix      Mix
+	// Users is the number of distinct users the generated subscriptions belong to.
+	Users int
+	Seed  uint64
+
+	Output     string
+	ReportFile string
+}
+
+// Run executes loadgen with args, which exclude the program name, and returns the exit
+// code.
+func Run(ctx context.Context, args []string, opts Options) int {
+	cfg, err := parseConfig(args, opts)
+	if errors.Is(err, flag.ErrHelp) {
+		return ExitOK
+	}
+	if err != nil {
+		fmt.Fprintln(opts.Stderr, "error:", err)
+		return ExitUsage
+	}
+
+	api, err := newClient(cfg, opts)
+	if err != nil {
+		fmt.Fprintln(opts.Stderr, "error:", err)
+		return ExitFailure
+	}
+
+	report := newRunner(cfg, api).run(ctx)
+
+	if err := writeReport(opts.Stdout, cfg.Output, report); err != nil {
+		fmt.Fprintln(opts.Stderr, "error:", err)
+		return ExitFailure
+	}
+
+	if cfg.ReportFile != "" {
+		if err := saveReport(cfg.ReportFile, report); err != nil {
+			fmt.Fprintln(opts.Stderr, "error:", err)
+			return ExitFailure
+		}
+	}
+
+	return ExitOK
+}
+
+func parseConfig(args []string, opts Options) (Config, error) {
+	cfg := Config{}
+	mix := defaultMix
+
+	fs := flag.NewFlagSet("loadgen", flag.ContinueOnError)
+	fs.SetOutput(opts.Stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: loadgen [flags]")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	fs.StringVar(&cfg.Server, "server", "", "API base URL (env LOADGEN_SERVER, default http://localhost:8080)")
+	fs.StringVar(&cfg.APIKey, "api-key", "", "API key (env LOADGEN_API_KEY)")
+	fs.StringVar(&cfg.Token, "token", "", "bearer token (env LOADGEN_TOKEN)")
+	fs.StringVar(&cfg.Tenant, "tenant", "", "tenant to act within, for platform admins (env LOADGEN_TENANT)")
+	fs.Float64Var(&cfg.RPS, "rps", 50, "target requests per second")
+	fs.IntVar(&cfg.Concurrency, "concurrency", 16, "maximum number of requests in flight")
+	fs.DurationVar(&cfg.Duration, "duration", 30*time.Second, "how long to generate load")
+	fs.IntVar(&cfg.Requests, "requests", 0, "stop after this many requests, 0 for no limit")
+	fs.DurationVar(&cfg.Timeout, "timeout", 10*time.Second, "timeout of a single request")
+	fs.Var(&mix, "mix", "weights of the operations, e.g. create=4,list=3,sum=2,update=1")
+	fs.IntVar(&cfg.Users, "users", 1000, "number of distinct users in the generated data")
+	fs.Uint64Var(&cfg.Seed, "seed", 0, "seed of the generated data, 0 for a random one")
+	fs.StringVar(&cfg.Output, "o", outputText, "report format: text or json")
+	fs.StringVar(&cfg.ReportFile, "report", "", "also write the JSON report to this file")
+
+	if err := fs.Parse(args); err != nil {
+		return Config{}, err
+	}
+	if fs.NArg() > 0 {
+		return Config{}, fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+
+	env := func(key string) string {
+		if opts.LookupEnv == nil {
+			return ""
+		}
+		v, _ := opts.LookupEnv(key)
+		return v
+	}
+
+	cfg.Server = first(cfg.Server, env("LOADGEN_SERVER"), "http://localhost:8080")
+	cfg.APIKey = first(cfg.APIKey, env("LOADGEN_API_KEY"))
+	cfg.Token = first(cfg.Token, env("LOADGEN_TOKEN"))
+	cfg.Tenant = first(cfg.Tenant, env("LOADGEN_TENANT"))
+	cfg.Mix = mix
+
+	return cfg, cfg.validate()
+}
+
+func (c Config) validate() error {
+	var errs []error
+
+	if c.RPS <= 0 {
+		errs = append(errs, errors.New("rps must be positive"))
+	}
+	if c.Concurrency <= 0 {
+		errs = append(errs, errors.New("concurrency must be positive"))
+	}
+	if c.Duration <= 0 {
+		errs = append(errs, errors.New("duration must be positive"))
+	}
+	if c.Requests < 0 {
+		errs = append(errs, errors.New("requests must not be negative"))
+	}
+	if c.Timeout <= 0 {
+		errs = append(errs, errors.New("timeout must be positive"))
+	}
+	if c.Users <= 0 {
+		errs = append(errs, errors.New("users must be positive"))
+	}
+	if c.Mix.total() == 0 {
+		errs = append(errs, errors.New("mix must give some operation a weight"))
+	}
+	if c.Output != outputText && c.Output != outputJSON {
+		errs = append(errs, fmt.Errorf("unknown report format %q, expected text or json", c.Output))
+	}
+	if c.Tenant != "" {
+		if _, err := uuid.Parse(c.Tenant); err != nil {
+			errs = append(errs, fmt.Errorf("tenant must be a uuid: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func newClient(cfg Config, opts Options) (*client.Client, error) {
+	doer := opts.HTTPClient
+	if doer == nil {
+		// The default transport keeps only two idle connections per host, so most
+		// requests of a concurrent run would pay for a new connection.
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.MaxIdleConnsPerHost = cfg.Concurrency
+		doer = &http.Client{Transport: transport}
+	}
+
+	authenticate := func(_ context.Context, req *http.Request) error {
+		switch {
+		case cfg.APIKey != "":
+			req.Header.Set("X-API-Key", cfg.APIKey)
+		case cfg.Token != "":
+			req.Header.Set("Authorization", "Bearer "+cfg.Token)
+		}
+
+		if cfg.Tenant != "" {
+			req.Header.Set("X-Tenant-ID", cfg.Tenant)
+		}
+
+		return nil
+	}
+
+	return client.NewClient(cfg.Server, client.WithHTTPClient(doer), client.WithRequestEditorFn(authenticate))
+}
+
+func saveReport(path string, report Report) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := writeReport(f, outputJSON, report); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func first(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,127 @@
+package loadgen_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"subscription-service/internal/loadgen"
+	"subscription-service/pkg/sdk/sdktest"
+)
+
+func run(t *testing.T, args ...string) (int, string, string) {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	code := loadgen.Run(context.Background(), args, loadgen.Options{
+		Stdout:    &stdout,
+		Stderr:    &stderr,
+		LookupEnv: func(string) (string, bool) { return "", false },
+	})
+
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRunReport(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+
+	code, stdout, stderr := run(t,
+		"--server", srv.URL, "--api-key", sdktest.APIKey,
+		"--rps", "1000", "--concurrency", "4", "--requests", "200", "--duration", "10s",
+		"--users", "5", "--seed", "42", "-o", "json",
+	)
+	if code != loadgen.ExitOK {
+		t.Fatalf("exit code = %d, stderr: %s", code, stderr)
+	}
+
+	var report loadgen.Report
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("report is not JSON: %v\n%s", err, stdout)
+	}
+
+	if report.Seed != 42 || report.Concurrency != 4 || report.TargetRPS != 1000 {
+		t.Errorf("settings in the report = %+v", report)
+	}
+
+	if got := report.Total.Requests + report.Total.Dropped; got != 200 {
+		t.Errorf("completed + dropped = %d, want 200", got)
+	}
+	if report.Total.Requests != srv.Calls() {
+		t.Errorf("completed requests = %d, server got %d", report.Total.Requests, srv.Calls())
+	}
+
+	names := make([]string, 0, len(report.Operations))
+	requests, errors := 0, 0
+	for _, op := range report.Operations {
+		names = append(names, op.Operation)
+		requests += op.Requests
+		errors += op.Errors
+
+		for class := range op.ErrorBreakdown {
+			// Five users share the popular services, so some creates and updates overlap
+			// an existing subscription; nothing else may fail.
+			if class != "409 Conflict" {
+				t.Errorf("%s: unexpected error %q", op.Operation, class)
+			}
+		}
+		if op.Requests > 0 && (op.Latency.P50 <= 0 || op.Latency.P50 > op.Latency.P99 || op.Latency.P99 > op.Latency.Max) {
+			t.Errorf("%s: inconsistent latencies %+v", op.Operation, op.Latency)
+		}
+	}
+
+	if got := strings.Join(names, ","); got != "create,list,sum,update" {
+		t.Errorf("operations = %s", got)
+	}
+	if requests != report.Total.Requests || errors != report.Total.Errors {
+		t.Errorf("total = %d requests, %d errors; operations add up to %d, %d",
+			report.Total.Requests, report.Total.Errors, requests, errors)
+	}
+	if len(srv.Subscriptions()) == 0 {
+		t.Error("no subscription was created")
+	}
+}
+
+func TestRunMix(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+
+	code, stdout, stderr := run(t,
+		"--server", srv.URL, "--rps", "1000", "--requests", "20", "--mix", "sum=1",
+	)
+	if code != loadgen.ExitOK {
+		t.Fatalf("exit code = %d, stderr: %s", code, stderr)
+	}
+
+	for _, want := range []string{"OPERATION", "sum", "total"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("text report has no %q:\n%s", want, stdout)
+		}
+	}
+	for _, unwanted := range []string{"create", "list", "update"} {
+		if strings.Contains(stdout, unwanted) {
+			t.Errorf("text report mentions %q, which the mix leaves out:\n%s", unwanted, stdout)
+		}
+	}
+	if len(srv.Subscriptions()) != 0 {
+		t.Errorf("a sum-only mix created %d subscriptions", len(srv.Subscriptions()))
+	}
+}
+
+func TestRunUsage(t *testing.T) {
+	for _, args := range [][]string{
+		{"--mix", "create=x"},
+		{"--mix", "delete=1"},
+		{"--mix", "create=0"},
+		{"--rps", "0"},
+		{"--tenant", "acme"},
+		{"-o", "yaml"},
+		{"extra"},
+	} {
+		if code, _, stderr := run(t, args...); code != loadgen.ExitUsage || stderr == "" {
+			t.Errorf("%v: exit code = %d, stderr %q; want usage error", args, code, stderr)
+		}
+	}
+}
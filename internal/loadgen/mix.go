@@ -0,0 +1,99 @@
+package loadgen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Operation is a kind of request loadgen sends.
+type Operation int
+
+const (
+	OpCreate Operation = iota
+	OpList
+	OpSum
+	OpUpdate
+
+	numOperations
+)
+
+var operationNames = [numOperations]string{"create", "list", "sum", "update"}
+
+func (o Operation) String() string {
+	return operationNames[o]
+}
+
+// Mix holds the relative weights of the operations, indexed by Operation.
+type Mix [numOperations]int
+
+var defaultMix = Mix{OpCreate: 4, OpList: 3, OpSum: 2, OpUpdate: 1}
+
+// String formats the mix as name=weight pairs, the syntax Set accepts.
+func (m *Mix) String() string {
+	parts := make([]string, 0, numOperations)
+	for op, weight := range m {
+		parts = append(parts, fmt.Sprintf("%s=%d", Operation(op), weight))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// Set parses comma-separated name=weight pairs. Operations left out get no weight.
+func (m *Mix) Set(value string) error {
+	var mix Mix
+
+	for _, part := range strings.Split(value, ",") {
+		name, raw, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return fmt.Errorf("%q is not name=weight", part)
+		}
+
+		op, ok := parseOperation(name)
+		if !ok {
+			return fmt.Errorf("unknown operation %q, expected one of %s", name, strings.Join(operationNames[:], ", "))
+		}
+
+		weight, err := strconv.Atoi(raw)
+		if err != nil || weight < 0 {
+			return fmt.Errorf("weight of %s must be a non-negative integer", name)
+		}
+
+		mix[op] = weight
+	}
+
+	*m = mix
+
+	return nil
+}
+
+func (m *Mix) total() int {
+	total := 0
+	for _, weight := range m {
+		total += weight
+	}
+
+	return total
+}
+
+// pick maps n in [0, total) to an operation in proportion to the weights.
+func (m *Mix) pick(n int) Operation {
+	for op, weight := range m {
+		if n < weight {
+			return Operation(op)
+		}
+		n -= weight
+	}
+
+	return numOperations - 1
+}
+
+func parseOperation(name string) (Operation, bool) {
+	for op, n := range operationNames {
+		if n == name {
+			return Operation(op), true
+		}
+	}
+
+	return 0, false
+}
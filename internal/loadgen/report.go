@@ -0,0 +1,225 @@
+package loadgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// Report is the outcome of a run.
+type Report struct {
+	Seed            uint64  `json:"seed"`
+	TargetRPS       float64 `json:"target_rps"`
+	Concurrency     int     `json:"concurrency"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	// Total sums up the operations.
+	Total      OperationReport   `json:"total"`
+	Operations []OperationReport `json:"operations"`
+}
+
+// OperationReport describes the requests of one operation.
+type OperationReport struct {
+	Operation string `json:"operation"`
+	// Requests counts the completed requests, failed ones included.
+	Requests int `json:"requests"`
+	Errors   int `json:"errors"`
+	// Dropped counts the requests not sent because every worker was busy and the queue
+	// in front of them was full.
+	Dropped int `json:"dropped"`
+	// Throughput is the rate of completed requests per second.
+	Throughput float64 `json:"throughput_rps"`
+	Latency    Latency `json:"latency_ms"`
+	// ErrorBreakdown counts the errors by class: the HTTP status, "timeout" or
+	// "connection error".
+	ErrorBreakdown map[string]int `json:"error_breakdown,omitempty"`
+}
+
+// Latency holds latencies of completed requests in milliseconds. They are measured from
+// the time a request was due, so a request waiting for a free worker is not flattered.
+type Latency struct {
+	Min  float64 `json:"min"`
+	Mean float64 `json:"mean"`
+	P50  float64 `json:"p50"`
+	P90  float64 `json:"p90"`
+	P95  float64 `json:"p95"`
+	P99  float64 `json:"p99"`
+	Max  float64 `json:"max"`
+}
+
+// stats collects the outcomes of requests from the workers.
+type stats struct {
+	mu  sync.Mutex
+	ops [numOperations]opStats
+}
+
+type opStats struct {
+	latencies []time.Duration
+	errors    map[string]int
+	dropped   int
+}
+
+func newStats() *stats {
+	s := &stats{}
+	for op := range s.ops {
+		s.ops[op].errors = make(map[string]int)
+	}
+
+	return s
+}
+
+func (s *stats) record(op Operation, latency time.Duration, failure string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ops[op].latencies = append(s.ops[op].latencies, latency)
+	if failure != "" {
+		s.ops[op].errors[failure]++
+	}
+}
+
+func (s *stats) drop(op Operation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ops[op].dropped++
+}
+
+func (s *stats) report(cfg Config, elapsed time.Duration) Report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	report := Report{
+		Seed:            cfg.Seed,
+		TargetRPS:       cfg.RPS,
+		Concurrency:     cfg.Concurrency,
+		DurationSeconds: elapsed.Seconds(),
+	}
+
+	total := opStats{errors: make(map[string]int)}
+
+	for op, st := range s.ops {
+		if cfg.Mix[op] == 0 && len(st.latencies) == 0 && st.dropped == 0 {
+			continue
+		}
+
+		report.Operations = append(report.Operations, st.summarize(Operation(op).String(), elapsed))
+
+		total.latencies = append(total.latencies, st.latencies...)
+		total.dropped += st.dropped
+		for class, n := range st.errors {
+			total.errors[class] += n
+		}
+	}
+
+	report.Total = total.summarize("total", elapsed)
+
+	return report
+}
+
+func (st opStats) summarize(name string, elapsed time.Duration) OperationReport {
+	r := OperationReport{
+		Operation: name,
+		Requests:  len(st.latencies),
+		Dropped:   st.dropped,
+		Latency:   summarizeLatencies(st.latencies),
+	}
+
+	if elapsed > 0 {
+		r.Throughput = float64(r.Requests) / elapsed.Seconds()
+	}
+
+	if len(st.errors) > 0 {
+		r.ErrorBreakdown = make(map[string]int, len(st.errors))
+		for class, n := range st.errors {
+			r.ErrorBreakdown[class] = n
+			r.Errors += n
+		}
+	}
+
+	return r
+}
+
+func summarizeLatencies(latencies []time.Duration) Latency {
+	if len(latencies) == 0 {
+		return Latency{}
+	}
+
+	sorted := slices.Clone(latencies)
+	slices.Sort(sorted)
+
+	var sum time.Duration
+	for _, l := range sorted {
+		sum += l
+	}
+
+	return Latency{
+		Min:  ms(sorted[0]),
+		Mean: ms(sum / time.Duration(len(sorted))),
+		P50:  ms(percentile(sorted, 50)),
+		P90:  ms(percentile(sorted, 90)),
+		P95:  ms(percentile(sorted, 95)),
+		P99:  ms(percentile(sorted, 99)),
+		Max:  ms(sorted[len(sorted)-1]),
+	}
+}
+
+// percentile returns the nearest-rank percentile p of sorted, which is not empty.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+
+	return sorted[max(rank, 1)-1]
+}
+
+func ms(d time.Duration) float64 {
+	return math.Round(float64(d)/float64(time.Millisecond)*1000) / 1000
+}
+
+func writeReport(w io.Writer, format string, r Report) error {
+	if format == outputJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(r)
+	}
+
+	fmt.Fprintf(w, "target %g rps, concurrency %d, seed %d, ran %.1fs\n\n",
+		r.TargetRPS, r.Concurrency, r.Seed, r.DurationSeconds)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "OPERATION\tREQUESTS\tERRORS\tDROPPED\tRPS\tP50 ms\tP90 ms\tP95 ms\tP99 ms\tMAX ms\t")
+	for _, op := range append(r.Operations, r.Total) {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%.1f\t%.2f\t%.2f\t%.2f\t%.2f\t%.2f\t\n",
+			op.Operation, op.Requests, op.Errors, op.Dropped, op.Throughput,
+			op.Latency.P50, op.Latency.P90, op.Latency.P95, op.Latency.P99, op.Latency.Max)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if r.Total.Errors == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "errors:")
+
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, op := range r.Operations {
+		classes := make([]string, 0, len(op.ErrorBreakdown))
+		for class := range op.ErrorBreakdown {
+			classes = append(classes, class)
+		}
+		sort.Strings(classes)
+
+		for _, class := range classes {
+			fmt.Fprintf(tw, "  %s\t%s\t%d\n", op.Operation, class, op.ErrorBreakdown[class])
+		}
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,174 @@
+package loadgen
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"subscription-service/pkg/client"
+)
+
+// job is a request ready to send. do returns the class of the error, or "" on success.
+type job struct {
+	op        Operation
+	scheduled time.Time
+	do        func(ctx context.Context) string
+}
+
+type runner struct {
+	cfg   Config
+	api   *client.Client
+	gen   *generator
+	subs  pool
+	stats *stats
+}
+
+func newRunner(cfg Config, api *client.Client) *runner {
+	if cfg.Seed == 0 {
+		cfg.Seed = uint64(time.Now().UnixNano())
+	}
+
+	return &runner{
+		cfg:   cfg,
+		api:   api,
+		gen:   newGenerator(cfg.Seed, cfg.Users),
+		stats: newStats(),
+	}
+}
+
+// run schedules requests at the target rate until the duration or the number of
+// requests is reached or ctx is done, then waits for the requests in flight.
+func (r *runner) run(ctx context.Context) Report {
+	schedule, cancel := context.WithTimeout(ctx, r.cfg.Duration)
+	defer cancel()
+
+	// The buffer absorbs workers being momentarily between two requests; latencies are
+	// measured from the scheduled time, so waiting in it still counts.
+	jobs := make(chan job, r.cfg.Concurrency)
+
+	var wg sync.WaitGroup
+	for range r.cfg.Concurrency {
+		wg.Go(func() {
+			for j := range jobs {
+				r.execute(ctx, j)
+			}
+		})
+	}
+
+	interval := time.Duration(float64(time.Second) / r.cfg.RPS)
+	timer := time.NewTimer(time.Hour)
+	defer timer.Stop()
+
+	start := time.Now()
+
+loop:
+	for i := 0; r.cfg.Requests == 0 || i < r.cfg.Requests; i++ {
+		if wait := time.Until(start.Add(time.Duration(i) * interval)); wait > 0 {
+			timer.Reset(wait)
+			select {
+			case <-schedule.Done():
+				break loop
+			case <-timer.C:
+			}
+		} else if schedule.Err() != nil {
+			break
+		}
+
+		j := r.next()
+		j.scheduled = time.Now()
+
+		select {
+		case jobs <- j:
+		default:
+			// Every worker is busy and as many requests wait: the service is not keeping
+			// up with the rate.
+			r.stats.drop(j.op)
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	return r.stats.report(r.cfg, time.Since(start))
+}
+
+func (r *runner) execute(ctx context.Context, j job) {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.Timeout)
+	defer cancel()
+
+	failure := j.do(ctx)
+	r.stats.record(j.op, time.Since(j.scheduled), failure)
+}
+
+// next generates the request of the next operation of the mix. Updates fall back to
+// creates until some subscription was created.
+func (r *runner) next() job {
+	op := r.cfg.Mix.pick(r.gen.rnd.IntN(r.cfg.Mix.total()))
+
+	switch op {
+	case OpList:
+		params := r.gen.list()
+		return job{op: op, do: func(ctx context.Context) string {
+			return check(r.api.GetSubscriptions(ctx, &params))
+		}}
+	case OpSum:
+		params := r.gen.sum()
+		return job{op: op, do: func(ctx context.Context) string {
+			return check(r.api.GetSubscriptionsSum(ctx, &params))
+		}}
+	case OpUpdate:
+		if sub, ok := r.subs.pick(r.gen.rnd); ok {
+			body := r.gen.update(sub)
+			return job{op: op, do: func(ctx context.Context) string {
+				return check(r.api.PutSubscriptionsId(ctx, sub.id, &client.PutSubscriptionsIdParams{}, body))
+			}}
+		}
+	}
+
+	sub := r.gen.create()
+
+	return job{op: OpCreate, do: func(ctx context.Context) string {
+		resp, err := r.api.PostSubscriptions(ctx, &client.PostSubscriptionsParams{}, sub.req)
+		if err != nil || resp.StatusCode != http.StatusCreated {
+			return check(resp, err)
+		}
+		defer resp.Body.Close()
+
+		var body client.Subscription
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil || body.Id == nil {
+			return "invalid response"
+		}
+
+		sub.id = *body.Id
+		r.subs.add(sub)
+
+		return ""
+	}}
+}
+
+// check classifies the outcome of a request and releases the response.
+func check(resp *http.Response, err error) string {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case err != nil:
+		return "connection error"
+	}
+
+	// Reading the body to the end lets the connection be reused.
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+
+	return ""
+}
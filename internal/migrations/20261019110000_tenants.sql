@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Rows that existed before multi-tenancy belong to the default tenant.
+ALTER TABLE subscriptions
+    ADD COLUMN tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000';
+
+ALTER TABLE subscriptions ALTER COLUMN tenant_id DROP DEFAULT;
+
+ALTER TABLE subscriptions DROP CONSTRAINT subscriptions_no_overlap;
+
+ALTER TABLE subscriptions
+    ADD CONSTRAINT subscriptions_no_overlap
+    EXCLUDE USING gist (
+        tenant_id WITH =,
+        user_id WITH =,
+        title WITH =,
+        daterange(start_date, COALESCE(end_date, 'infinity'::date), '[]') WITH &&
+    );
+
+CREATE INDEX IF NOT EXISTS subscriptions_tenant_user_idx ON subscriptions (tenant_id, user_id);
+
+-- The tenant is set per transaction with set_config('app.tenant_id', ..., true).
+-- Without it no rows are visible. Superusers and roles with BYPASSRLS are not affected.
+ALTER TABLE subscriptions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE subscriptions FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY subscriptions_tenant_isolation ON subscriptions
+    USING (tenant_id = NULLIF(current_setting('app.tenant_id', true), '')::uuid)
+    WITH CHECK (tenant_id = NULLIF(current_setting('app.tenant_id', true), '')::uuid);
+
+ALTER TABLE api_keys ADD COLUMN tenant_id UUID;
+
+UPDATE api_keys SET tenant_id = '00000000-0000-0000-0000-000000000000' WHERE role = 'user';
+
+CREATE INDEX IF NOT EXISTS api_keys_tenant_idx ON api_keys (tenant_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- Irreversible: without tenant_id the subscriptions of all tenants fall under one
+-- subscriptions_no_overlap constraint, which the same user and title in two tenants
+-- break, and the tenants of subscriptions and API keys can't be told apart any more.
+-- Restore a backup taken before the migration instead.
+-- +goose StatementBegin
+DO $$
+BEGIN
+    RAISE EXCEPTION 'migration 20261019110000_tenants is irreversible, restore a backup taken before it';
+END
+$$;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Phases are an ordered array of {"start_date": "YYYY-MM-DD", "end_date": "YYYY-MM-DD",
+-- "price": N}; the regular price applies after the last one.
+ALTER TABLE subscriptions ADD COLUMN phases JSONB NOT NULL DEFAULT '[]';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS phases;
+-- +goose StatementEnd
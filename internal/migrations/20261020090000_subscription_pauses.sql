@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Pauses are an ordered array of {"start_date": "YYYY-MM-DD", "end_date": "YYYY-MM-DD"},
+-- where a null end_date is a pause lasting until the subscription is resumed.
+ALTER TABLE subscriptions ADD COLUMN pauses JSONB NOT NULL DEFAULT '[]';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS pauses;
+-- +goose StatementEnd
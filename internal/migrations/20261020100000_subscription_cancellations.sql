@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A cancelled subscription has a reason; cancel_effective_date is its end date at the
+-- time of the cancellation, kept apart so that later edits don't move it.
+ALTER TABLE subscriptions
+    ADD COLUMN cancel_reason TEXT,
+    ADD COLUMN cancel_note TEXT NOT NULL DEFAULT '',
+    ADD COLUMN cancel_effective_date DATE,
+    ADD COLUMN cancelled_at BIGINT;
+
+CREATE INDEX IF NOT EXISTS subscriptions_cancellations_idx
+    ON subscriptions (tenant_id, cancel_effective_date) WHERE cancel_reason IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS subscriptions_cancellations_idx;
+
+ALTER TABLE subscriptions
+    DROP COLUMN IF EXISTS cancel_reason,
+    DROP COLUMN IF EXISTS cancel_note,
+    DROP COLUMN IF EXISTS cancel_effective_date,
+    DROP COLUMN IF EXISTS cancelled_at;
+-- +goose StatementEnd
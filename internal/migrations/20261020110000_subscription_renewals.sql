@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Subscriptions with renewal_term_months renew automatically by that many months.
+ALTER TABLE subscriptions
+    ADD COLUMN renewal_term_months int CHECK (renewal_term_months > 0);
+
+CREATE INDEX IF NOT EXISTS subscriptions_renewals_idx
+    ON subscriptions (end_date) WHERE renewal_term_months IS NOT NULL AND cancel_reason IS NULL;
+
+-- The renewal job lists due subscriptions of all tenants with app.renewal_scan set to
+-- 'on'. The policy is permissive, so it widens reads only; writes still need the tenant.
+CREATE POLICY subscriptions_renewal_scan ON subscriptions FOR SELECT
+    USING (current_setting('app.renewal_scan', true) = 'on');
+
+-- seq keeps the order the events were stored in, also among events of the same time.
+CREATE TABLE IF NOT EXISTS subscription_events (
+    id UUID PRIMARY KEY,
+    seq bigint GENERATED ALWAYS AS IDENTITY,
+    tenant_id UUID NOT NULL,
+    subscription_id UUID NOT NULL REFERENCES subscriptions (id) ON DELETE CASCADE,
+    type TEXT NOT NULL,
+    data JSONB NOT NULL DEFAULT '{}',
+    created_at bigint NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS subscription_events_subscription_idx
+    ON subscription_events (subscription_id, seq);
+
+ALTER TABLE subscription_events ENABLE ROW LEVEL SECURITY;
+ALTER TABLE subscription_events FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY subscription_events_tenant_isolation ON subscription_events
+    USING (tenant_id = NULLIF(current_setting('app.tenant_id', true), '')::uuid)
+    WITH CHECK (tenant_id = NULLIF(current_setting('app.tenant_id', true), '')::uuid);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS subscription_events;
+
+DROP POLICY IF EXISTS subscriptions_renewal_scan ON subscriptions;
+
+DROP INDEX IF EXISTS subscriptions_renewals_idx;
+
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS renewal_term_months;
+-- +goose StatementEnd
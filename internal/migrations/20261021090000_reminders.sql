@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS reminder_preferences (
+    tenant_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    days_before int NOT NULL CHECK (days_before > 0),
+    renewals boolean NOT NULL,
+    ends boolean NOT NULL,
+    price_changes boolean NOT NULL,
+    email TEXT NOT NULL DEFAULT '',
+    updated_at bigint NOT NULL,
+    PRIMARY KEY (tenant_id, user_id)
+);
+
+ALTER TABLE reminder_preferences ENABLE ROW LEVEL SECURITY;
+ALTER TABLE reminder_preferences FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY reminder_preferences_tenant_isolation ON reminder_preferences
+    USING (tenant_id = NULLIF(current_setting('app.tenant_id', true), '')::uuid)
+    WITH CHECK (tenant_id = NULLIF(current_setting('app.tenant_id', true), '')::uuid);
+
+-- The reminder job lists the subscriptions of all tenants with app.reminder_scan set to
+-- 'on', like the renewal job does with app.renewal_scan.
+CREATE POLICY reminder_preferences_reminder_scan ON reminder_preferences FOR SELECT
+    USING (current_setting('app.reminder_scan', true) = 'on');
+
+CREATE POLICY subscriptions_reminder_scan ON subscriptions FOR SELECT
+    USING (current_setting('app.reminder_scan', true) = 'on');
+
+-- A row per reminder sent. The job inserts it in the transaction that sends the
+-- reminder, so a reminder is sent once even by concurrent replicas, and again only when
+-- sending failed and the row was rolled back.
+CREATE TABLE IF NOT EXISTS sent_reminders (
+    subscription_id UUID NOT NULL REFERENCES subscriptions (id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    date DATE NOT NULL,
+    tenant_id UUID NOT NULL,
+    sent_at bigint NOT NULL,
+    PRIMARY KEY (subscription_id, kind, date)
+);
+
+ALTER TABLE sent_reminders ENABLE ROW LEVEL SECURITY;
+ALTER TABLE sent_reminders FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY sent_reminders_tenant_isolation ON sent_reminders
+    USING (tenant_id = NULLIF(current_setting('app.tenant_id', true), '')::uuid)
+    WITH CHECK (tenant_id = NULLIF(current_setting('app.tenant_id', true), '')::uuid);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS sent_reminders;
+
+DROP POLICY IF EXISTS subscriptions_reminder_scan ON subscriptions;
+
+DROP TABLE IF EXISTS reminder_preferences;
+-- +goose StatementEnd
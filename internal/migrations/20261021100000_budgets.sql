@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A budget with an empty category covers all subscriptions of the user; the others
+-- cover the subscriptions whose title is one of services.
+CREATE TABLE IF NOT EXISTS budgets (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    category TEXT NOT NULL DEFAULT '',
+    services TEXT[] NOT NULL DEFAULT '{}',
+    monthly_limit bigint NOT NULL CHECK (monthly_limit >= 0),
+    strict boolean NOT NULL DEFAULT false,
+    created_at bigint NOT NULL,
+    updated_at bigint NOT NULL,
+    UNIQUE (tenant_id, user_id, category)
+);
+
+ALTER TABLE budgets ENABLE ROW LEVEL SECURITY;
+ALTER TABLE budgets FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY budgets_tenant_isolation ON budgets
+    USING (tenant_id = NULLIF(current_setting('app.tenant_id', true), '')::uuid)
+    WITH CHECK (tenant_id = NULLIF(current_setting('app.tenant_id', true), '')::uuid);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS budgets;
+-- +goose StatementEnd
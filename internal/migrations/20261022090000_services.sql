@@ -0,0 +1,144 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS services (
+    id UUID PRIMARY KEY,
+    tenant_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    aliases TEXT[] NOT NULL DEFAULT '{}',
+    category TEXT NOT NULL DEFAULT '',
+    vendor_url TEXT NOT NULL DEFAULT '',
+    default_price bigint CHECK (default_price >= 0),
+    currency CHAR(3) NOT NULL DEFAULT 'RUB',
+    created_at bigint NOT NULL,
+    updated_at bigint NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS services_tenant_name_idx ON services (tenant_id, name);
+
+-- The name and the aliases of every service, trimmed and in lower case, so that no two
+-- services of a tenant share one and titles resolve with an index lookup.
+CREATE TABLE IF NOT EXISTS service_names (
+    tenant_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    service_id UUID NOT NULL REFERENCES services (id) ON DELETE CASCADE,
+    PRIMARY KEY (tenant_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS service_names_service_idx ON service_names (service_id);
+
+ALTER TABLE services ENABLE ROW LEVEL SECURITY;
+ALTER TABLE services FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY services_tenant_isolation ON services
+    USING (tenant_id = NULLIF(current_setting('app.tenant_id', true), '')::uuid)
+    WITH CHECK (tenant_id = NULLIF(current_setting('app.tenant_id', true), '')::uuid);
+
+ALTER TABLE service_names ENABLE ROW LEVEL SECURITY;
+ALTER TABLE service_names FORCE ROW LEVEL SECURITY;
+
+CREATE POLICY service_names_tenant_isolation ON service_names
+    USING (tenant_id = NULLIF(current_setting('app.tenant_id', true), '')::uuid)
+    WITH CHECK (tenant_id = NULLIF(current_setting('app.tenant_id', true), '')::uuid);
+
+ALTER TABLE subscriptions ADD COLUMN service_id UUID REFERENCES services (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS subscriptions_tenant_service_idx ON subscriptions (tenant_id, service_id);
+
+-- The backfill reads the rows of all tenants, which the owner of the tables sees only
+-- while row-level security is not forced on it.
+ALTER TABLE subscriptions NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE services NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE service_names NO FORCE ROW LEVEL SECURITY;
+
+-- Every title becomes a service of its tenant, titles that differ only in case and
+-- surrounding spaces one service named by their most common spelling.
+INSERT INTO services (id, tenant_id, name, created_at, updated_at)
+SELECT gen_random_uuid(), tenant_id, (array_agg(spelling ORDER BY n DESC, spelling))[1],
+    (extract(epoch FROM now()) * 1000)::bigint, (extract(epoch FROM now()) * 1000)::bigint
+FROM (
+    SELECT tenant_id, lower(btrim(title)) AS key, btrim(title) AS spelling, count(*) AS n
+    FROM subscriptions
+    GROUP BY tenant_id, lower(btrim(title)), btrim(title)
+) AS spellings
+GROUP BY tenant_id, key;
+
+INSERT INTO service_names (tenant_id, name, service_id)
+SELECT tenant_id, lower(btrim(name)), id FROM services;
+
+UPDATE subscriptions
+SET service_id = service_names.service_id
+FROM service_names
+WHERE service_names.tenant_id = subscriptions.tenant_id
+    AND service_names.name = lower(btrim(subscriptions.title));
+
+-- Subscriptions of a user to one service that overlap, such as "Netflix" and "netflix "
+-- ones, are not merged: which of them to keep is for the tenant to decide. The migration
+-- fails with the pairs instead, to be run again once one of every pair is ended or
+-- deleted.
+DO $$
+DECLARE
+    overlaps TEXT;
+BEGIN
+    SELECT string_agg(format('%s and %s of user %s in tenant %s', a.id, b.id, a.user_id, a.tenant_id), '; ')
+    INTO overlaps
+    FROM subscriptions AS a
+    JOIN subscriptions AS b
+        ON b.tenant_id = a.tenant_id
+        AND b.user_id = a.user_id
+        AND b.service_id = a.service_id
+        AND b.id > a.id
+    WHERE daterange(a.start_date, COALESCE(a.end_date, 'infinity'::date), '[]')
+        && daterange(b.start_date, COALESCE(b.end_date, 'infinity'::date), '[]');
+
+    IF overlaps IS NOT NULL THEN
+        RAISE EXCEPTION 'subscriptions to one service overlap: %', overlaps
+            USING HINT = 'End or delete one subscription of every pair and run the migration again.';
+    END IF;
+END
+$$;
+
+-- The names and aliases of a service are one service to the no-overlap constraint.
+ALTER TABLE subscriptions DROP CONSTRAINT subscriptions_no_overlap;
+
+ALTER TABLE subscriptions
+    ADD CONSTRAINT subscriptions_no_overlap
+    EXCLUDE USING gist (
+        tenant_id WITH =,
+        user_id WITH =,
+        (COALESCE(service_id::text, lower(btrim(title)))) WITH =,
+        daterange(start_date, COALESCE(end_date, 'infinity'::date), '[]') WITH &&
+    );
+
+-- Titles take the name of their service.
+UPDATE subscriptions
+SET title = services.name
+FROM services
+WHERE services.id = subscriptions.service_id
+    AND subscriptions.title <> services.name;
+
+ALTER TABLE subscriptions FORCE ROW LEVEL SECURITY;
+ALTER TABLE services FORCE ROW LEVEL SECURITY;
+ALTER TABLE service_names FORCE ROW LEVEL SECURITY;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Titles keep the names of their services. Dropping service_id drops the no-overlap
+-- constraint on it, which is restored on titles; subscriptions with one title that
+-- overlap under two services fail it.
+DROP INDEX IF EXISTS subscriptions_tenant_service_idx;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS service_id;
+
+ALTER TABLE subscriptions DROP CONSTRAINT IF EXISTS subscriptions_no_overlap;
+
+ALTER TABLE subscriptions
+    ADD CONSTRAINT subscriptions_no_overlap
+    EXCLUDE USING gist (
+        tenant_id WITH =,
+        user_id WITH =,
+        title WITH =,
+        daterange(start_date, COALESCE(end_date, 'infinity'::date), '[]') WITH &&
+    );
+DROP TABLE IF EXISTS service_names;
+DROP TABLE IF EXISTS services;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- lease_token identifies the holder of a lease, so that a request whose lease expired
+-- and was taken over can't complete or release the key of the one that took it.
+ALTER TABLE idempotency_keys ADD COLUMN lease_token TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS lease_token;
+-- +goose StatementEnd
@@ -0,0 +1,76 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The renewal job read the due subscriptions of all tenants through a policy that any
+-- session could turn on with app.renewal_scan. It calls renewal_due_subscriptions
+-- instead, which runs as subscription_scanner: a role without login and without members,
+-- the only one the subscriptions_scan policy lets read every tenant.
+--
+-- Creating the role and handing the function over to it needs CREATEROLE or a
+-- superuser, so this migration is applied with such a role rather than the one the
+-- service connects with.
+DROP POLICY IF EXISTS subscriptions_renewal_scan ON subscriptions;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = 'subscription_scanner') THEN
+        CREATE ROLE subscription_scanner NOLOGIN;
+    END IF;
+END
+$$;
+
+GRANT SELECT ON subscriptions TO subscription_scanner;
+
+CREATE POLICY subscriptions_scan ON subscriptions FOR SELECT TO subscription_scanner
+    USING (true);
+
+CREATE FUNCTION renewal_due_subscriptions(until date) RETURNS SETOF subscriptions
+    LANGUAGE sql STABLE SECURITY DEFINER SET search_path FROM CURRENT
+    AS $fn$
+        SELECT * FROM subscriptions
+        WHERE renewal_term_months IS NOT NULL AND cancel_reason IS NULL AND end_date <= until
+    $fn$;
+
+REVOKE EXECUTE ON FUNCTION renewal_due_subscriptions(date) FROM PUBLIC;
+
+-- The owner of a function must be able to create in its schema, and only a member of a
+-- role can give it a function. The migrating role is a member for the handover only,
+-- and keeps the right to execute the function.
+DO $$
+DECLARE
+    migrator TEXT := current_user;
+BEGIN
+    EXECUTE format('GRANT USAGE, CREATE ON SCHEMA %I TO subscription_scanner', current_schema());
+    EXECUTE format('GRANT subscription_scanner TO %I', migrator);
+
+    ALTER FUNCTION renewal_due_subscriptions(date) OWNER TO subscription_scanner;
+    SET LOCAL ROLE subscription_scanner;
+    EXECUTE format('GRANT EXECUTE ON FUNCTION renewal_due_subscriptions(date) TO %I', migrator);
+    RESET ROLE;
+
+    EXECUTE format('REVOKE subscription_scanner FROM %I', migrator);
+    EXECUTE format('REVOKE CREATE ON SCHEMA %I FROM subscription_scanner', current_schema());
+END
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- The role stays: it is shared by the databases of the cluster.
+DO $$
+DECLARE
+    migrator TEXT := current_user;
+BEGIN
+    EXECUTE format('GRANT subscription_scanner TO %I', migrator);
+    DROP FUNCTION IF EXISTS renewal_due_subscriptions(date);
+    EXECUTE format('REVOKE subscription_scanner FROM %I', migrator);
+    EXECUTE format('REVOKE USAGE ON SCHEMA %I FROM subscription_scanner', current_schema());
+END
+$$;
+
+DROP POLICY IF EXISTS subscriptions_scan ON subscriptions;
+
+REVOKE SELECT ON subscriptions FROM subscription_scanner;
+
+CREATE POLICY subscriptions_renewal_scan ON subscriptions FOR SELECT
+    USING (current_setting('app.renewal_scan', true) = 'on');
+-- +goose StatementEnd
@@ -0,0 +1,65 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The reminder job read the subscriptions and reminder preferences of all tenants
+-- through policies that any session could turn on with app.reminder_scan. It calls
+-- reminder_targets instead, which runs as subscription_scanner like
+-- renewal_due_subscriptions does, and needs CREATEROLE or a superuser the same way.
+DROP POLICY IF EXISTS subscriptions_reminder_scan ON subscriptions;
+DROP POLICY IF EXISTS reminder_preferences_reminder_scan ON reminder_preferences;
+
+GRANT SELECT ON reminder_preferences TO subscription_scanner;
+
+CREATE POLICY reminder_preferences_scan ON reminder_preferences FOR SELECT TO subscription_scanner
+    USING (true);
+
+CREATE FUNCTION reminder_targets(since date)
+    RETURNS TABLE (subscription subscriptions, preferences reminder_preferences)
+    LANGUAGE sql STABLE SECURITY DEFINER SET search_path FROM CURRENT
+    AS $fn$
+        SELECT s, p FROM subscriptions AS s
+        JOIN reminder_preferences AS p ON p.tenant_id = s.tenant_id AND p.user_id = s.user_id
+        WHERE (p.renewals OR p.ends OR p.price_changes) AND (s.end_date IS NULL OR s.end_date >= since)
+    $fn$;
+
+REVOKE EXECUTE ON FUNCTION reminder_targets(date) FROM PUBLIC;
+
+DO $$
+DECLARE
+    migrator TEXT := current_user;
+BEGIN
+    EXECUTE format('GRANT CREATE ON SCHEMA %I TO subscription_scanner', current_schema());
+    EXECUTE format('GRANT subscription_scanner TO %I', migrator);
+
+    ALTER FUNCTION reminder_targets(date) OWNER TO subscription_scanner;
+    SET LOCAL ROLE subscription_scanner;
+    EXECUTE format('GRANT EXECUTE ON FUNCTION reminder_targets(date) TO %I', migrator);
+    RESET ROLE;
+
+    EXECUTE format('REVOKE subscription_scanner FROM %I', migrator);
+    EXECUTE format('REVOKE CREATE ON SCHEMA %I FROM subscription_scanner', current_schema());
+END
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DO $$
+DECLARE
+    migrator TEXT := current_user;
+BEGIN
+    EXECUTE format('GRANT subscription_scanner TO %I', migrator);
+    DROP FUNCTION IF EXISTS reminder_targets(date);
+    EXECUTE format('REVOKE subscription_scanner FROM %I', migrator);
+END
+$$;
+
+DROP POLICY IF EXISTS reminder_preferences_scan ON reminder_preferences;
+
+REVOKE SELECT ON reminder_preferences FROM subscription_scanner;
+
+CREATE POLICY reminder_preferences_reminder_scan ON reminder_preferences FOR SELECT
+    USING (current_setting('app.reminder_scan', true) = 'on');
+
+CREATE POLICY subscriptions_reminder_scan ON subscriptions FOR SELECT
+    USING (current_setting('app.reminder_scan', true) = 'on');
+-- +goose StatementEnd
@@ -6,20 +6,38 @@ import (
 	"fmt"
 
 	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
 )
 
 //go:embed *.sql
 var embedMigrations embed.FS
 
-func Migrate(db *sql.DB) error {
-	goose.SetBaseFS(embedMigrations)
+// Dir is where new migrations are created, relative to the repository root.
+const Dir = "internal/migrations"
 
-	if err := goose.SetDialect("postgres"); err != nil {
-		return fmt.Errorf("failed to set goose dialect: %w", err)
+// NewProvider returns a goose provider for the embedded migrations. Every operation
+// holds a Postgres advisory lock for its duration, so replicas migrating at the same
+// time are serialized instead of racing each other.
+func NewProvider(db *sql.DB) (*goose.Provider, error) {
+	locker, err := lock.NewPostgresSessionLocker()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migration lock: %w", err)
 	}
 
-	if err := goose.Up(db, "."); err != nil {
-		return fmt.Errorf("failed to migrate database: %w", err)
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, embedMigrations, goose.WithSessionLocker(locker))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migration provider: %w", err)
+	}
+
+	return provider, nil
+}
+
+// Create writes an empty SQL migration named after the current time into dir.
+func Create(dir, name string) error {
+	goose.SetSequential(false)
+
+	if err := goose.Create(nil, dir, name, "sql"); err != nil {
+		return fmt.Errorf("failed to create migration: %w", err)
 	}
 
 	return nil
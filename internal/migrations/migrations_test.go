@@ -0,0 +1,229 @@
+package migrations_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+
+	"subscription-service/internal/migrations"
+)
+
+// tenantsVersion is the migration whose Down is irreversible.
+const tenantsVersion = 20261019110000
+
+// openSchema connects to the database of TEST_DATABASE_URL with a new schema first on
+// the search path and drops the schema when the test ends, so that migrating up and down
+// doesn't disturb the tests sharing the database.
+func openSchema(t *testing.T, ctx context.Context) *sql.DB {
+	t.Helper()
+
+	connStr := os.Getenv("TEST_DATABASE_URL")
+	if connStr == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	cfg, err := pgx.ParseConfig(connStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	admin := stdlib.OpenDB(*cfg)
+	t.Cleanup(func() { _ = admin.Close() })
+
+	schema := "migrations_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	if _, err := admin.ExecContext(ctx, "CREATE SCHEMA "+schema); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := admin.ExecContext(context.Background(), "DROP SCHEMA "+schema+" CASCADE"); err != nil {
+			t.Errorf("drop schema: %v", err)
+		}
+	})
+
+	cfg = cfg.Copy()
+	cfg.RuntimeParams["search_path"] = schema + ", public"
+	db := stdlib.OpenDB(*cfg)
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db
+}
+
+func TestMigrations(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	db := openSchema(t, ctx)
+
+	provider, err := migrations.NewProvider(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := provider.Up(ctx); err != nil {
+		t.Fatalf("up: %v", err)
+	}
+
+	// The migrations after the tenants one roll back, and apply again at the end.
+	if _, err := provider.DownTo(ctx, tenantsVersion); err != nil {
+		t.Fatalf("down to %d: %v", tenantsVersion, err)
+	}
+
+	_, err = provider.Down(ctx)
+	if err == nil || !strings.Contains(err.Error(), "irreversible") {
+		t.Fatalf("down of the tenants migration: %v, want it to fail as irreversible", err)
+	}
+
+	version, err := provider.GetDBVersion(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != tenantsVersion {
+		t.Errorf("version after the failed down = %d, want %d", version, tenantsVersion)
+	}
+
+	// The failed down leaves the schema as it was.
+	if _, err := db.ExecContext(ctx, "SELECT tenant_id FROM subscriptions LIMIT 0"); err != nil {
+		t.Errorf("select the tenants of subscriptions after the failed down: %v", err)
+	}
+
+	if _, err := provider.Up(ctx); err != nil {
+		t.Fatalf("up after the failed down: %v", err)
+	}
+}
+
+// TestScanRole checks that a role the service could connect with reads no other tenant
+// with the settings the scans of the jobs once used, and can't call the scan functions
+// it was not granted.
+func TestScanRole(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	db := openSchema(t, ctx)
+
+	provider, err := migrations.NewProvider(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := provider.Up(ctx); err != nil {
+		t.Fatalf("up: %v", err)
+	}
+
+	tenantID, userID := uuid.NewString(), uuid.NewString()
+	if _, err := db.ExecContext(ctx,
+		"INSERT INTO subscriptions (id, tenant_id, title, price, user_id, start_date, end_date, "+
+			"renewal_term_months, created_at, updated_at) VALUES ($1, $2, 'Okko', 400, $3, '2025-01-01', "+
+			"'2025-06-01', 6, 0, 0)",
+		uuid.NewString(), tenantID, userID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.ExecContext(ctx,
+		"INSERT INTO reminder_preferences (tenant_id, user_id, days_before, renewals, ends, price_changes, updated_at) "+
+			"VALUES ($1, $2, 3, true, true, true, 0)",
+		tenantID, userID); err != nil {
+		t.Fatal(err)
+	}
+
+	role := "scan_test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	if _, err := db.ExecContext(ctx, "CREATE ROLE "+role+" NOLOGIN"); err != nil {
+		t.Skipf("create a role: %v", err)
+	}
+	t.Cleanup(func() {
+		for _, q := range []string{"DROP OWNED BY " + role, "DROP ROLE " + role} {
+			if _, err := db.ExecContext(context.Background(), q); err != nil {
+				t.Errorf("%s: %v", q, err)
+			}
+		}
+	})
+	if _, err := db.ExecContext(ctx,
+		"GRANT USAGE ON SCHEMA "+currentSchema(t, ctx, db)+" TO "+role+"; "+
+			"GRANT SELECT ON subscriptions, reminder_preferences TO "+role); err != nil {
+		t.Fatal(err)
+	}
+
+	asRole := func(t *testing.T, query string, dest any) error {
+		t.Helper()
+
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		if _, err := tx.ExecContext(ctx, "SET LOCAL ROLE "+role+"; "+
+			"SELECT set_config('app.renewal_scan', 'on', true), set_config('app.reminder_scan', 'on', true)"); err != nil {
+			t.Fatal(err)
+		}
+
+		return tx.QueryRowContext(ctx, query).Scan(dest)
+	}
+
+	for _, table := range []string{"subscriptions", "reminder_preferences"} {
+		var n int
+		if err := asRole(t, "SELECT count(*) FROM "+table, &n); err != nil || n != 0 {
+			t.Errorf("rows of %s read with the scan settings = %d, %v, want none", table, n, err)
+		}
+	}
+
+	for _, fn := range []string{"renewal_due_subscriptions('2025-06-01')", "reminder_targets('2025-01-01')"} {
+		var n int
+		err := asRole(t, "SELECT count(*) FROM "+fn, &n)
+		if err == nil || !strings.Contains(err.Error(), "permission denied") {
+			t.Errorf("call %s: %d, %v, want permission denied", fn, n, err)
+		}
+	}
+
+	// The migrating role keeps the right to run the scans, which read every tenant.
+	var renewals, reminders int
+	if err := db.QueryRowContext(ctx, "SELECT count(*) FROM renewal_due_subscriptions('2025-06-01')").
+		Scan(&renewals); err != nil || renewals != 1 {
+		t.Errorf("due renewals = %d, %v, want 1", renewals, err)
+	}
+	if err := db.QueryRowContext(ctx, "SELECT count(*) FROM reminder_targets('2025-01-01')").
+		Scan(&reminders); err != nil || reminders != 1 {
+		t.Errorf("reminder targets = %d, %v, want 1", reminders, err)
+	}
+}
+
+func currentSchema(t *testing.T, ctx context.Context, db *sql.DB) string {
+	t.Helper()
+
+	var schema string
+	if err := db.QueryRowContext(ctx, "SELECT current_schema()").Scan(&schema); err != nil {
+		t.Fatal(err)
+	}
+
+	return schema
+}
+
+func TestCreate(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := migrations.Create(dir, "add_notes"); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || !regexp.MustCompile(`^\d{14}_add_notes\.sql$`).MatchString(entries[0].Name()) {
+		t.Fatalf("created %v, want one timestamped add_notes.sql", entries)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "-- +goose Up") || !strings.Contains(string(content), "-- +goose Down") {
+		t.Errorf("created migration %q, want the goose annotations", content)
+	}
+}
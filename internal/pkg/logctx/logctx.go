@@ -0,0 +1,29 @@
+package logctx
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+const RequestIDField = "request_id"
+
+type requestIDKey struct{}
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// Logger returns base enriched with the request scoped fields stored in ctx.
+func Logger(ctx context.Context, base *zap.Logger) *zap.Logger {
+	if requestID := RequestID(ctx); requestID != "" {
+		return base.With(zap.String(RequestIDField, requestID))
+	}
+
+	return base
+}
@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+
+	"subscription-service/internal/app/entity"
+)
+
+//go:generate mockgen -destination ../adapter/repo/mock/analytics_mock.go -package repo -source ./analytics.go
+
+// AnalyticsRepo computes business metrics over the subscriptions of the tenant of ctx.
+type AnalyticsRepo interface {
+	// MonthlyMetrics returns the metrics of every month of the filter, in order, see
+	// entity.MonthlyMetrics.
+	MonthlyMetrics(ctx context.Context, filter entity.MetricsFilter) ([]entity.MonthlyMetrics, error)
+}
@@ -0,0 +1,22 @@
+package port
+
+import (
+	"context"
+
+	"subscription-service/internal/app/entity"
+)
+
+//go:generate mockgen -destination ../adapter/repo/mock/auth_mock.go -package repo -source ./auth.go
+
+type APIKeyRepo interface {
+	Create(ctx context.Context, key entity.APIKey, hash []byte) error
+	GetByHash(ctx context.Context, hash []byte) (*entity.APIKey, error)
+	// List and Revoke are restricted to keys of tenantID unless it is nil.
+	List(ctx context.Context, tenantID *string) ([]entity.APIKey, error)
+	Revoke(ctx context.Context, id string, tenantID *string, revokedAt int64) error
+}
+
+// TokenVerifier validates bearer tokens issued by an external identity provider.
+type TokenVerifier interface {
+	Verify(ctx context.Context, token string) (entity.Principal, error)
+}
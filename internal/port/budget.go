@@ -0,0 +1,28 @@
+package port
+
+import (
+	"context"
+
+	"subscription-service/internal/app/entity"
+)
+
+//go:generate mockgen -destination ../adapter/repo/mock/budget_mock.go -package repo -source ./budget.go
+
+// BudgetRepo keeps the budgets of users in the tenant of ctx. A user has at most one
+// budget per category, including the budget without a category.
+type BudgetRepo interface {
+	// CreateBudget stores a new budget, or returns ErrBudgetAlreadyExists when the id or
+	// the category of the user is taken.
+	CreateBudget(ctx context.Context, budget entity.Budget) error
+	// GetBudget returns the budget with id or ErrNotFound.
+	GetBudget(ctx context.Context, id string) (*entity.Budget, error)
+	// UpdateBudget replaces the category, services, limit, strictness and update time
+	// of the budget with the id of budget. It returns ErrNotFound, or
+	// ErrBudgetAlreadyExists when the user has another budget for the new category.
+	UpdateBudget(ctx context.Context, budget entity.Budget) error
+	// DeleteBudget deletes the budget with id or returns ErrNotFound.
+	DeleteBudget(ctx context.Context, id string) error
+	// ListBudgets returns the budgets of the user ordered by category, the budget
+	// without one first.
+	ListBudgets(ctx context.Context, userID string) ([]entity.Budget, error)
+}
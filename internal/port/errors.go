@@ -5,6 +5,17 @@ import "errors"
 var (
 	ErrNotFound                  = errors.New("subscription not found")
 	ErrSubscriptionAlreadyExists = errors.New("subscription already exists")
+	ErrBudgetAlreadyExists       = errors.New("budget already exists")
+	ErrServiceAlreadyExists      = errors.New("service already exists")
+	ErrServiceNotFound           = errors.New("service not found")
 
-	ErrTransactionFailure = errors.New("transaction failure")
+	ErrInvalidToken = errors.New("invalid token")
+
+	ErrTenantRequired = errors.New("tenant is not set")
+
+	ErrIdempotencyKeyReused  = errors.New("idempotency key reused with another request")
+	ErrIdempotencyInProgress = errors.New("request with this idempotency key is in progress")
+	ErrIdempotencyLeaseLost  = errors.New("lease on the idempotency key is lost")
+
+	ErrNoRecipient = errors.New("no recipient for the notification")
 )
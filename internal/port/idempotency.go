@@ -0,0 +1,31 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"subscription-service/internal/app/entity"
+)
+
+//go:generate mockgen -destination ../adapter/repo/mock/idempotency_mock.go -package repo -source ./idempotency.go
+
+// IdempotencyStore remembers responses of requests sent with an Idempotency-Key.
+type IdempotencyStore interface {
+	// Acquire takes a lease on the key for the holder of token. It returns the stored
+	// response when the request has already completed, ErrIdempotencyKeyReused when the
+	// key was used for another request and ErrIdempotencyInProgress while another lease
+	// holder runs it. An expired lease is taken over.
+	Acquire(
+		ctx context.Context,
+		scope, key, token string,
+		fingerprint []byte,
+		lease time.Duration,
+	) (*entity.IdempotentResponse, error)
+	// Complete stores the response and releases the lease of token. It returns
+	// ErrIdempotencyLeaseLost when the lease is no longer held with token, as another
+	// request took it over after it expired.
+	Complete(ctx context.Context, scope, key, token string, resp entity.IdempotentResponse, ttl time.Duration) error
+	// Release drops the lease of token without storing a response so that the request can
+	// be retried. A lease held with another token is kept.
+	Release(ctx context.Context, scope, key, token string) error
+}
@@ -0,0 +1,125 @@
+package porttest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+// AnalyticsRepo checks the metrics of the analytics port against a small portfolio
+// worked out by hand. subs stores the subscriptions analytics reads and their services.
+func AnalyticsRepo(t *testing.T, subs CatalogRepo, analytics port.AnalyticsRepo) {
+	ctx := tenantContext()
+
+	// The MRR of the users, from 12-2024 to 04-2025:
+	//
+	//	a: Okko 400 from 12-2024 and Kion 200 from 02-2025 to 03-2025: 400, 400, 600, 600, 400
+	//	b: Ivi 300 from 01-2025 to 02-2025, 100 in 01-2025:               -, 100, 300,   -,   -
+	//	c: Okko 500 from 11-2024 to 01-2025:                            500, 500,   -,   -,   -
+	//	d: Okko 250 from 01-2025, paused in 03-2025:                      -, 250, 250,   -, 250
+	a, b, c, d := uuid.NewString(), uuid.NewString(), uuid.NewString(), uuid.NewString()
+
+	create := func(userID, title string, price int64, start time.Time, end *time.Time, phases ...entity.Phase) string {
+		sub := newSubscription(title, start, end)
+		sub.UserID, sub.Price, sub.Phases = userID, price, phases
+		mustCreate(t, ctx, subs, sub)
+		return sub.ID
+	}
+
+	create(a, "Okko", 400, month(2024, time.December), nil)
+	create(a, "Kion", 200, month(2025, time.February), ptr(month(2025, time.March)))
+	create(b, "Ivi", 300, month(2025, time.January), ptr(month(2025, time.February)),
+		entity.Phase{StartDate: month(2025, time.January), EndDate: month(2025, time.January), Price: 100})
+	create(c, "Okko", 500, month(2024, time.November), ptr(month(2025, time.January)))
+	paused := create(d, "Okko", 250, month(2025, time.January), nil)
+
+	_, err := subs.UpdatePauses(ctx, paused, time.Now().UnixMilli(), func(entity.Subscription) ([]entity.Pause, error) {
+		return []entity.Pause{{StartDate: month(2025, time.March), EndDate: ptr(month(2025, time.March))}}, nil
+	})
+	if err != nil {
+		t.Fatalf("pause: %v", err)
+	}
+
+	// Subscriptions of another tenant are not counted.
+	mustCreate(t, tenantContext(), subs, newSubscription("Okko", month(2024, time.December), nil))
+
+	// The service links the subscriptions to Okko created before it.
+	okko := "Okko"
+	service := entity.Service{ID: uuid.NewString(), Name: okko, Currency: "RUB"}
+	if err := subs.CreateService(ctx, service); err != nil {
+		t.Fatalf("create service: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		filter entity.MetricsFilter
+		want   []entity.MonthlyMetrics
+	}{
+		{
+			name:   "all services",
+			filter: entity.MetricsFilter{From: month(2025, time.January), To: month(2025, time.April)},
+			want: []entity.MonthlyMetrics{
+				{Month: month(2025, time.January), MRR: 1250, NewMRR: 350,
+					Subscribers: 4, PreviousSubscribers: 2, NewSubscribers: 2},
+				{Month: month(2025, time.February), MRR: 1150, ChurnedMRR: 500, ExpansionMRR: 400,
+					Subscribers: 3, PreviousSubscribers: 4, ChurnedSubscribers: 1},
+				{Month: month(2025, time.March), MRR: 600, ChurnedMRR: 550,
+					Subscribers: 1, PreviousSubscribers: 3, ChurnedSubscribers: 2},
+				{Month: month(2025, time.April), MRR: 650, NewMRR: 250, ContractionMRR: 200,
+					Subscribers: 2, PreviousSubscribers: 1, NewSubscribers: 1},
+			},
+		},
+		{
+			name:   "one service",
+			filter: entity.MetricsFilter{Title: &okko, From: month(2025, time.February), To: month(2025, time.March)},
+			want: []entity.MonthlyMetrics{
+				{Month: month(2025, time.February), MRR: 650, ChurnedMRR: 500,
+					Subscribers: 2, PreviousSubscribers: 3, ChurnedSubscribers: 1},
+				{Month: month(2025, time.March), MRR: 400, ChurnedMRR: 250,
+					Subscribers: 1, PreviousSubscribers: 2, ChurnedSubscribers: 1},
+			},
+		},
+		{
+			name:   "one service by id",
+			filter: entity.MetricsFilter{ServiceID: &service.ID, From: month(2025, time.February), To: month(2025, time.March)},
+			want: []entity.MonthlyMetrics{
+				{Month: month(2025, time.February), MRR: 650, ChurnedMRR: 500,
+					Subscribers: 2, PreviousSubscribers: 3, ChurnedSubscribers: 1},
+				{Month: month(2025, time.March), MRR: 400, ChurnedMRR: 250,
+					Subscribers: 1, PreviousSubscribers: 2, ChurnedSubscribers: 1},
+			},
+		},
+		{
+			name:   "no subscriptions",
+			filter: entity.MetricsFilter{From: month(2020, time.January), To: month(2020, time.February)},
+			want:   []entity.MonthlyMetrics{{Month: month(2020, time.January)}, {Month: month(2020, time.February)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metrics, err := analytics.MonthlyMetrics(ctx, tt.filter)
+			if err != nil {
+				t.Fatalf("metrics: %v", err)
+			}
+			if len(metrics) != len(tt.want) {
+				t.Fatalf("metrics = %+v, want %+v", metrics, tt.want)
+			}
+
+			for i, got := range metrics {
+				want := tt.want[i]
+				if !got.Month.Equal(want.Month) {
+					t.Errorf("month %d is %s, want %s", i, got.Month, want.Month)
+				}
+				got.Month = want.Month
+				if got != want {
+					t.Errorf("%s = %+v, want %+v", want.Month.Format("01-2006"), got, want)
+				}
+			}
+		})
+	}
+}
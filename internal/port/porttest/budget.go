@@ -0,0 +1,299 @@
+package porttest
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+// BudgetingRepo is a SubscriptionRepo that also keeps budgets.
+type BudgetingRepo interface {
+	port.SubscriptionRepo
+	port.BudgetRepo
+}
+
+// BudgetRepo checks the budget port and the events that record budget alerts.
+func BudgetRepo(t *testing.T, repo BudgetingRepo) {
+	t.Run("crud", func(t *testing.T) {
+		ctx := tenantContext()
+		userID := uuid.NewString()
+
+		total := entity.Budget{ID: uuid.NewString(), UserID: userID, Limit: 3000, CreatedAt: 1, UpdatedAt: 1}
+		video := entity.Budget{
+			ID: uuid.NewString(), UserID: userID, Category: "video", Services: []string{"Okko", "Ivi"},
+			Limit: 1000, Strict: true, CreatedAt: 1, UpdatedAt: 1,
+		}
+		for _, b := range []entity.Budget{video, total} {
+			if err := repo.CreateBudget(ctx, b); err != nil {
+				t.Fatalf("create %q: %v", b.Category, err)
+			}
+		}
+
+		duplicate := video
+		duplicate.ID = uuid.NewString()
+		if err := repo.CreateBudget(ctx, duplicate); !errors.Is(err, port.ErrBudgetAlreadyExists) {
+			t.Errorf("create a second video budget: %v, want %v", err, port.ErrBudgetAlreadyExists)
+		}
+
+		budgets, err := repo.ListBudgets(ctx, userID)
+		if err != nil || len(budgets) != 2 || !sameBudget(budgets[0], total) || !sameBudget(budgets[1], video) {
+			t.Fatalf("list = %+v, %v, want %+v and %+v", budgets, err, total, video)
+		}
+
+		video.Category, video.Services, video.Limit, video.Strict, video.UpdatedAt = "music", []string{"Zvuk"}, 500, false, 2
+		if err := repo.UpdateBudget(ctx, video); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+
+		got, err := repo.GetBudget(ctx, video.ID)
+		if err != nil || !sameBudget(*got, video) {
+			t.Errorf("get = %+v, %v, want %+v", got, err, video)
+		}
+
+		total.Category, total.Services = "music", []string{"Yandex Music"}
+		if err := repo.UpdateBudget(ctx, total); !errors.Is(err, port.ErrBudgetAlreadyExists) {
+			t.Errorf("update into a taken category: %v, want %v", err, port.ErrBudgetAlreadyExists)
+		}
+
+		other := tenantContext()
+		if _, err := repo.GetBudget(other, video.ID); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("get in another tenant: %v, want %v", err, port.ErrNotFound)
+		}
+		if err := repo.UpdateBudget(other, video); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("update in another tenant: %v, want %v", err, port.ErrNotFound)
+		}
+		if err := repo.DeleteBudget(other, video.ID); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("delete in another tenant: %v, want %v", err, port.ErrNotFound)
+		}
+		if budgets, err := repo.ListBudgets(other, userID); err != nil || len(budgets) != 0 {
+			t.Errorf("list in another tenant = %+v, %v, want none", budgets, err)
+		}
+
+		if err := repo.DeleteBudget(ctx, video.ID); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if _, err := repo.GetBudget(ctx, video.ID); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("get deleted: %v, want %v", err, port.ErrNotFound)
+		}
+		if err := repo.DeleteBudget(ctx, video.ID); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("delete again: %v, want %v", err, port.ErrNotFound)
+		}
+	})
+
+	t.Run("checked create", func(t *testing.T) {
+		ctx := tenantContext()
+
+		first := newSubscription("Okko", month(2025, time.January), nil)
+		mustCreate(t, ctx, repo, first)
+		budget := entity.Budget{ID: uuid.NewString(), UserID: first.UserID, Limit: 1000, CreatedAt: 1, UpdatedAt: 1}
+		if err := repo.CreateBudget(ctx, budget); err != nil {
+			t.Fatalf("create budget: %v", err)
+		}
+
+		rejected := errors.New("over the budget")
+		sub := newSubscription("Ivi", month(2025, time.February), nil)
+		sub.UserID = first.UserID
+		err := repo.CreateChecked(ctx, sub, func(reader port.CheckReader) error {
+			budgets, err := reader.ListBudgets(ctx, sub.UserID)
+			if err != nil || len(budgets) != 1 || !sameBudget(budgets[0], budget) {
+				t.Errorf("budgets in the check = %+v, %v, want %+v", budgets, err, budget)
+			}
+			active, err := reader.ListActive(ctx, entity.PeriodFilter{
+				UserID: &sub.UserID, From: month(2025, time.February), To: month(2025, time.February),
+			})
+			if err != nil || len(active) != 1 || active[0].ID != first.ID {
+				t.Errorf("active in the check = %+v, %v, want only %s", active, err, first.ID)
+			}
+			return rejected
+		})
+		if !errors.Is(err, rejected) {
+			t.Errorf("create rejected by the check: %v, want %v", err, rejected)
+		}
+		if _, err := repo.GetSubscription(ctx, sub.ID); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("get the rejected subscription: %v, want %v", err, port.ErrNotFound)
+		}
+
+		// Each create admits a subscription only while the user has none other in the
+		// month; checked concurrently, exactly one of them passes.
+		userID := uuid.NewString()
+		var (
+			wg      sync.WaitGroup
+			mu      sync.Mutex
+			created int
+		)
+		for i := range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				sub := newSubscription(fmt.Sprintf("Service %d", i), month(2025, time.March), ptr(month(2025, time.March)))
+				sub.UserID = userID
+				err := repo.CreateChecked(ctx, sub, func(reader port.CheckReader) error {
+					active, err := reader.ListActive(ctx, entity.PeriodFilter{
+						UserID: &userID, From: month(2025, time.March), To: month(2025, time.March),
+					})
+					if err != nil {
+						return err
+					}
+					if len(active) > 0 {
+						return rejected
+					}
+					return nil
+				})
+				switch {
+				case err == nil:
+					mu.Lock()
+					created++
+					mu.Unlock()
+				case !errors.Is(err, rejected):
+					t.Errorf("checked create: %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if created != 1 {
+			t.Errorf("%d concurrent checked creates passed, want 1", created)
+		}
+	})
+
+	t.Run("checked update", func(t *testing.T) {
+		ctx := tenantContext()
+
+		okko := newSubscription("Okko", month(2025, time.January), nil)
+		mustCreate(t, ctx, repo, okko)
+		ivi := newSubscription("Ivi", month(2025, time.January), nil)
+		ivi.UserID = okko.UserID
+		mustCreate(t, ctx, repo, ivi)
+		budget := entity.Budget{ID: uuid.NewString(), UserID: okko.UserID, Limit: 1000, CreatedAt: 1, UpdatedAt: 1}
+		if err := repo.CreateBudget(ctx, budget); err != nil {
+			t.Fatalf("create budget: %v", err)
+		}
+
+		missing := entity.UpdateSubscriptionRequest(newSubscription("Kion", month(2025, time.January), nil))
+		err := repo.UpdateChecked(ctx, missing, func(entity.Subscription, port.CheckReader) error {
+			t.Error("check of an unknown subscription")
+			return nil
+		})
+		if !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("checked update of an unknown subscription: %v, want %v", err, port.ErrNotFound)
+		}
+
+		rejected := errors.New("over the budget")
+		update := entity.UpdateSubscriptionRequest(okko)
+		update.Price, update.UpdatedAt = 900, okko.UpdatedAt+1
+		err = repo.UpdateChecked(ctx, update, func(current entity.Subscription, reader port.CheckReader) error {
+			if current.ID != okko.ID || current.Price != okko.Price {
+				t.Errorf("current in the check = %+v, want %s as stored", current, okko.ID)
+			}
+			budgets, err := reader.ListBudgets(ctx, okko.UserID)
+			if err != nil || len(budgets) != 1 || !sameBudget(budgets[0], budget) {
+				t.Errorf("budgets in the check = %+v, %v, want %+v", budgets, err, budget)
+			}
+			return rejected
+		})
+		if !errors.Is(err, rejected) {
+			t.Errorf("update rejected by the check: %v, want %v", err, rejected)
+		}
+		if got, err := repo.GetSubscription(ctx, okko.ID); err != nil || got.Price != okko.Price {
+			t.Errorf("get the subscription after a rejected update: %+v, %v, want price %d", got, err, okko.Price)
+		}
+
+		// Each update raises the price of one of the subscriptions while the user spends
+		// no more than the limit; checked concurrently, the raises never add up over it.
+		var wg sync.WaitGroup
+		for i := range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				update := entity.UpdateSubscriptionRequest(okko)
+				if i%2 == 1 {
+					update = entity.UpdateSubscriptionRequest(ivi)
+				}
+				update.Price = 550
+				err := repo.UpdateChecked(ctx, update, func(current entity.Subscription, reader port.CheckReader) error {
+					active, err := reader.ListActive(ctx, entity.PeriodFilter{
+						UserID: &okko.UserID, From: month(2025, time.January), To: month(2025, time.January),
+					})
+					if err != nil {
+						return err
+					}
+					cost := update.Price - current.Price
+					for _, sub := range active {
+						cost += sub.Price
+					}
+					if cost > budget.Limit {
+						return rejected
+					}
+					return nil
+				})
+				if err != nil && !errors.Is(err, rejected) {
+					t.Errorf("checked update: %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		var cost int64
+		for _, id := range []string{okko.ID, ivi.ID} {
+			sub, err := repo.GetSubscription(ctx, id)
+			if err != nil {
+				t.Fatalf("get subscription: %v", err)
+			}
+			cost += sub.Price
+		}
+		if cost != 950 {
+			t.Errorf("the subscriptions cost %d after concurrent checked updates, want 950", cost)
+		}
+	})
+
+	t.Run("alert events", func(t *testing.T) {
+		ctx := tenantContext()
+
+		sub := newSubscription("Okko", month(2025, time.January), nil)
+		mustCreate(t, ctx, repo, sub)
+
+		alert := entity.SubscriptionEvent{
+			ID:             uuid.NewString(),
+			SubscriptionID: sub.ID,
+			Type:           entity.EventBudgetExceeded,
+			BudgetAlert: &entity.BudgetAlert{
+				BudgetID: uuid.NewString(), Category: "video", Month: month(2025, time.March), Limit: 300, Cost: 400,
+			},
+			CreatedAt: time.Now().UnixMilli(),
+		}
+		if err := repo.AddEvents(ctx, []entity.SubscriptionEvent{alert}); err != nil {
+			t.Fatalf("add events: %v", err)
+		}
+
+		missing := alert
+		missing.ID, missing.SubscriptionID = uuid.NewString(), uuid.NewString()
+		second := alert
+		second.ID = uuid.NewString()
+		if err := repo.AddEvents(ctx, []entity.SubscriptionEvent{second, missing}); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("add events of an unknown subscription: %v, want %v", err, port.ErrNotFound)
+		}
+		if err := repo.AddEvents(tenantContext(), []entity.SubscriptionEvent{second}); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("add events in another tenant: %v, want %v", err, port.ErrNotFound)
+		}
+
+		events, err := repo.ListEvents(ctx, sub.ID)
+		if err != nil || !sameEvents(events, []entity.SubscriptionEvent{alert}) {
+			t.Errorf("events = %+v, %v, want only %+v", events, err, alert)
+		}
+	})
+}
+
+func sameBudget(a, b entity.Budget) bool {
+	return a.ID == b.ID && a.UserID == b.UserID && a.Category == b.Category && slices.Equal(a.Services, b.Services) &&
+		a.Limit == b.Limit && a.Strict == b.Strict && a.CreatedAt == b.CreatedAt && a.UpdatedAt == b.UpdatedAt
+}
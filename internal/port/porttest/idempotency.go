@@ -0,0 +1,92 @@
+package porttest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+// IdempotencyStore checks the leases and the stored responses of an idempotency store.
+// Every subtest uses a new scope, so the store may be shared.
+func IdempotencyStore(t *testing.T, store port.IdempotencyStore) {
+	ctx := context.Background()
+	fingerprint := []byte("POST /subscriptions")
+	resp := entity.IdempotentResponse{StatusCode: 201, ContentType: "application/json", Body: []byte(`{}`)}
+
+	t.Run("replay", func(t *testing.T) {
+		scope, key := uuid.NewString(), uuid.NewString()
+
+		stored, err := store.Acquire(ctx, scope, key, "first", fingerprint, time.Minute)
+		if stored != nil || err != nil {
+			t.Fatalf("acquire a new key: %+v, %v", stored, err)
+		}
+		_, err = store.Acquire(ctx, scope, key, "second", fingerprint, time.Minute)
+		if !errors.Is(err, port.ErrIdempotencyInProgress) {
+			t.Errorf("acquire a leased key: %v, want %v", err, port.ErrIdempotencyInProgress)
+		}
+		_, err = store.Acquire(ctx, scope, key, "second", []byte("other"), time.Minute)
+		if !errors.Is(err, port.ErrIdempotencyKeyReused) {
+			t.Errorf("acquire with another request: %v, want %v", err, port.ErrIdempotencyKeyReused)
+		}
+
+		if err := store.Complete(ctx, scope, key, "first", resp, time.Hour); err != nil {
+			t.Fatalf("complete: %v", err)
+		}
+		stored, err = store.Acquire(ctx, scope, key, "second", fingerprint, time.Minute)
+		if err != nil || stored == nil || stored.StatusCode != resp.StatusCode || string(stored.Body) != string(resp.Body) {
+			t.Errorf("acquire a completed key: %+v, %v, want %+v", stored, err, resp)
+		}
+	})
+
+	t.Run("lost lease", func(t *testing.T) {
+		scope, key := uuid.NewString(), uuid.NewString()
+
+		if _, err := store.Acquire(ctx, scope, key, "first", fingerprint, time.Millisecond); err != nil {
+			t.Fatalf("acquire: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		// The expired lease is taken over, and its holder can neither complete nor release
+		// the key any more.
+		stored, err := store.Acquire(ctx, scope, key, "second", fingerprint, time.Minute)
+		if stored != nil || err != nil {
+			t.Fatalf("acquire an expired lease: %+v, %v", stored, err)
+		}
+		err = store.Complete(ctx, scope, key, "first", resp, time.Hour)
+		if !errors.Is(err, port.ErrIdempotencyLeaseLost) {
+			t.Errorf("complete a lost lease: %v, want %v", err, port.ErrIdempotencyLeaseLost)
+		}
+		if err := store.Release(ctx, scope, key, "first"); err != nil {
+			t.Errorf("release a lost lease: %v", err)
+		}
+		_, err = store.Acquire(ctx, scope, key, "third", fingerprint, time.Minute)
+		if !errors.Is(err, port.ErrIdempotencyInProgress) {
+			t.Errorf("acquire after the lost lease is released: %v, want %v", err, port.ErrIdempotencyInProgress)
+		}
+
+		if err := store.Complete(ctx, scope, key, "second", resp, time.Hour); err != nil {
+			t.Errorf("complete the lease that took over: %v", err)
+		}
+	})
+
+	t.Run("release", func(t *testing.T) {
+		scope, key := uuid.NewString(), uuid.NewString()
+
+		if _, err := store.Acquire(ctx, scope, key, "first", fingerprint, time.Minute); err != nil {
+			t.Fatalf("acquire: %v", err)
+		}
+		if err := store.Release(ctx, scope, key, "first"); err != nil {
+			t.Fatalf("release: %v", err)
+		}
+		stored, err := store.Acquire(ctx, scope, key, "second", fingerprint, time.Minute)
+		if stored != nil || err != nil {
+			t.Errorf("acquire a released key: %+v, %v", stored, err)
+		}
+	})
+}
@@ -0,0 +1,154 @@
+package porttest
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+// RemindingRepo is a SubscriptionRepo that also serves the reminders.
+type RemindingRepo interface {
+	port.SubscriptionRepo
+	port.ReminderRepo
+}
+
+// ReminderRepo checks the reminder port. ReminderTargets sees every tenant, so only the
+// subscriptions created by the test are looked at.
+func ReminderRepo(t *testing.T, repo RemindingRepo) {
+	t.Run("preferences", func(t *testing.T) {
+		ctx := tenantContext()
+		userID := uuid.NewString()
+
+		if _, err := repo.GetReminderPreferences(ctx, userID); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("get unset preferences: %v, want %v", err, port.ErrNotFound)
+		}
+
+		prefs := entity.ReminderPreferences{UserID: userID, DaysBefore: 7, Renewals: true, Email: "user@example.com", UpdatedAt: 1}
+		if err := repo.PutReminderPreferences(ctx, prefs); err != nil {
+			t.Fatalf("put: %v", err)
+		}
+
+		prefs.DaysBefore, prefs.Renewals, prefs.PriceChanges, prefs.UpdatedAt = 3, false, true, 2
+		if err := repo.PutReminderPreferences(ctx, prefs); err != nil {
+			t.Fatalf("put again: %v", err)
+		}
+
+		got, err := repo.GetReminderPreferences(ctx, userID)
+		if err != nil || *got != prefs {
+			t.Errorf("get = %+v, %v, want %+v", got, err, prefs)
+		}
+
+		if _, err := repo.GetReminderPreferences(tenantContext(), userID); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("get in another tenant: %v, want %v", err, port.ErrNotFound)
+		}
+	})
+
+	t.Run("targets", func(t *testing.T) {
+		ctx := tenantContext()
+
+		open := newSubscription("Okko", month(2025, time.January), nil)
+		ending := newSubscription("Ivi", month(2025, time.January), ptr(month(2025, time.June)))
+		ending.UserID = open.UserID
+		ended := newSubscription("Kion", month(2025, time.January), ptr(month(2025, time.April)))
+		ended.UserID = open.UserID
+		unwanted := newSubscription("Okko", month(2025, time.January), nil)
+		silent := newSubscription("Okko", month(2025, time.January), nil)
+
+		for _, sub := range []entity.CreateSubscriptionRequest{open, ending, ended, unwanted, silent} {
+			mustCreate(t, ctx, repo, sub)
+		}
+
+		prefs := entity.ReminderPreferences{UserID: open.UserID, DaysBefore: 7, Ends: true, UpdatedAt: 1}
+		for _, p := range []entity.ReminderPreferences{prefs, {UserID: silent.UserID, DaysBefore: 7, UpdatedAt: 1}} {
+			if err := repo.PutReminderPreferences(ctx, p); err != nil {
+				t.Fatalf("put preferences: %v", err)
+			}
+		}
+
+		targets, err := repo.ReminderTargets(context.Background(), month(2025, time.May))
+		if err != nil {
+			t.Fatalf("reminder targets: %v", err)
+		}
+
+		ids := []string{open.ID, ending.ID, ended.ID, unwanted.ID, silent.ID}
+		targets = slices.DeleteFunc(targets, func(target entity.ReminderTarget) bool {
+			return !slices.Contains(ids, target.ID)
+		})
+		slices.SortFunc(targets, func(a, b entity.ReminderTarget) int {
+			return slices.Index(ids, a.ID) - slices.Index(ids, b.ID)
+		})
+
+		if len(targets) != 2 || !equal(targets[0].Subscription, entity.Subscription(open)) ||
+			!equal(targets[1].Subscription, entity.Subscription(ending)) {
+			t.Fatalf("targets = %+v, want %s and %s", targets, open.ID, ending.ID)
+		}
+		for _, target := range targets {
+			if target.TenantID != tenantOf(ctx) || target.Preferences != prefs {
+				t.Errorf("target %s of tenant %s with %+v, want %s with %+v",
+					target.ID, target.TenantID, target.Preferences, tenantOf(ctx), prefs)
+			}
+		}
+	})
+
+	t.Run("send once", func(t *testing.T) {
+		ctx := tenantContext()
+
+		sub := newSubscription("Okko", month(2025, time.January), ptr(month(2025, time.June)))
+		mustCreate(t, ctx, repo, sub)
+
+		reminder := entity.Reminder{
+			SubscriptionID: sub.ID,
+			UserID:         sub.UserID,
+			Kind:           entity.ReminderEnd,
+			Date:           month(2025, time.July),
+		}
+
+		failure := errors.New("smtp is down")
+		sent, err := repo.SendOnce(ctx, reminder, func(context.Context) error { return failure })
+		if !errors.Is(err, failure) || sent {
+			t.Errorf("send failing = %v, %v, want %v", sent, err, failure)
+		}
+
+		var calls atomic.Int32
+		send := func(context.Context) error {
+			calls.Add(1)
+			return nil
+		}
+
+		if sent, err = repo.SendOnce(ctx, reminder, send); err != nil || !sent {
+			t.Errorf("send after a failure = %v, %v, want true", sent, err)
+		}
+		if sent, err = repo.SendOnce(ctx, reminder, send); err != nil || sent {
+			t.Errorf("send again = %v, %v, want false", sent, err)
+		}
+
+		other := reminder
+		other.Date = month(2025, time.June)
+		other.Kind = entity.ReminderPriceChange
+
+		var wg sync.WaitGroup
+		for range 4 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := repo.SendOnce(ctx, other, send); err != nil {
+					t.Errorf("send concurrently: %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if n := calls.Load(); n != 2 {
+			t.Errorf("sent %d times, want each of the two reminders once", n)
+		}
+	})
+}
@@ -0,0 +1,229 @@
+package porttest
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+// RenewableRepo is a SubscriptionRepo that also serves the renewal job.
+type RenewableRepo interface {
+	port.SubscriptionRepo
+	port.RenewalRepo
+}
+
+// RenewalRepo checks the renewal port. DueRenewals sees every tenant, so only the
+// subscriptions created by the test are looked at.
+func RenewalRepo(t *testing.T, repo RenewableRepo) {
+	t.Run("due renewals", func(t *testing.T) {
+		ctx, otherCtx := tenantContext(), tenantContext()
+
+		due := renewing(newSubscription("Okko", month(2024, time.April), ptr(month(2025, time.March))), 12)
+		mustCreate(t, ctx, repo, due)
+
+		later := renewing(newSubscription("Okko", month(2024, time.June), ptr(month(2025, time.May))), 1)
+		mustCreate(t, otherCtx, repo, later)
+
+		fixed := newSubscription("Ivi", month(2024, time.April), ptr(month(2025, time.March)))
+		mustCreate(t, ctx, repo, fixed)
+
+		cancelled := renewing(newSubscription("Kion", month(2024, time.April), ptr(month(2025, time.March))), 12)
+		mustCreate(t, ctx, repo, cancelled)
+		_, err := repo.Cancel(ctx, cancelled.ID, func(current entity.Subscription) (entity.Subscription, error) {
+			current.Cancellation = &entity.Cancellation{Reason: "other", EffectiveDate: month(2025, time.March), CancelledAt: 1}
+			return current, nil
+		})
+		if err != nil {
+			t.Fatalf("cancel: %v", err)
+		}
+
+		ids := []string{due.ID, later.ID, fixed.ID, cancelled.ID}
+
+		subs := dueRenewals(t, repo, month(2025, time.April), ids)
+		if len(subs) != 1 || subs[0].TenantID != tenantOf(ctx) || !equal(subs[0].Subscription, entity.Subscription(due)) {
+			t.Errorf("due renewals in April = %+v, want %+v", subs, due)
+		}
+
+		subs = dueRenewals(t, repo, month(2025, time.May), ids)
+		if len(subs) != 2 || subs[0].ID != due.ID || subs[1].ID != later.ID || subs[1].TenantID != tenantOf(otherCtx) {
+			t.Errorf("due renewals in May = %+v, want %s and %s of the other tenant", subs, due.ID, later.ID)
+		}
+	})
+
+	t.Run("renew", func(t *testing.T) {
+		ctx := tenantContext()
+
+		sub := renewing(newSubscription("Okko", month(2024, time.April), ptr(month(2025, time.March))), 12)
+		mustCreate(t, ctx, repo, sub)
+
+		event := renewalEvent(sub.ID, month(2025, time.March), month(2026, time.March))
+		renewed, err := repo.Renew(ctx, event)
+		if err != nil || !renewed {
+			t.Fatalf("renew = %v, %v, want true", renewed, err)
+		}
+
+		want := entity.Subscription(sub)
+		want.EndDate = ptr(month(2026, time.March))
+		want.UpdatedAt = event.CreatedAt
+
+		got, err := repo.GetSubscription(ctx, sub.ID)
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		if !equal(*got, want) {
+			t.Errorf("get after renew = %+v, want %+v", *got, want)
+		}
+
+		// The same renewal run again finds the end date moved.
+		renewed, err = repo.Renew(ctx, renewalEvent(sub.ID, month(2025, time.March), month(2026, time.March)))
+		if err != nil || renewed {
+			t.Errorf("second renew = %v, %v, want false", renewed, err)
+		}
+
+		next := renewalEvent(sub.ID, month(2026, time.March), month(2027, time.March))
+		if renewed, err = repo.Renew(ctx, next); err != nil || !renewed {
+			t.Fatalf("renew the next term = %v, %v, want true", renewed, err)
+		}
+
+		events, err := repo.ListEvents(ctx, sub.ID)
+		if err != nil {
+			t.Fatalf("list events: %v", err)
+		}
+		if !sameEvents(events, []entity.SubscriptionEvent{event, next}) {
+			t.Errorf("events = %+v, want %+v and %+v", events, event, next)
+		}
+
+		if renewed, err = repo.Renew(tenantContext(), next); err != nil || renewed {
+			t.Errorf("renew in another tenant = %v, %v, want false", renewed, err)
+		}
+		if _, err := repo.ListEvents(tenantContext(), sub.ID); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("list events in another tenant: %v, want %v", err, port.ErrNotFound)
+		}
+		if _, err := repo.ListEvents(ctx, uuid.NewString()); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("list events of an unknown id: %v, want %v", err, port.ErrNotFound)
+		}
+
+		if err := repo.Delete(ctx, sub.ID); err != nil {
+			t.Fatalf("delete with events: %v", err)
+		}
+	})
+
+	t.Run("renew conflicts", func(t *testing.T) {
+		ctx := tenantContext()
+
+		sub := renewing(newSubscription("Okko", month(2024, time.April), ptr(month(2025, time.March))), 12)
+		mustCreate(t, ctx, repo, sub)
+
+		next := newSubscription("Okko", month(2025, time.September), nil)
+		next.UserID = sub.UserID
+		mustCreate(t, ctx, repo, next)
+
+		renewed, err := repo.Renew(ctx, renewalEvent(sub.ID, month(2025, time.March), month(2026, time.March)))
+		if !errors.Is(err, port.ErrSubscriptionAlreadyExists) || renewed {
+			t.Errorf("renew into another subscription = %v, %v, want %v", renewed, err, port.ErrSubscriptionAlreadyExists)
+		}
+
+		events, err := repo.ListEvents(ctx, sub.ID)
+		if err != nil || len(events) != 0 {
+			t.Errorf("events after a failed renewal = %+v, %v, want none", events, err)
+		}
+
+		fixed := newSubscription("Ivi", month(2024, time.April), ptr(month(2025, time.March)))
+		mustCreate(t, ctx, repo, fixed)
+
+		renewed, err = repo.Renew(ctx, renewalEvent(fixed.ID, month(2025, time.March), month(2026, time.March)))
+		if err != nil || renewed {
+			t.Errorf("renew without a renewal = %v, %v, want false", renewed, err)
+		}
+	})
+
+	t.Run("lock", func(t *testing.T) {
+		ctx := context.Background()
+
+		unlock, ok, err := repo.TryLock(ctx)
+		if err != nil || !ok {
+			t.Fatalf("try lock = %v, %v, want the lock", ok, err)
+		}
+
+		if _, ok, err := repo.TryLock(ctx); err != nil || ok {
+			t.Errorf("try lock while locked = %v, %v, want false", ok, err)
+		}
+
+		unlock()
+
+		unlock, ok, err = repo.TryLock(ctx)
+		if err != nil || !ok {
+			t.Fatalf("try lock after unlock = %v, %v, want the lock", ok, err)
+		}
+		unlock()
+	})
+}
+
+func renewing(sub entity.CreateSubscriptionRequest, termMonths int) entity.CreateSubscriptionRequest {
+	sub.Renewal = &entity.Renewal{TermMonths: termMonths}
+	return sub
+}
+
+func renewalEvent(id string, previous, end time.Time) entity.SubscriptionEvent {
+	return entity.SubscriptionEvent{
+		ID:             uuid.NewString(),
+		SubscriptionID: id,
+		Type:           entity.EventRenewed,
+		Renewal: &entity.RenewalEvent{
+			PreviousEndDate: previous,
+			EndDate:         end,
+			TermMonths:      entity.MonthsBetween(previous, end) - 1,
+		},
+		CreatedAt: time.Now().UnixMilli(),
+	}
+}
+
+// dueRenewals lists the due renewals among the subscriptions with ids.
+func dueRenewals(t *testing.T, repo RenewableRepo, until time.Time, ids []string) []entity.TenantSubscription {
+	t.Helper()
+
+	subs, err := repo.DueRenewals(context.Background(), until)
+	if err != nil {
+		t.Fatalf("due renewals: %v", err)
+	}
+
+	return slices.DeleteFunc(subs, func(s entity.TenantSubscription) bool {
+		return !slices.Contains(ids, s.ID)
+	})
+}
+
+func tenantOf(ctx context.Context) string {
+	tenantID, _ := entity.TenantFromContext(ctx)
+	return tenantID
+}
+
+func sameEvents(a, b []entity.SubscriptionEvent) bool {
+	return slices.EqualFunc(a, b, func(x, y entity.SubscriptionEvent) bool {
+		if x.ID != y.ID || x.SubscriptionID != y.SubscriptionID || x.Type != y.Type || x.CreatedAt != y.CreatedAt ||
+			(x.Renewal == nil) != (y.Renewal == nil) || (x.BudgetAlert == nil) != (y.BudgetAlert == nil) {
+			return false
+		}
+
+		if x.BudgetAlert != nil {
+			a, b := *x.BudgetAlert, *y.BudgetAlert
+			if a.Month.Format(time.DateOnly) != b.Month.Format(time.DateOnly) {
+				return false
+			}
+			a.Month, b.Month = time.Time{}, time.Time{}
+			if a != b {
+				return false
+			}
+		}
+
+		return x.Renewal == nil || x.Renewal.TermMonths == y.Renewal.TermMonths &&
+			x.Renewal.PreviousEndDate.Format(time.DateOnly) == y.Renewal.PreviousEndDate.Format(time.DateOnly) &&
+			x.Renewal.EndDate.Format(time.DateOnly) == y.Renewal.EndDate.Format(time.DateOnly)
+	})
+}
@@ -0,0 +1,245 @@
+package porttest
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+// CatalogRepo is a SubscriptionRepo that also keeps the service catalog.
+type CatalogRepo interface {
+	port.SubscriptionRepo
+	port.ServiceRepo
+}
+
+// ServiceRepo checks the service port and the links of subscriptions to services.
+func ServiceRepo(t *testing.T, repo CatalogRepo) {
+	t.Run("crud", func(t *testing.T) {
+		ctx := tenantContext()
+
+		plus := entity.Service{
+			ID: uuid.NewString(), Name: "Yandex Plus", Aliases: []string{"Яндекс Плюс", "Plus"}, Category: "video",
+			VendorURL: "https://plus.yandex.ru", DefaultPrice: ptr(int64(400)), Currency: "RUB", CreatedAt: 1, UpdatedAt: 1,
+		}
+		okko := entity.Service{ID: uuid.NewString(), Name: "Okko", Currency: "RUB", CreatedAt: 1, UpdatedAt: 1}
+		for _, s := range []entity.Service{plus, okko} {
+			if err := repo.CreateService(ctx, s); err != nil {
+				t.Fatalf("create %s: %v", s.Name, err)
+			}
+		}
+
+		for _, name := range []string{"OKKO", " plus "} {
+			taken := entity.Service{ID: uuid.NewString(), Name: "Kion", Aliases: []string{name}, Currency: "RUB"}
+			if err := repo.CreateService(ctx, taken); !errors.Is(err, port.ErrServiceAlreadyExists) {
+				t.Errorf("create a service also named %q: %v, want %v", name, err, port.ErrServiceAlreadyExists)
+			}
+		}
+
+		services, err := repo.ListServices(ctx, entity.ServiceFilter{})
+		if err != nil || len(services) != 2 || !sameService(services[0], okko) || !sameService(services[1], plus) {
+			t.Fatalf("list = %+v, %v, want %+v and %+v", services, err, okko, plus)
+		}
+		services, err = repo.ListServices(ctx, entity.ServiceFilter{Category: ptr("video")})
+		if err != nil || len(services) != 1 || !sameService(services[0], plus) {
+			t.Errorf("list of video = %+v, %v, want %+v", services, err, plus)
+		}
+
+		for _, name := range []string{"yandex plus", " ЯНДЕКС ПЛЮС "} {
+			if got, err := repo.FindService(ctx, name); err != nil || !sameService(*got, plus) {
+				t.Errorf("find %q = %+v, %v, want %+v", name, got, err, plus)
+			}
+		}
+
+		plus.Name, plus.Aliases, plus.Category, plus.VendorURL, plus.DefaultPrice, plus.UpdatedAt =
+			"Plus Multi", []string{"Yandex Plus"}, "", "", nil, 2
+		if err := repo.UpdateService(ctx, plus); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+		if got, err := repo.GetService(ctx, plus.ID); err != nil || !sameService(*got, plus) {
+			t.Errorf("get = %+v, %v, want %+v", got, err, plus)
+		}
+		if _, err := repo.FindService(ctx, "Яндекс Плюс"); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("find a removed alias: %v, want %v", err, port.ErrNotFound)
+		}
+
+		okko.Aliases = []string{"plus multi"}
+		if err := repo.UpdateService(ctx, okko); !errors.Is(err, port.ErrServiceAlreadyExists) {
+			t.Errorf("update into a taken name: %v, want %v", err, port.ErrServiceAlreadyExists)
+		}
+
+		other := tenantContext()
+		if _, err := repo.GetService(other, plus.ID); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("get in another tenant: %v, want %v", err, port.ErrNotFound)
+		}
+		if _, err := repo.FindService(other, "Okko"); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("find in another tenant: %v, want %v", err, port.ErrNotFound)
+		}
+		if err := repo.UpdateService(other, plus); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("update in another tenant: %v, want %v", err, port.ErrNotFound)
+		}
+		if err := repo.DeleteService(other, plus.ID); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("delete in another tenant: %v, want %v", err, port.ErrNotFound)
+		}
+		// Names are unique within a tenant only.
+		if err := repo.CreateService(other, entity.Service{ID: uuid.NewString(), Name: "Okko", Currency: "RUB"}); err != nil {
+			t.Errorf("create Okko in another tenant: %v", err)
+		}
+
+		if err := repo.DeleteService(ctx, plus.ID); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if _, err := repo.GetService(ctx, plus.ID); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("get deleted: %v, want %v", err, port.ErrNotFound)
+		}
+		if _, err := repo.FindService(ctx, "Plus Multi"); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("find deleted: %v, want %v", err, port.ErrNotFound)
+		}
+		if err := repo.DeleteService(ctx, plus.ID); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("delete again: %v, want %v", err, port.ErrNotFound)
+		}
+	})
+
+	t.Run("links", func(t *testing.T) {
+		ctx, other := tenantContext(), tenantContext()
+
+		unlinked := newSubscription("okko ", month(2025, time.January), nil)
+		mustCreate(t, ctx, repo, unlinked)
+		elsewhere := newSubscription("Okko", month(2025, time.January), nil)
+		mustCreate(t, other, repo, elsewhere)
+
+		okko := entity.Service{ID: uuid.NewString(), Name: "Okko", Currency: "RUB"}
+		if err := repo.CreateService(ctx, okko); err != nil {
+			t.Fatalf("create service: %v", err)
+		}
+
+		linked := newSubscription("Okko", month(2025, time.March), nil)
+		linked.ServiceID = &okko.ID
+		mustCreate(t, ctx, repo, linked)
+		ivi := newSubscription("Ivi", month(2025, time.January), nil)
+		mustCreate(t, ctx, repo, ivi)
+
+		unknown := newSubscription("Kion", month(2025, time.January), nil)
+		unknown.ServiceID = ptr(uuid.NewString())
+		if err := repo.Create(ctx, unknown); !errors.Is(err, port.ErrServiceNotFound) {
+			t.Errorf("create with an unknown service: %v, want %v", err, port.ErrServiceNotFound)
+		}
+		moved := entity.UpdateSubscriptionRequest(ivi)
+		moved.ServiceID, moved.UpdatedAt = unknown.ServiceID, moved.UpdatedAt+1
+		if err := repo.Update(ctx, moved); !errors.Is(err, port.ErrServiceNotFound) {
+			t.Errorf("update to an unknown service: %v, want %v", err, port.ErrServiceNotFound)
+		}
+
+		got, err := repo.GetSubscription(ctx, unlinked.ID)
+		if err != nil || got.ServiceID == nil || *got.ServiceID != okko.ID || got.Title != "okko " {
+			t.Errorf("subscription created before its service = %+v, %v, want it linked with its title", got, err)
+		}
+		if got, err := repo.GetSubscription(other, elsewhere.ID); err != nil || got.ServiceID != nil {
+			t.Errorf("subscription of another tenant = %+v, %v, want it unlinked", got, err)
+		}
+
+		byService := entity.ListSubscriptionFilter{ServiceID: &okko.ID}
+		want := []string{unlinked.ID, linked.ID}
+		slices.Sort(want)
+		subs, err := repo.List(ctx, byService)
+		if err != nil || !slices.Equal(sortedIDs(subs), want) {
+			t.Errorf("list by service = %v, %v, want %v", sortedIDs(subs), err, want)
+		}
+		active, err := repo.ListActive(ctx, entity.PeriodFilter{
+			ServiceID: &okko.ID, From: month(2025, time.February), To: month(2025, time.February),
+		})
+		if err != nil || len(active) != 1 || active[0].ID != unlinked.ID {
+			t.Errorf("active in 02-2025 by service = %+v, %v, want only %s", active, err, unlinked.ID)
+		}
+		sum, err := repo.Sum(ctx, entity.ListSubscriptionFilter{ServiceID: &okko.ID, EndDate: ptr(month(2025, time.April))})
+		if err != nil || sum != 2400 {
+			t.Errorf("sum by service by 04-2025 = %d, %v, want 2400", sum, err)
+		}
+
+		for _, id := range []string{unlinked.ID, ivi.ID} {
+			_, err := repo.Cancel(ctx, id, func(current entity.Subscription) (entity.Subscription, error) {
+				current = current.EndAt(month(2025, time.May))
+				current.Cancellation = &entity.Cancellation{Reason: "not_using", EffectiveDate: month(2025, time.May), CancelledAt: 1}
+				return current, nil
+			})
+			if err != nil {
+				t.Fatalf("cancel: %v", err)
+			}
+		}
+		counts, err := repo.CountCancellations(ctx, entity.CancellationFilter{ServiceID: &okko.ID})
+		wantCounts := []entity.CancellationCount{{Month: month(2025, time.May), Title: "okko ", Reason: "not_using", Count: 1}}
+		if err != nil || !sameCounts(counts, wantCounts) {
+			t.Errorf("count cancellations by service = %+v, %v, want %+v", counts, err, wantCounts)
+		}
+
+		update := entity.UpdateSubscriptionRequest(linked)
+		update.ServiceID, update.Title, update.UpdatedAt = nil, "Okko Premium", update.UpdatedAt+1
+		if err := repo.Update(ctx, update); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+		if got, err := repo.GetSubscription(ctx, linked.ID); err != nil || got.ServiceID != nil {
+			t.Errorf("subscription updated without a service = %+v, %v, want it unlinked", got, err)
+		}
+
+		if err := repo.DeleteService(ctx, okko.ID); err != nil {
+			t.Fatalf("delete service: %v", err)
+		}
+		if got, err := repo.GetSubscription(ctx, unlinked.ID); err != nil || got.ServiceID != nil || got.Title != "okko " {
+			t.Errorf("subscription of a deleted service = %+v, %v, want it unlinked with its title", got, err)
+		}
+	})
+
+	t.Run("overlap", func(t *testing.T) {
+		ctx := tenantContext()
+
+		latin := newSubscription("Yandex Plus", month(2025, time.January), nil)
+		mustCreate(t, ctx, repo, latin)
+		cyrillic := newSubscription("Яндекс Плюс", month(2025, time.March), nil)
+		cyrillic.UserID = latin.UserID
+		mustCreate(t, ctx, repo, cyrillic)
+
+		// The names of one service are one service to the no-overlap constraint, so the
+		// service can't link both subscriptions.
+		plus := entity.Service{ID: uuid.NewString(), Name: "Yandex Plus", Aliases: []string{"Яндекс Плюс"}, Currency: "RUB"}
+		if err := repo.CreateService(ctx, plus); !errors.Is(err, port.ErrSubscriptionAlreadyExists) {
+			t.Errorf("create a service over overlapping subscriptions: %v, want %v", err, port.ErrSubscriptionAlreadyExists)
+		}
+		if got, err := repo.GetSubscription(ctx, cyrillic.ID); err != nil || got.ServiceID != nil {
+			t.Errorf("subscription after the rejected service = %+v, %v, want it unlinked", got, err)
+		}
+
+		plus.Aliases = nil
+		if err := repo.CreateService(ctx, plus); err != nil {
+			t.Fatalf("create service: %v", err)
+		}
+		plus.Aliases = []string{"Яндекс Плюс"}
+		if err := repo.UpdateService(ctx, plus); !errors.Is(err, port.ErrSubscriptionAlreadyExists) {
+			t.Errorf("add an alias over overlapping subscriptions: %v, want %v", err, port.ErrSubscriptionAlreadyExists)
+		}
+
+		again := newSubscription("Яндекс Плюс", month(2025, time.June), nil)
+		again.UserID, again.ServiceID = latin.UserID, &plus.ID
+		if err := repo.Create(ctx, again); !errors.Is(err, port.ErrSubscriptionAlreadyExists) {
+			t.Errorf("create under another name of the service: %v, want %v", err, port.ErrSubscriptionAlreadyExists)
+		}
+	})
+}
+
+func sameService(a, b entity.Service) bool {
+	return a.ID == b.ID && a.Name == b.Name && slices.Equal(a.Aliases, b.Aliases) && a.Category == b.Category &&
+		a.VendorURL == b.VendorURL && samePtr(a.DefaultPrice, b.DefaultPrice) &&
+		a.Currency == b.Currency && a.CreatedAt == b.CreatedAt && a.UpdatedAt == b.UpdatedAt
+}
+
+func samePtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
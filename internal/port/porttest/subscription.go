@@ -0,0 +1,712 @@
+// Package porttest is a conformance suite for implementations of the storage ports. Every
+// adapter runs it from its own tests, so they stay interchangeable.
+package porttest
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"subscription-service/internal/app/entity"
+	"subscription-service/internal/port"
+)
+
+// SubscriptionRepo checks the behaviour the use cases rely on. Every subtest works in a
+// tenant of its own, so repo may be shared and hold other data.
+func SubscriptionRepo(t *testing.T, repo port.SubscriptionRepo) {
+	t.Run("create, get and delete", func(t *testing.T) {
+		ctx := tenantContext()
+
+		sub := newSubscription("Yandex Plus", month(2025, time.January), ptr(month(2025, time.June)))
+		mustCreate(t, ctx, repo, sub)
+
+		got, err := repo.GetSubscription(ctx, sub.ID)
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		if !equal(*got, entity.Subscription(sub)) {
+			t.Fatalf("get = %+v, want %+v", *got, sub)
+		}
+
+		if err := repo.Delete(ctx, sub.ID); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		if _, err := repo.GetSubscription(ctx, sub.ID); !errors.Is(err, port.ErrNotFound) {
+			t.Fatalf("get after delete: err = %v, want ErrNotFound", err)
+		}
+		if err := repo.Delete(ctx, sub.ID); !errors.Is(err, port.ErrNotFound) {
+			t.Fatalf("second delete: err = %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("tenant is required", func(t *testing.T) {
+		ctx := context.Background()
+		id := uuid.NewString()
+
+		errs := map[string]error{
+			"create": repo.Create(ctx, newSubscription("Okko", month(2025, time.January), nil)),
+			"update": repo.Update(ctx, entity.UpdateSubscriptionRequest{ID: id}),
+			"delete": repo.Delete(ctx, id),
+		}
+		_, errs["get"] = repo.GetSubscription(ctx, id)
+		_, errs["list"] = repo.List(ctx, entity.ListSubscriptionFilter{})
+		_, errs["sum"] = repo.Sum(ctx, entity.ListSubscriptionFilter{})
+
+		for op, err := range errs {
+			if !errors.Is(err, port.ErrTenantRequired) {
+				t.Errorf("%s without a tenant: err = %v, want ErrTenantRequired", op, err)
+			}
+		}
+	})
+
+	t.Run("tenants are isolated", func(t *testing.T) {
+		ctx, other := tenantContext(), tenantContext()
+
+		sub := newSubscription("Okko", month(2025, time.January), nil)
+		mustCreate(t, ctx, repo, sub)
+
+		if _, err := repo.GetSubscription(other, sub.ID); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("get from another tenant: err = %v, want ErrNotFound", err)
+		}
+		if err := repo.Delete(other, sub.ID); !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("delete from another tenant: err = %v, want ErrNotFound", err)
+		}
+
+		update := entity.UpdateSubscriptionRequest(sub)
+		update.Price = 1
+		if err := repo.Update(other, update); err != nil {
+			t.Errorf("update from another tenant: %v", err)
+		}
+
+		subs, err := repo.List(other, entity.ListSubscriptionFilter{})
+		if err != nil || len(subs) != 0 {
+			t.Errorf("list of another tenant = %v, %v; want none", subs, err)
+		}
+
+		// The same user may subscribe to the same service in another tenant.
+		twin := newSubscription(sub.Title, sub.StartDate, nil)
+		twin.UserID = sub.UserID
+		mustCreate(t, other, repo, twin)
+
+		got, err := repo.GetSubscription(ctx, sub.ID)
+		if err != nil || got.Price != sub.Price {
+			t.Errorf("subscription after changes from another tenant = %+v, %v", got, err)
+		}
+	})
+
+	t.Run("overlapping periods are excluded", func(t *testing.T) {
+		ctx := tenantContext()
+
+		first := newSubscription("Kinopoisk", month(2025, time.January), ptr(month(2025, time.March)))
+		mustCreate(t, ctx, repo, first)
+
+		sameUser := func(title string, start time.Time, end *time.Time) entity.CreateSubscriptionRequest {
+			sub := newSubscription(title, start, end)
+			sub.UserID = first.UserID
+			return sub
+		}
+
+		conflicts := map[string]entity.CreateSubscriptionRequest{
+			"overlapping":     sameUser(first.Title, month(2025, time.February), ptr(month(2025, time.May))),
+			"sharing a month": sameUser(first.Title, month(2025, time.March), nil),
+			"enclosing":       sameUser(first.Title, month(2024, time.December), ptr(month(2025, time.April))),
+		}
+		for name, sub := range conflicts {
+			if err := repo.Create(ctx, sub); !errors.Is(err, port.ErrSubscriptionAlreadyExists) {
+				t.Errorf("create %s: err = %v, want ErrSubscriptionAlreadyExists", name, err)
+			}
+		}
+
+		duplicateID := newSubscription("Other", month(2025, time.January), nil)
+		duplicateID.ID = first.ID
+		if err := repo.Create(ctx, duplicateID); !errors.Is(err, port.ErrSubscriptionAlreadyExists) {
+			t.Errorf("create with a taken id: err = %v, want ErrSubscriptionAlreadyExists", err)
+		}
+
+		allowed := map[string]entity.CreateSubscriptionRequest{
+			"next month":      sameUser(first.Title, month(2025, time.April), nil),
+			"another service": sameUser("Other", month(2025, time.January), nil),
+			"another user":    newSubscription(first.Title, month(2025, time.January), nil),
+		}
+		for name, sub := range allowed {
+			if err := repo.Create(ctx, sub); err != nil {
+				t.Errorf("create %s: %v", name, err)
+			}
+		}
+
+		// The open-ended subscription from April now blocks extending the first one.
+		update := entity.UpdateSubscriptionRequest(first)
+		update.EndDate = ptr(month(2025, time.April))
+		if err := repo.Update(ctx, update); !errors.Is(err, port.ErrSubscriptionAlreadyExists) {
+			t.Errorf("update into an overlap: err = %v, want ErrSubscriptionAlreadyExists", err)
+		}
+
+		update.EndDate = ptr(month(2025, time.February))
+		if err := repo.Update(ctx, update); err != nil {
+			t.Errorf("update within its own period: %v", err)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		ctx := tenantContext()
+
+		sub := newSubscription("Okko", month(2025, time.January), ptr(month(2025, time.June)))
+		mustCreate(t, ctx, repo, sub)
+
+		update := entity.UpdateSubscriptionRequest{
+			ID:        sub.ID,
+			Title:     "Okko Premium",
+			Price:     999,
+			UserID:    sub.UserID,
+			StartDate: month(2025, time.February),
+			UpdatedAt: sub.UpdatedAt + 1000,
+		}
+		if err := repo.Update(ctx, update); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+
+		got, err := repo.GetSubscription(ctx, sub.ID)
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+
+		want := entity.Subscription(update)
+		want.CreatedAt = sub.CreatedAt
+		if !equal(*got, want) {
+			t.Fatalf("get after update = %+v, want %+v", *got, want)
+		}
+
+		update.ID = uuid.NewString()
+		if err := repo.Update(ctx, update); err != nil {
+			t.Fatalf("update of an unknown id: %v", err)
+		}
+	})
+
+	t.Run("list and sum filters", func(t *testing.T) {
+		ctx := tenantContext()
+
+		alice, bob := uuid.NewString(), uuid.NewString()
+
+		subs := []entity.CreateSubscriptionRequest{
+			newSubscription("Okko", month(2025, time.January), ptr(month(2025, time.March))),
+			newSubscription("Okko", month(2025, time.April), nil),
+			newSubscription("Kinopoisk", month(2025, time.February), ptr(month(2025, time.December))),
+			newSubscription("Okko", month(2025, time.March), ptr(month(2025, time.May))),
+		}
+		subs[0].UserID, subs[1].UserID, subs[2].UserID, subs[3].UserID = alice, alice, alice, bob
+		subs[0].Price, subs[1].Price, subs[2].Price, subs[3].Price = 100, 200, 300, 100
+
+		for _, sub := range subs {
+			mustCreate(t, ctx, repo, sub)
+		}
+
+		cases := []struct {
+			name   string
+			filter entity.ListSubscriptionFilter
+			want   []int
+			sum    int64
+		}{
+			{name: "no filter", want: []int{0, 1, 2, 3}, sum: 3900},
+			{name: "title", filter: entity.ListSubscriptionFilter{Title: ptr("Okko")}, want: []int{0, 1, 3}, sum: 600},
+			{name: "user", filter: entity.ListSubscriptionFilter{UserID: &alice}, want: []int{0, 1, 2}, sum: 3600},
+			{
+				name:   "price, ignored by sum",
+				filter: entity.ListSubscriptionFilter{Price: ptr[int64](100)},
+				want:   []int{0, 3},
+				sum:    3900,
+			},
+			{
+				name:   "starting from",
+				filter: entity.ListSubscriptionFilter{StartDate: ptr(month(2025, time.March))},
+				want:   []int{1, 3},
+				sum:    3400,
+			},
+			{
+				name:   "ending by",
+				filter: entity.ListSubscriptionFilter{EndDate: ptr(month(2025, time.May))},
+				want:   []int{0, 3},
+				sum:    2200,
+			},
+			{
+				name: "period",
+				filter: entity.ListSubscriptionFilter{
+					StartDate: ptr(month(2025, time.February)),
+					EndDate:   ptr(month(2025, time.December)),
+				},
+				want: []int{2, 3},
+				sum:  5600,
+			},
+		}
+
+		for _, tc := range cases {
+			got, err := repo.List(ctx, tc.filter)
+			if err != nil {
+				t.Fatalf("list by %s: %v", tc.name, err)
+			}
+			if ids, want := sortedIDs(got), pick(subs, tc.want); !slices.Equal(ids, want) {
+				t.Errorf("list by %s = %v, want %v", tc.name, ids, want)
+			}
+
+			sum, err := repo.Sum(ctx, tc.filter)
+			if err != nil {
+				t.Fatalf("sum by %s: %v", tc.name, err)
+			}
+			if sum != tc.sum {
+				t.Errorf("sum by %s = %d, want %d", tc.name, sum, tc.sum)
+			}
+		}
+
+		page, err := repo.List(ctx, entity.ListSubscriptionFilter{Limit: ptr(3)})
+		if err != nil || len(page) != 3 {
+			t.Errorf("list with limit 3 = %d subscriptions, %v", len(page), err)
+		}
+
+		rest, err := repo.List(ctx, entity.ListSubscriptionFilter{Limit: ptr(3), Offset: ptr(3)})
+		if err != nil || len(rest) != 1 {
+			t.Errorf("list with offset 3 = %d subscriptions, %v", len(rest), err)
+		}
+		if len(page) == 3 && len(rest) == 1 && slices.Contains(sortedIDs(page), rest[0].ID) {
+			t.Errorf("pages overlap: %v and %v", sortedIDs(page), rest[0].ID)
+		}
+	})
+
+	t.Run("phases", func(t *testing.T) {
+		ctx := tenantContext()
+
+		sub := newSubscription("Okko", month(2025, time.January), ptr(month(2025, time.December)))
+		sub.Phases = []entity.Phase{
+			{StartDate: month(2025, time.January), EndDate: month(2025, time.March), Price: 0},
+			{StartDate: month(2025, time.April), EndDate: month(2025, time.June), Price: 200},
+		}
+		mustCreate(t, ctx, repo, sub)
+
+		later := newSubscription("Okko", month(2026, time.January), nil)
+		later.UserID = sub.UserID
+		mustCreate(t, ctx, repo, later)
+
+		got, err := repo.GetSubscription(ctx, sub.ID)
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		if !equal(*got, entity.Subscription(sub)) {
+			t.Fatalf("get = %+v, want %+v", *got, sub)
+		}
+
+		// Every month costs the price of its phase or else the regular one; the later
+		// subscription has no end, so its months count only up to the end of the filter.
+		for _, tc := range []struct {
+			name   string
+			filter entity.ListSubscriptionFilter
+			sum    int64
+		}{
+			{name: "every month", filter: entity.ListSubscriptionFilter{UserID: &sub.UserID}, sum: 3000},
+			{
+				name:   "months from may",
+				filter: entity.ListSubscriptionFilter{UserID: &sub.UserID, StartDate: ptr(month(2025, time.May))},
+				sum:    2800,
+			},
+			{
+				name:   "months by june",
+				filter: entity.ListSubscriptionFilter{UserID: &sub.UserID, EndDate: ptr(month(2025, time.June))},
+				sum:    600,
+			},
+			{
+				name:   "months by march of the next year",
+				filter: entity.ListSubscriptionFilter{UserID: &sub.UserID, EndDate: ptr(month(2026, time.March))},
+				sum:    4200,
+			},
+		} {
+			sum, err := repo.Sum(ctx, tc.filter)
+			if err != nil {
+				t.Fatalf("sum of %s: %v", tc.name, err)
+			}
+			if sum != tc.sum {
+				t.Errorf("sum of %s = %d, want %d", tc.name, sum, tc.sum)
+			}
+		}
+
+		active, err := repo.ListActive(ctx, entity.PeriodFilter{
+			UserID: &sub.UserID,
+			From:   month(2025, time.May),
+			To:     month(2025, time.December),
+		})
+		if err != nil {
+			t.Fatalf("list active: %v", err)
+		}
+		if len(active) != 1 || !equal(active[0], entity.Subscription(sub)) {
+			t.Errorf("active from may to december = %+v, want only %s", active, sub.ID)
+		}
+
+		active, err = repo.ListActive(ctx, entity.PeriodFilter{
+			Title: ptr("Okko"),
+			From:  month(2025, time.December),
+			To:    month(2026, time.March),
+		})
+		if err != nil {
+			t.Fatalf("list active: %v", err)
+		}
+		if ids, want := sortedIDs(active), sortedIDs([]entity.Subscription{entity.Subscription(sub), entity.Subscription(later)}); !slices.Equal(ids, want) {
+			t.Errorf("active from december to march = %v, want %v", ids, want)
+		}
+
+		update := entity.UpdateSubscriptionRequest(sub)
+		update.Phases = nil
+		update.UpdatedAt++
+		if err := repo.Update(ctx, update); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+
+		got, err = repo.GetSubscription(ctx, sub.ID)
+		if err != nil {
+			t.Fatalf("get after update: %v", err)
+		}
+		if len(got.Phases) != 0 {
+			t.Errorf("phases after removing them = %+v", got.Phases)
+		}
+	})
+
+	t.Run("pauses", func(t *testing.T) {
+		ctx := tenantContext()
+
+		sub := newSubscription("Okko", month(2025, time.January), ptr(month(2025, time.June)))
+		// Pauses are changed only by UpdatePauses.
+		sub.Pauses = []entity.Pause{{StartDate: month(2025, time.January)}}
+		mustCreate(t, ctx, repo, sub)
+		sub.Pauses = nil
+
+		pauses := []entity.Pause{
+			{StartDate: month(2025, time.February), EndDate: ptr(month(2025, time.March))},
+			{StartDate: month(2025, time.May)},
+		}
+
+		updated, err := repo.UpdatePauses(ctx, sub.ID, sub.UpdatedAt+1,
+			func(current entity.Subscription) ([]entity.Pause, error) {
+				if !equal(current, entity.Subscription(sub)) {
+					t.Errorf("modify got %+v, want %+v", current, sub)
+				}
+				return pauses, nil
+			})
+		if err != nil {
+			t.Fatalf("update pauses: %v", err)
+		}
+
+		want := entity.Subscription(sub)
+		want.Pauses = pauses
+		want.UpdatedAt++
+		if !equal(*updated, want) {
+			t.Errorf("update pauses = %+v, want %+v", *updated, want)
+		}
+
+		update := entity.UpdateSubscriptionRequest(want)
+		update.Pauses = nil
+		update.Price = 500
+		if err := repo.Update(ctx, update); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+
+		got, err := repo.GetSubscription(ctx, sub.ID)
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		want.Price = 500
+		if !equal(*got, want) {
+			t.Errorf("get after update = %+v, want the pauses kept: %+v", *got, want)
+		}
+
+		failure := errors.New("rejected")
+		_, err = repo.UpdatePauses(ctx, sub.ID, sub.UpdatedAt+2, func(entity.Subscription) ([]entity.Pause, error) {
+			return nil, failure
+		})
+		if !errors.Is(err, failure) {
+			t.Errorf("update pauses with a failing modify: %v, want %v", err, failure)
+		}
+
+		_, err = repo.UpdatePauses(ctx, uuid.NewString(), sub.UpdatedAt, func(entity.Subscription) ([]entity.Pause, error) {
+			t.Error("modify called for an unknown id")
+			return nil, nil
+		})
+		if !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("update pauses of an unknown id: %v, want %v", err, port.ErrNotFound)
+		}
+
+		// Only the unpaused months count: those of January and April here, while the
+		// others are paused throughout, by one pause or by two adjacent ones.
+		paused := newSubscription("Kion", month(2025, time.January), ptr(month(2025, time.March)))
+		adjacent := newSubscription("Ivi", month(2025, time.January), ptr(month(2025, time.April)))
+		for _, other := range []struct {
+			sub    entity.CreateSubscriptionRequest
+			pauses []entity.Pause
+		}{
+			{paused, []entity.Pause{{StartDate: month(2025, time.January)}}},
+			{adjacent, []entity.Pause{
+				{StartDate: month(2025, time.January), EndDate: ptr(month(2025, time.February))},
+				{StartDate: month(2025, time.March), EndDate: ptr(month(2025, time.April))},
+			}},
+		} {
+			other.sub.UserID = sub.UserID
+			mustCreate(t, ctx, repo, other.sub)
+
+			_, err = repo.UpdatePauses(ctx, other.sub.ID, other.sub.UpdatedAt,
+				func(entity.Subscription) ([]entity.Pause, error) { return other.pauses, nil })
+			if err != nil {
+				t.Fatalf("update pauses of %s: %v", other.sub.Title, err)
+			}
+		}
+
+		for _, tc := range []struct {
+			title *string
+			sum   int64
+		}{
+			{ptr("Okko"), 1000},
+			{ptr("Kion"), 0},
+			{ptr("Ivi"), 0},
+			{nil, 1000},
+		} {
+			sum, err := repo.Sum(ctx, entity.ListSubscriptionFilter{
+				Title:     tc.title,
+				UserID:    &sub.UserID,
+				StartDate: ptr(month(2025, time.January)),
+				EndDate:   ptr(month(2025, time.December)),
+			})
+			if err != nil {
+				t.Fatalf("sum of %v: %v", tc.title, err)
+			}
+			if sum != tc.sum {
+				t.Errorf("sum of %v = %d, want %d", tc.title, sum, tc.sum)
+			}
+		}
+	})
+
+	t.Run("cancellations", func(t *testing.T) {
+		ctx := tenantContext()
+
+		sub := newSubscription("Okko", month(2025, time.January), nil)
+		sub.Phases = []entity.Phase{{StartDate: month(2025, time.January), EndDate: month(2025, time.June), Price: 0}}
+		// The cancellation is set only by Cancel.
+		sub.Cancellation = &entity.Cancellation{Reason: "other", EffectiveDate: month(2025, time.January)}
+		mustCreate(t, ctx, repo, sub)
+		sub.Cancellation = nil
+
+		cancellation := &entity.Cancellation{
+			Reason:        "too_expensive",
+			Note:          "family plan",
+			EffectiveDate: month(2025, time.March),
+			CancelledAt:   sub.UpdatedAt + 1,
+		}
+
+		cancelled, err := repo.Cancel(ctx, sub.ID, func(current entity.Subscription) (entity.Subscription, error) {
+			if !equal(current, entity.Subscription(sub)) || current.Cancellation != nil {
+				t.Errorf("cancel got %+v, want %+v", current, sub)
+			}
+			current = current.EndAt(month(2025, time.March))
+			current.Cancellation = cancellation
+			current.UpdatedAt++
+			// Only the columns of a cancellation are written.
+			current.Price = 1
+			return current, nil
+		})
+		if err != nil {
+			t.Fatalf("cancel: %v", err)
+		}
+
+		want := entity.Subscription(sub).EndAt(month(2025, time.March))
+		want.Cancellation = cancellation
+		want.UpdatedAt++
+
+		got, err := repo.GetSubscription(ctx, sub.ID)
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		if !equal(*got, want) || !sameCancellation(got.Cancellation, cancellation) {
+			t.Errorf("get after cancel = %+v %+v, want %+v %+v", *got, got.Cancellation, want, cancellation)
+		}
+		if !equal(*cancelled, want) || !sameCancellation(cancelled.Cancellation, cancellation) {
+			t.Errorf("cancel = %+v, want %+v", *cancelled, want)
+		}
+
+		update := entity.UpdateSubscriptionRequest(want)
+		update.Cancellation = nil
+		if err := repo.Update(ctx, update); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+		if got, err = repo.GetSubscription(ctx, sub.ID); err != nil || !sameCancellation(got.Cancellation, cancellation) {
+			t.Errorf("get after update = %+v, %v, want the cancellation kept", got, err)
+		}
+
+		failure := errors.New("rejected")
+		_, err = repo.Cancel(ctx, sub.ID, func(entity.Subscription) (entity.Subscription, error) {
+			return entity.Subscription{}, failure
+		})
+		if !errors.Is(err, failure) {
+			t.Errorf("cancel with a failing callback: %v, want %v", err, failure)
+		}
+
+		_, err = repo.Cancel(ctx, uuid.NewString(), func(entity.Subscription) (entity.Subscription, error) {
+			t.Error("cancel called for an unknown id")
+			return entity.Subscription{}, nil
+		})
+		if !errors.Is(err, port.ErrNotFound) {
+			t.Errorf("cancel of an unknown id: %v, want %v", err, port.ErrNotFound)
+		}
+
+		for _, c := range []struct {
+			title, reason string
+			effective     time.Time
+		}{
+			{"Kion", "too_expensive", month(2025, time.March)},
+			{"Kion", "not_using", month(2025, time.March)},
+			{"Ivi", "not_using", month(2025, time.May)},
+		} {
+			other := newSubscription(c.title, c.effective, nil)
+			other.UserID = sub.UserID
+			if c.reason == "not_using" && c.title == "Kion" {
+				// Another user's subscription in the same tenant.
+				other.UserID = uuid.NewString()
+			}
+			mustCreate(t, ctx, repo, other)
+
+			_, err := repo.Cancel(ctx, other.ID, func(current entity.Subscription) (entity.Subscription, error) {
+				current = current.EndAt(c.effective)
+				current.Cancellation = &entity.Cancellation{Reason: c.reason, EffectiveDate: c.effective, CancelledAt: 1}
+				return current, nil
+			})
+			if err != nil {
+				t.Fatalf("cancel %s: %v", c.title, err)
+			}
+		}
+		mustCreate(t, ctx, repo, newSubscription("Ivi", month(2025, time.January), ptr(month(2025, time.February))))
+
+		counts, err := repo.CountCancellations(ctx, entity.CancellationFilter{})
+		if err != nil {
+			t.Fatalf("count cancellations: %v", err)
+		}
+		wantCounts := []entity.CancellationCount{
+			{Month: month(2025, time.March), Title: "Kion", Reason: "not_using", Count: 1},
+			{Month: month(2025, time.March), Title: "Kion", Reason: "too_expensive", Count: 1},
+			{Month: month(2025, time.March), Title: "Okko", Reason: "too_expensive", Count: 1},
+			{Month: month(2025, time.May), Title: "Ivi", Reason: "not_using", Count: 1},
+		}
+		if !sameCounts(counts, wantCounts) {
+			t.Errorf("count cancellations = %+v, want %+v", counts, wantCounts)
+		}
+
+		counts, err = repo.CountCancellations(ctx, entity.CancellationFilter{
+			UserID: &sub.UserID,
+			From:   ptr(month(2025, time.March)),
+			To:     ptr(month(2025, time.April)),
+		})
+		if err != nil {
+			t.Fatalf("count cancellations: %v", err)
+		}
+		wantCounts = []entity.CancellationCount{
+			{Month: month(2025, time.March), Title: "Kion", Reason: "too_expensive", Count: 1},
+			{Month: month(2025, time.March), Title: "Okko", Reason: "too_expensive", Count: 1},
+		}
+		if !sameCounts(counts, wantCounts) {
+			t.Errorf("count cancellations of a user in March and April = %+v, want %+v", counts, wantCounts)
+		}
+
+		counts, err = repo.CountCancellations(ctx, entity.CancellationFilter{Title: ptr("Ivi"), To: ptr(month(2025, time.April))})
+		if err != nil || len(counts) != 0 {
+			t.Errorf("count cancellations of Ivi until April = %+v, %v, want none", counts, err)
+		}
+	})
+
+}
+
+func tenantContext() context.Context {
+	return entity.WithTenant(context.Background(), uuid.NewString())
+}
+
+func newSubscription(title string, start time.Time, end *time.Time) entity.CreateSubscriptionRequest {
+	now := time.Now().UnixMilli()
+
+	return entity.CreateSubscriptionRequest{
+		ID:        uuid.NewString(),
+		Title:     title,
+		Price:     400,
+		UserID:    uuid.NewString(),
+		StartDate: start,
+		EndDate:   end,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func mustCreate(t *testing.T, ctx context.Context, repo port.SubscriptionRepo, sub entity.CreateSubscriptionRequest) {
+	t.Helper()
+
+	if err := repo.Create(ctx, sub); err != nil {
+		t.Fatalf("create %s %s: %v", sub.Title, sub.StartDate.Format("01-2006"), err)
+	}
+}
+
+// equal compares subscriptions with dates by calendar day, as they are stored.
+func equal(a, b entity.Subscription) bool {
+	sameDay := func(x, y *time.Time) bool {
+		if x == nil || y == nil {
+			return x == y
+		}
+		return x.Format(time.DateOnly) == y.Format(time.DateOnly)
+	}
+
+	return a.ID == b.ID && a.Title == b.Title && samePtr(a.ServiceID, b.ServiceID) && a.Price == b.Price &&
+		a.UserID == b.UserID &&
+		a.CreatedAt == b.CreatedAt && a.UpdatedAt == b.UpdatedAt &&
+		sameDay(&a.StartDate, &b.StartDate) && sameDay(a.EndDate, b.EndDate) &&
+		slices.EqualFunc(a.Phases, b.Phases, func(x, y entity.Phase) bool {
+			return x.Price == y.Price && sameDay(&x.StartDate, &y.StartDate) && sameDay(&x.EndDate, &y.EndDate)
+		}) &&
+		slices.EqualFunc(a.Pauses, b.Pauses, func(x, y entity.Pause) bool {
+			return sameDay(&x.StartDate, &y.StartDate) && sameDay(x.EndDate, y.EndDate)
+		}) &&
+		(a.Renewal == nil) == (b.Renewal == nil) && (a.Renewal == nil || *a.Renewal == *b.Renewal)
+}
+
+func sameCancellation(a, b *entity.Cancellation) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Reason == b.Reason && a.Note == b.Note && a.CancelledAt == b.CancelledAt &&
+		a.EffectiveDate.Format(time.DateOnly) == b.EffectiveDate.Format(time.DateOnly)
+}
+
+func sameCounts(a, b []entity.CancellationCount) bool {
+	return slices.EqualFunc(a, b, func(x, y entity.CancellationCount) bool {
+		return x.Title == y.Title && x.Reason == y.Reason && x.Count == y.Count &&
+			x.Month.Format(time.DateOnly) == y.Month.Format(time.DateOnly)
+	})
+}
+
+func sortedIDs(subs []entity.Subscription) []string {
+	ids := make([]string, 0, len(subs))
+	for _, s := range subs {
+		ids = append(ids, s.ID)
+	}
+	slices.Sort(ids)
+
+	return ids
+}
+
+func pick(subs []entity.CreateSubscriptionRequest, indexes []int) []string {
+	ids := make([]string, 0, len(indexes))
+	for _, i := range indexes {
+		ids = append(ids, subs[i].ID)
+	}
+	slices.Sort(ids)
+
+	return ids
+}
+
+func month(year int, m time.Month) time.Time {
+	return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,12 @@
+package port
+
+import (
+	"context"
+
+	"subscription-service/internal/app/entity"
+)
+
+// RateLimiter counts a request against the token bucket identified by key.
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, limit entity.RateLimit) (entity.RateLimitDecision, error)
+}
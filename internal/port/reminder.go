@@ -0,0 +1,34 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"subscription-service/internal/app/entity"
+)
+
+//go:generate mockgen -destination ../adapter/repo/mock/reminder_mock.go -package repo -source ./reminder.go
+
+// ReminderRepo keeps the reminder preferences of users and the reminders sent to them.
+type ReminderRepo interface {
+	// GetReminderPreferences returns the preferences of the user in the tenant of ctx,
+	// or ErrNotFound when the user has set none.
+	GetReminderPreferences(ctx context.Context, userID string) (*entity.ReminderPreferences, error)
+	// PutReminderPreferences creates or replaces the preferences of the user.
+	PutReminderPreferences(ctx context.Context, prefs entity.ReminderPreferences) error
+	// ReminderTargets returns, across tenants, the subscriptions of the users with any
+	// kind of reminder enabled that have no end date or end on or after since.
+	ReminderTargets(ctx context.Context, since time.Time) ([]entity.ReminderTarget, error)
+	// SendOnce calls send for a reminder of the tenant of ctx not sent yet and records it
+	// as sent when send succeeds, so a failed reminder is retried by a later call. A
+	// reminder being sent by a concurrent call, also of another replica, is waited for.
+	// It reports whether this call sent the reminder.
+	SendOnce(ctx context.Context, reminder entity.Reminder, send func(context.Context) error) (bool, error)
+}
+
+// Notifier delivers reminders to users through a channel such as email.
+type Notifier interface {
+	// Notify sends the reminder. It returns ErrNoRecipient when the channel has nowhere
+	// to send it, such as email to a user without an address; retrying won't help.
+	Notify(ctx context.Context, reminder entity.Reminder) error
+}
@@ -0,0 +1,28 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"subscription-service/internal/app/entity"
+)
+
+//go:generate mockgen -destination ../adapter/repo/mock/renewal_mock.go -package repo -source ./renewal.go
+
+// RenewalRepo serves the renewal job. Unlike the other ports it sees the subscriptions
+// of all tenants.
+type RenewalRepo interface {
+	// TryLock takes the renewal lock unless another holder, such as another replica of
+	// the service, has it; ok reports whether it did. unlock releases a lock taken.
+	TryLock(ctx context.Context) (unlock func(), ok bool, err error)
+	// DueRenewals returns the subscriptions of all tenants that renew automatically, are
+	// not cancelled and end on or before until.
+	DueRenewals(ctx context.Context, until time.Time) ([]entity.TenantSubscription, error)
+	// Renew moves the end date of the subscription of the event, in the tenant of ctx,
+	// from the previous end date of the event to its end date and stores the event, in
+	// one transaction. It changes nothing and returns false when the subscription no
+	// longer ends on the previous end date, doesn't renew automatically or is cancelled,
+	// so that a renewal is applied once however many times it runs. It returns
+	// ErrSubscriptionAlreadyExists when the new term overlaps another subscription.
+	Renew(ctx context.Context, event entity.SubscriptionEvent) (bool, error)
+}
@@ -0,0 +1,34 @@
+package port
+
+import (
+	"context"
+
+	"subscription-service/internal/app/entity"
+)
+
+//go:generate mockgen -destination ../adapter/repo/mock/service_mock.go -package repo -source ./service.go
+
+// ServiceRepo keeps the service catalog of the tenant of ctx. No two services of a
+// tenant share a name or an alias, compared by entity.ServiceKey.
+type ServiceRepo interface {
+	// CreateService stores a new service and links to it the subscriptions without a
+	// service whose title is its name or one of its aliases. It returns
+	// ErrServiceAlreadyExists when the id, the name or an alias is taken, and
+	// ErrSubscriptionAlreadyExists when subscriptions of a user it would link overlap.
+	CreateService(ctx context.Context, service entity.Service) error
+	// GetService returns the service with id or ErrNotFound.
+	GetService(ctx context.Context, id string) (*entity.Service, error)
+	// UpdateService replaces the name, aliases, category, vendor URL, default price,
+	// currency and update time of the service with the id of service, and links
+	// subscriptions like CreateService. It returns ErrNotFound, ErrServiceAlreadyExists
+	// when another service has one of the new names, or ErrSubscriptionAlreadyExists.
+	UpdateService(ctx context.Context, service entity.Service) error
+	// DeleteService deletes the service with id, unlinking its subscriptions, or returns
+	// ErrNotFound.
+	DeleteService(ctx context.Context, id string) error
+	// ListServices returns the services that pass the filter ordered by name.
+	ListServices(ctx context.Context, filter entity.ServiceFilter) ([]entity.Service, error)
+	// FindService returns the service with name as its name or one of its aliases, or
+	// ErrNotFound.
+	FindService(ctx context.Context, name string) (*entity.Service, error)
+}
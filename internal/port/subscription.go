@@ -9,10 +9,69 @@ import (
 //go:generate mockgen -destination ../adapter/repo/mock/subscription_mock.go -package repo -source ./subscription.go
 
 type SubscriptionRepo interface {
+	// Create and Update return ErrSubscriptionAlreadyExists for a taken id or a period
+	// that overlaps another subscription of the user to the same service, and
+	// ErrServiceNotFound for a ServiceID that is not in the catalog. The period must not
+	// end before it starts.
 	Create(ctx context.Context, post entity.CreateSubscriptionRequest) error
+	// CreateChecked stores post like Create once check accepts it. check reads through
+	// the transaction of the insert, and the checked writes of the other subscriptions of
+	// the user wait for it, so two of them are never checked against the same state. It
+	// returns the errors of Create or the error of check.
+	CreateChecked(ctx context.Context, post entity.CreateSubscriptionRequest, check func(CheckReader) error) error
 	GetSubscription(ctx context.Context, id string) (*entity.Subscription, error)
 	Update(ctx context.Context, post entity.UpdateSubscriptionRequest) error
+	// UpdateChecked stores post like Update once check accepts it. check gets the
+	// subscription with the id of post as stored and reads through the transaction of
+	// the update. The subscription is locked from the read to the write, and the checked
+	// writes of the other subscriptions of its user wait for it. It returns ErrNotFound,
+	// the errors of Update or the error of check.
+	UpdateChecked(
+		ctx context.Context,
+		post entity.UpdateSubscriptionRequest,
+		check func(current entity.Subscription, reader CheckReader) error,
+	) error
 	Delete(ctx context.Context, id string) error
 	List(ctx context.Context, filter entity.ListSubscriptionFilter) ([]entity.Subscription, error)
+	// Sum adds up the prices of the months the subscriptions are billed in within the
+	// dates of the filter, see entity.Subscription.Cost.
 	Sum(ctx context.Context, filter entity.ListSubscriptionFilter) (int64, error)
+	// ListActive returns the subscriptions active in any month of the period.
+	ListActive(ctx context.Context, filter entity.PeriodFilter) ([]entity.Subscription, error)
+	// UpdatePauses replaces the pauses of the subscription with id by the result of
+	// modify, which gets the subscription as stored. The subscription is locked from the
+	// read to the write, so concurrent changes of its pauses don't overwrite each other.
+	// It returns the updated subscription, ErrNotFound or the error of modify.
+	UpdatePauses(
+		ctx context.Context,
+		id string,
+		updatedAt int64,
+		modify func(entity.Subscription) ([]entity.Pause, error),
+	) (*entity.Subscription, error)
+	// Cancel stores the result of cancel, which gets the subscription with id as stored
+	// and returns it cancelled: its end date, phases, pauses, cancellation and update
+	// time are written. The subscription is locked from the read to the write. It
+	// returns the cancelled subscription, ErrNotFound or the error of cancel.
+	Cancel(
+		ctx context.Context,
+		id string,
+		cancel func(entity.Subscription) (entity.Subscription, error),
+	) (*entity.Subscription, error)
+	// CountCancellations counts the cancellations that pass the filter by the month they
+	// take effect in, title and reason, ordered by them.
+	CountCancellations(ctx context.Context, filter entity.CancellationFilter) ([]entity.CancellationCount, error)
+	// ListEvents returns the events of the subscription with id in the order they were
+	// stored, or ErrNotFound.
+	ListEvents(ctx context.Context, id string) ([]entity.SubscriptionEvent, error)
+	// AddEvents stores the events, all or none, or returns ErrNotFound when the
+	// subscription of one of them doesn't exist.
+	AddEvents(ctx context.Context, events []entity.SubscriptionEvent) error
+}
+
+// CheckReader reads what a checked write of a subscription is compared against, in the
+// transaction of the write.
+type CheckReader interface {
+	ListBudgets(ctx context.Context, userID string) ([]entity.Budget, error)
+	ListActive(ctx context.Context, filter entity.PeriodFilter) ([]entity.Subscription, error)
+	FindService(ctx context.Context, name string) (*entity.Service, error)
 }
@@ -0,0 +1,153 @@
+package subctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"subscription-service/pkg/client"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputCSV   = "csv"
+)
+
+type cli struct {
+	opts  Options
+	usage string
+
+	// Values of the global flags; empty when not given.
+	server, apiKey, token, tenant, output, profile, configPath string
+
+	api *client.ClientWithResponses
+}
+
+// flagSet returns a flag set of a command with the global flags registered.
+func (c *cli) flagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet("subctl "+name, flag.ContinueOnError)
+	fs.SetOutput(c.opts.Stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: subctl "+c.usage)
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	fs.StringVar(&c.server, "server", "", "API base URL (env SUBCTL_SERVER, default "+defaultServer+")")
+	fs.StringVar(&c.apiKey, "api-key", "", "API key (env SUBCTL_API_KEY)")
+	fs.StringVar(&c.token, "token", "", "bearer token (env SUBCTL_TOKEN)")
+	fs.StringVar(&c.tenant, "tenant", "", "tenant to act within, for platform admins (env SUBCTL_TENANT)")
+	fs.StringVar(&c.output, "o", "", "output format: table, json or csv (env SUBCTL_OUTPUT)")
+	fs.StringVar(&c.profile, "profile", "", "profile of the config file (env SUBCTL_PROFILE)")
+	fs.StringVar(&c.configPath, "config", "", "config file with profiles (env SUBCTL_CONFIG)")
+
+	return fs
+}
+
+// parse parses flags wherever they appear among the arguments, resolves the settings
+// and returns the positional arguments.
+func (c *cli) parse(fs *flag.FlagSet, args []string, positional int) ([]string, error) {
+	var rest []string
+
+	for {
+		if err := fs.Parse(args); err != nil {
+			if err == flag.ErrHelp {
+				return nil, err
+			}
+			return nil, &usageError{msg: err.Error()}
+		}
+
+		if fs.NArg() == 0 {
+			break
+		}
+
+		rest = append(rest, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+
+	if len(rest) != positional {
+		return nil, usagef("usage: subctl %s", c.usage)
+	}
+
+	if err := c.resolve(); err != nil {
+		return nil, err
+	}
+
+	return rest, nil
+}
+
+// resolve fills the settings not given as flags from the environment, then from the
+// profile, and creates the API client.
+func (c *cli) resolve() error {
+	env := func(key string) string {
+		if c.opts.LookupEnv == nil {
+			return ""
+		}
+		v, _ := c.opts.LookupEnv(key)
+		return v
+	}
+
+	explicit := c.configPath != "" || env("SUBCTL_CONFIG") != ""
+	path := first(c.configPath, env("SUBCTL_CONFIG"), defaultConfigPath())
+
+	profile, err := loadProfile(path, first(c.profile, env("SUBCTL_PROFILE")), explicit)
+	if err != nil {
+		return err
+	}
+
+	c.server = first(c.server, env("SUBCTL_SERVER"), profile.Server, defaultServer)
+	c.apiKey = first(c.apiKey, env("SUBCTL_API_KEY"), profile.APIKey)
+	c.token = first(c.token, env("SUBCTL_TOKEN"), profile.Token)
+	c.tenant = first(c.tenant, env("SUBCTL_TENANT"), profile.Tenant)
+	c.output = first(c.output, env("SUBCTL_OUTPUT"), profile.Output, outputTable)
+
+	switch c.output {
+	case outputTable, outputJSON, outputCSV:
+	default:
+		return usagef("unknown output format %q, expected table, json or csv", c.output)
+	}
+
+	if c.tenant != "" {
+		if _, err := uuid.Parse(c.tenant); err != nil {
+			return usagef("tenant must be a uuid: %v", err)
+		}
+	}
+
+	opts := []client.ClientOption{client.WithRequestEditorFn(c.authenticate)}
+	if c.opts.HTTPClient != nil {
+		opts = append(opts, client.WithHTTPClient(c.opts.HTTPClient))
+	}
+
+	c.api, err = client.NewClientWithResponses(c.server, opts...)
+
+	return err
+}
+
+func (c *cli) authenticate(_ context.Context, req *http.Request) error {
+	switch {
+	case c.apiKey != "":
+		req.Header.Set("X-API-Key", c.apiKey)
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	if c.tenant != "" {
+		req.Header.Set("X-Tenant-ID", c.tenant)
+	}
+
+	return nil
+}
+
+func first(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,490 @@
+package subctl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"net/http"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"subscription-service/pkg/client"
+)
+
+// noEndDate clears the end date of a subscription in update.
+const noEndDate = "none"
+
+type listFilter struct {
+	userID, serviceName, startDate, endDate string
+	price, limit, offset                    int
+}
+
+func (f *listFilter) register(fs *flag.FlagSet, paging bool) {
+	fs.StringVar(&f.userID, "user-id", "", "only subscriptions of the user")
+	fs.StringVar(&f.serviceName, "service-name", "", "only subscriptions to the service")
+	fs.IntVar(&f.price, "price", -1, "only subscriptions with the price")
+	fs.StringVar(&f.startDate, "start-date", "", "only subscriptions active since MM-YYYY")
+	fs.StringVar(&f.endDate, "end-date", "", "only subscriptions active until MM-YYYY")
+
+	if paging {
+		fs.IntVar(&f.limit, "limit", 0, "maximum number of subscriptions")
+		fs.IntVar(&f.offset, "offset", 0, "number of subscriptions to skip")
+	}
+}
+
+func (f *listFilter) params() (*client.GetSubscriptionsParams, error) {
+	params := &client.GetSubscriptionsParams{}
+
+	if f.userID != "" {
+		id, err := parseUUID("user-id", f.userID)
+		if err != nil {
+			return nil, err
+		}
+		params.UserId = &id
+	}
+	if f.serviceName != "" {
+		params.ServiceName = &f.serviceName
+	}
+	if f.price >= 0 {
+		params.Price = &f.price
+	}
+	if f.startDate != "" {
+		params.StartDate = &f.startDate
+	}
+	if f.endDate != "" {
+		params.EndDate = &f.endDate
+	}
+	if f.limit > 0 {
+		params.Limit = &f.limit
+	}
+	if f.offset > 0 {
+		params.Offset = &f.offset
+	}
+
+	return params, nil
+}
+
+func (c *cli) list(ctx context.Context, args []string) error {
+	fs := c.flagSet("list")
+
+	var filter listFilter
+	filter.register(fs, true)
+
+	if _, err := c.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	params, err := filter.params()
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.api.GetSubscriptionsWithResponse(ctx, params)
+	if err != nil {
+		return err
+	}
+	if resp.JSON200 == nil {
+		return responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	return writeSubscriptions(c.opts.Stdout, c.output, *resp.JSON200)
+}
+
+func (c *cli) get(ctx context.Context, args []string) error {
+	fs := c.flagSet("get")
+
+	rest, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	sub, err := c.fetch(ctx, rest[0])
+	if err != nil {
+		return err
+	}
+
+	return writeSubscriptions(c.opts.Stdout, c.output, []client.Subscription{*sub})
+}
+
+func (c *cli) fetch(ctx context.Context, rawID string) (*client.Subscription, error) {
+	id, err := parseUUID("ID", rawID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.api.GetSubscriptionsIdWithResponse(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	return resp.JSON200, nil
+}
+
+func (c *cli) create(ctx context.Context, args []string) error {
+	fs := c.flagSet("create")
+
+	var (
+		req            client.CreateSubscriptionRequest
+		userID         string
+		endDate        string
+		idempotencyKey string
+		price          int
+		renewMonths    int
+	)
+
+	fs.StringVar(&req.ServiceName, "service-name", "", "name of the service (required)")
+	fs.IntVar(&price, "price", -1, "monthly price in rubles (default: the default price of the service)")
+	fs.StringVar(&userID, "user-id", "", "owner of the subscription (required)")
+	fs.StringVar(&req.StartDate, "start-date", "", "first month, MM-YYYY (required)")
+	fs.StringVar(&endDate, "end-date", "", "last month, MM-YYYY")
+	fs.IntVar(&renewMonths, "renew-months", 0, "renew automatically by this many months once the last month starts")
+	fs.StringVar(&idempotencyKey, "idempotency-key", "", "key that makes retries safe (default: random)")
+
+	if _, err := c.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	if req.ServiceName == "" || userID == "" || req.StartDate == "" {
+		return usagef("--service-name, --user-id and --start-date are required")
+	}
+	if price >= 0 {
+		req.Price = &price
+	}
+
+	var err error
+	if req.UserId, err = parseUUID("user-id", userID); err != nil {
+		return err
+	}
+	if endDate != "" {
+		req.EndDate = &endDate
+	}
+	if renewMonths > 0 {
+		req.Renewal = autoRenewal(renewMonths)
+	}
+
+	sub, err := c.createOne(ctx, req, first(idempotencyKey, uuid.NewString()))
+	if err != nil {
+		return err
+	}
+
+	return writeSubscriptions(c.opts.Stdout, c.output, []client.Subscription{*sub})
+}
+
+func (c *cli) createOne(
+	ctx context.Context,
+	req client.CreateSubscriptionRequest,
+	idempotencyKey string,
+) (*client.Subscription, error) {
+	params := &client.PostSubscriptionsParams{IdempotencyKey: &idempotencyKey}
+
+	resp, err := c.api.PostSubscriptionsWithResponse(ctx, params, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	return resp.JSON201, nil
+}
+
+// update replaces only the fields given as flags: the API takes the whole subscription,
+// so the current one is fetched first.
+func (c *cli) update(ctx context.Context, args []string) error {
+	fs := c.flagSet("update")
+
+	var (
+		serviceName, startDate, endDate string
+		price, renewMonths              int
+	)
+
+	fs.StringVar(&serviceName, "service-name", "", "new name of the service")
+	fs.IntVar(&price, "price", -1, "new monthly price in rubles")
+	fs.StringVar(&startDate, "start-date", "", "new first month, MM-YYYY")
+	fs.StringVar(&endDate, "end-date", "", `new last month, MM-YYYY, or "`+noEndDate+`" to clear it`)
+
+	rest, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	current, err := c.fetch(ctx, rest[0])
+	if err != nil {
+		return err
+	}
+
+	req := client.UpdateSubscriptionRequest{
+		ServiceName: first(serviceName, current.ServiceName),
+		Price:       current.Price,
+		StartDate:   first(startDate, current.StartDate),
+		EndDate:     current.EndDate,
+		Phases:      current.Phases,
+		Renewal:     current.Renewal,
+	}
+	if price >= 0 {
+		req.Price = price
+	}
+	switch {
+	case renewMonths == 0:
+		req.Renewal = nil
+	case renewMonths > 0:
+		req.Renewal = autoRenewal(renewMonths)
+	}
+	switch endDate {
+	case "":
+	case noEndDate:
+		req.EndDate = nil
+	default:
+		req.EndDate = &endDate
+	}
+
+	resp, err := c.api.PutSubscriptionsIdWithResponse(ctx, *current.Id, nil, req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	updated, err := c.fetch(ctx, rest[0])
+	if err != nil {
+		return err
+	}
+
+	return writeSubscriptions(c.opts.Stdout, c.output, []client.Subscription{*updated})
+}
+
+func autoRenewal(termMonths int) *client.Renewal {
+	return &client.Renewal{Policy: client.Auto, TermMonths: &termMonths}
+}
+
+func (c *cli) events(ctx context.Context, args []string) error {
+	fs := c.flagSet("events")
+
+	rest, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	id, err := parseUUID("ID", rest[0])
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.api.GetSubscriptionsIdEventsWithResponse(ctx, id, nil)
+	if err != nil {
+		return err
+	}
+	if resp.JSON200 == nil {
+		return responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	return writeEvents(c.opts.Stdout, c.output, resp.JSON200.Items)
+}
+
+func (c *cli) pause(ctx context.Context, args []string) error {
+	fs := c.flagSet("pause")
+
+	var startDate, endDate string
+
+	fs.StringVar(&startDate, "start-date", "", "first paused month, MM-YYYY (default the current month)")
+	fs.StringVar(&endDate, "end-date", "", "last paused month, MM-YYYY (default until resumed)")
+
+	rest, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	id, err := parseUUID("ID", rest[0])
+	if err != nil {
+		return err
+	}
+
+	req := client.PauseRequest{}
+	if startDate != "" {
+		req.StartDate = &startDate
+	}
+	if endDate != "" {
+		req.EndDate = &endDate
+	}
+
+	resp, err := c.api.PostSubscriptionsIdPauseWithResponse(ctx, id, nil, req)
+	if err != nil {
+		return err
+	}
+	if resp.JSON200 == nil {
+		return responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	return writeSubscriptions(c.opts.Stdout, c.output, []client.Subscription{*resp.JSON200})
+}
+
+func (c *cli) resume(ctx context.Context, args []string) error {
+	fs := c.flagSet("resume")
+
+	var resumeDate string
+
+	fs.StringVar(&resumeDate, "resume-date", "", "first billed month again, MM-YYYY (default the current month)")
+
+	rest, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	id, err := parseUUID("ID", rest[0])
+	if err != nil {
+		return err
+	}
+
+	req := client.ResumeRequest{}
+	if resumeDate != "" {
+		req.ResumeDate = &resumeDate
+	}
+
+	resp, err := c.api.PostSubscriptionsIdResumeWithResponse(ctx, id, nil, req)
+	if err != nil {
+		return err
+	}
+	if resp.JSON200 == nil {
+		return responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	return writeSubscriptions(c.opts.Stdout, c.output, []client.Subscription{*resp.JSON200})
+}
+
+func (c *cli) cancel(ctx context.Context, args []string) error {
+	fs := c.flagSet("cancel")
+
+	var effectiveDate, reason, note string
+
+	fs.StringVar(&effectiveDate, "effective-date", "", "last month of the subscription, MM-YYYY (default the current month)")
+	fs.StringVar(&reason, "reason", "", "reason code of the cancellation")
+	fs.StringVar(&note, "note", "", "free-text note")
+
+	rest, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	id, err := parseUUID("ID", rest[0])
+	if err != nil {
+		return err
+	}
+
+	if reason == "" {
+		return usagef("--reason is required")
+	}
+
+	req := client.CancelRequest{Reason: reason}
+	if effectiveDate != "" {
+		req.EffectiveDate = &effectiveDate
+	}
+	if note != "" {
+		req.Note = &note
+	}
+
+	resp, err := c.api.PostSubscriptionsIdCancelWithResponse(ctx, id, nil, req)
+	if err != nil {
+		return err
+	}
+	if resp.JSON200 == nil {
+		return responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	return writeSubscriptions(c.opts.Stdout, c.output, []client.Subscription{*resp.JSON200})
+}
+
+func (c *cli) delete(ctx context.Context, args []string) error {
+	fs := c.flagSet("delete")
+
+	rest, err := c.parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	id, err := parseUUID("ID", rest[0])
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.api.DeleteSubscriptionsIdWithResponse(ctx, id, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	return nil
+}
+
+func (c *cli) sum(ctx context.Context, args []string) error {
+	fs := c.flagSet("sum")
+
+	var filter listFilter
+	filter.register(fs, false)
+
+	if _, err := c.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	if filter.startDate == "" || filter.endDate == "" {
+		return usagef("--start-date and --end-date are required")
+	}
+	if filter.price >= 0 {
+		return usagef("sum can't be filtered by price")
+	}
+
+	list, err := filter.params()
+	if err != nil {
+		return err
+	}
+
+	params := &client.GetSubscriptionsSumParams{
+		StartDate:   filter.startDate,
+		EndDate:     filter.endDate,
+		UserId:      list.UserId,
+		ServiceName: list.ServiceName,
+	}
+
+	resp, err := c.api.GetSubscriptionsSumWithResponse(ctx, params)
+	if err != nil {
+		return err
+	}
+	if resp.JSON200 == nil {
+		return responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	return writeSum(c.opts.Stdout, c.output, resp.JSON200.TotalCost)
+}
+
+func parseUUID(name, raw string) (openapi_types.UUID, error) {
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return id, usagef("%s must be a uuid: %v", name, err)
+	}
+
+	return id, nil
+}
+
+// responseError turns an unexpected response into an apiError, keeping the problem
+// details when the server sent them.
+func responseError(resp *http.Response, body []byte) error {
+	if resp == nil {
+		return errors.New("no response")
+	}
+
+	err := &apiError{status: resp.StatusCode}
+
+	var problem client.Problem
+	if jsonErr := json.Unmarshal(body, &problem); jsonErr == nil && problem.Title != "" {
+		err.problem = &problem
+	}
+
+	return err
+}
@@ -0,0 +1,134 @@
+package subctl
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"subscription-service/pkg/client"
+)
+
+var subscriptionColumns = []string{"id", "service_name", "price", "user_id", "start_date", "end_date", "created_at", "updated_at"}
+
+func subscriptionRow(s client.Subscription) []string {
+	row := []string{"", s.ServiceName, strconv.Itoa(s.Price), s.UserId.String(), s.StartDate, "", "", ""}
+
+	if s.Id != nil {
+		row[0] = s.Id.String()
+	}
+	if s.EndDate != nil {
+		row[5] = *s.EndDate
+	}
+	if s.CreatedAt != nil {
+		row[6] = s.CreatedAt.Format(time.RFC3339)
+	}
+	if s.UpdatedAt != nil {
+		row[7] = s.UpdatedAt.Format(time.RFC3339)
+	}
+
+	return row
+}
+
+func writeSubscriptions(w io.Writer, format string, subs []client.Subscription) error {
+	switch format {
+	case outputJSON:
+		if subs == nil {
+			subs = []client.Subscription{}
+		}
+		return writeJSON(w, subs)
+	case outputCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(subscriptionColumns); err != nil {
+			return err
+		}
+		for _, s := range subs {
+			if err := cw.Write(subscriptionRow(s)); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	default:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "ID\tSERVICE\tPRICE\tUSER\tSTART\tEND")
+		for _, s := range subs {
+			row := subscriptionRow(s)
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", row[0], row[1], row[2], row[3], row[4], dash(row[5]))
+		}
+		return tw.Flush()
+	}
+}
+
+var eventColumns = []string{"id", "type", "previous_end_date", "end_date", "term_months", "created_at"}
+
+func eventRow(e client.SubscriptionEvent) []string {
+	row := []string{e.Id.String(), string(e.Type), "", "", "", e.CreatedAt.Format(time.RFC3339)}
+
+	if r := e.Renewal; r != nil {
+		row[2], row[3], row[4] = r.PreviousEndDate, r.EndDate, strconv.Itoa(r.TermMonths)
+	}
+
+	return row
+}
+
+func writeEvents(w io.Writer, format string, events []client.SubscriptionEvent) error {
+	switch format {
+	case outputJSON:
+		if events == nil {
+			events = []client.SubscriptionEvent{}
+		}
+		return writeJSON(w, events)
+	case outputCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(eventColumns); err != nil {
+			return err
+		}
+		for _, e := range events {
+			if err := cw.Write(eventRow(e)); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	default:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "ID\tTYPE\tFROM\tTO\tCREATED")
+		for _, e := range events {
+			row := eventRow(e)
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", row[0], row[1], dash(row[2]), dash(row[3]), row[5])
+		}
+		return tw.Flush()
+	}
+}
+
+func writeSum(w io.Writer, format string, total int) error {
+	switch format {
+	case outputJSON:
+		return writeJSON(w, client.AggregationResult{TotalCost: total})
+	case outputCSV:
+		_, err := fmt.Fprintf(w, "total_cost\n%d\n", total)
+		return err
+	default:
+		_, err := fmt.Fprintln(w, total)
+		return err
+	}
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
+
+func dash(s string) string {
+	if s == "" {
+		return "-"
+	}
+
+	return s
+}
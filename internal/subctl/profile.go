@@ -0,0 +1,82 @@
+package subctl
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+const defaultServer = "http://localhost:8080"
+
+// Profile is a named set of connection settings in the config file:
+//
+//	current_profile: prod
+//	profiles:
+//	  prod:
+//	    server: https://subscriptions.example.com
+//	    api_key: sk_...
+//	    output: table
+type Profile struct {
+	Server string `yaml:"server"`
+	APIKey string `yaml:"api_key"`
+	Token  string `yaml:"token"`
+	Tenant string `yaml:"tenant"`
+	Output string `yaml:"output"`
+}
+
+type profileFile struct {
+	CurrentProfile string             `yaml:"current_profile"`
+	Profiles       map[string]Profile `yaml:"profiles"`
+}
+
+// defaultConfigPath is ~/.config/subctl/config.yaml or its equivalent on the platform.
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(dir, "subctl", "config.yaml")
+}
+
+// loadProfile reads the named profile, or the current one when name is empty. A missing
+// file is only an error when its path was given explicitly.
+func loadProfile(path, name string, explicit bool) (Profile, error) {
+	if path == "" {
+		return Profile{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) && !explicit {
+		if name != "" {
+			return Profile{}, usagef("profile %q not found: %s does not exist", name, path)
+		}
+		return Profile{}, nil
+	}
+	if err != nil {
+		return Profile{}, fmt.Errorf("read config: %w", err)
+	}
+
+	var file profileFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return Profile{}, fmt.Errorf("parse config %s: %w", path, err)
+	}
+
+	if name == "" {
+		name = file.CurrentProfile
+	}
+	if name == "" {
+		return Profile{}, nil
+	}
+
+	profile, ok := file.Profiles[name]
+	if !ok {
+		return Profile{}, usagef("profile %q not found in %s", name, path)
+	}
+
+	return profile, nil
+}
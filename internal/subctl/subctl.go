@@ -0,0 +1,171 @@
+// Package subctl implements the subctl command line client of the subscription API.
+package subctl
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+
+	"subscription-service/pkg/client"
+)
+
+// Exit codes. Errors returned by the API are mapped to the class of their HTTP status.
+const (
+	ExitOK          = 0
+	ExitFailure     = 1
+	ExitUsage       = 2
+	ExitUnreachable = 3
+	ExitClientError = 4
+	ExitServerError = 5
+)
+
+// Options are the process environment of a run.
+type Options struct {
+	Stdin     io.Reader
+	Stdout    io.Writer
+	Stderr    io.Writer
+	LookupEnv func(string) (string, bool)
+	// HTTPClient is used for API calls; http.DefaultClient when nil.
+	HTTPClient client.HttpRequestDoer
+}
+
+type command struct {
+	usage string
+	run   func(c *cli, ctx context.Context, args []string) error
+}
+
+var commands = map[string]command{
+	"list":   {"list [flags]", (*cli).list},
+	"get":    {"get ID [flags]", (*cli).get},
+	"create": {"create --service-name NAME --price N --user-id UUID --start-date MM-YYYY [flags]", (*cli).create},
+	"update": {"update ID [--service-name NAME] [--price N] [--start-date MM-YYYY] [--end-date MM-YYYY|none] [--renew-months N]", (*cli).update},
+	"events": {"events ID [flags]", (*cli).events},
+	"pause":  {"pause ID [--start-date MM-YYYY] [--end-date MM-YYYY] [flags]", (*cli).pause},
+	"resume": {"resume ID [--resume-date MM-YYYY] [flags]", (*cli).resume},
+	"cancel": {"cancel ID --reason CODE [--effective-date MM-YYYY] [--note TEXT] [flags]", (*cli).cancel},
+	"delete": {"delete ID [flags]", (*cli).delete},
+	"sum":    {"sum --start-date MM-YYYY --end-date MM-YYYY [flags]", (*cli).sum},
+	"export": {"export [--file PATH] [list filters] [flags]", (*cli).export},
+	"import": {"import [--file PATH] [--format json|csv] [flags]", (*cli).importSubscriptions},
+}
+
+// Run executes subctl with args, which exclude the program name, and returns the exit code.
+func Run(ctx context.Context, args []string, opts Options) int {
+	c := &cli{opts: opts}
+
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		c.printUsage(opts.Stdout)
+		return ExitOK
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(opts.Stderr, "unknown command %q\n\n", args[0])
+		c.printUsage(opts.Stderr)
+		return ExitUsage
+	}
+
+	c.usage = cmd.usage
+
+	err := cmd.run(c, ctx, args[1:])
+	if err == nil {
+		return ExitOK
+	}
+
+	if errors.Is(err, flag.ErrHelp) {
+		return ExitOK
+	}
+
+	fmt.Fprintln(opts.Stderr, "error:", err)
+
+	return exitCode(err)
+}
+
+func (c *cli) printUsage(w io.Writer) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(w, "Usage: subctl COMMAND [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, name := range names {
+		fmt.Fprintln(w, "  subctl "+commands[name].usage)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, `Run "subctl COMMAND --help" for the flags of a command.`)
+}
+
+// usageError is a mistake in the command line.
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+func usagef(format string, args ...any) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// apiError is a response of the API with an error status.
+type apiError struct {
+	status  int
+	problem *client.Problem
+}
+
+func (e *apiError) Error() string {
+	if e.problem == nil {
+		return fmt.Sprintf("%d %s", e.status, http.StatusText(e.status))
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d %s", e.status, e.problem.Title)
+
+	if e.problem.Detail != nil {
+		b.WriteString(": " + *e.problem.Detail)
+	}
+
+	if e.problem.InvalidParams != nil {
+		for _, p := range *e.problem.InvalidParams {
+			fmt.Fprintf(&b, "\n  %s (%s): %s", p.Name, p.In, p.Reason)
+		}
+	}
+
+	if e.problem.RequestId != nil {
+		b.WriteString("\n  request id: " + *e.problem.RequestId)
+	}
+
+	return b.String()
+}
+
+func exitCode(err error) int {
+	var (
+		uErr   *usageError
+		aErr   *apiError
+		netErr net.Error
+		opErr  *net.OpError
+	)
+
+	switch {
+	case errors.As(err, &uErr):
+		return ExitUsage
+	case errors.As(err, &aErr) && aErr.status >= http.StatusInternalServerError:
+		return ExitServerError
+	case errors.As(err, &aErr):
+		return ExitClientError
+	case errors.As(err, &opErr), errors.As(err, &netErr):
+		return ExitUnreachable
+	default:
+		return ExitFailure
+	}
+}
@@ -0,0 +1,358 @@
+package subctl_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"subscription-service/internal/subctl"
+	"subscription-service/pkg/client"
+)
+
+const (
+	apiKey = "sk_test"
+	userID = "60601fee-2bf1-4721-ae6f-7636e79a0cba"
+)
+
+// fakeAPI serves the subscription endpoints from memory.
+type fakeAPI struct {
+	mu          sync.Mutex
+	subs        []client.Subscription
+	idempotency map[string]client.Subscription
+	failWith    int
+}
+
+func newFakeAPI(t *testing.T) (*fakeAPI, *httptest.Server) {
+	t.Helper()
+
+	api := &fakeAPI{idempotency: make(map[string]client.Subscription)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /subscriptions", api.list)
+	mux.HandleFunc("POST /subscriptions", api.create)
+	mux.HandleFunc("GET /subscriptions/sum", api.sum)
+	mux.HandleFunc("GET /subscriptions/{id}", api.get)
+	mux.HandleFunc("PUT /subscriptions/{id}", api.update)
+	mux.HandleFunc("DELETE /subscriptions/{id}", api.delete)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-API-Key") != apiKey {
+			problem(w, http.StatusUnauthorized, "Authentication required")
+			return
+		}
+		if api.failWith != 0 {
+			problem(w, api.failWith, http.StatusText(api.failWith))
+			return
+		}
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	return api, srv
+}
+
+func problem(w http.ResponseWriter, status int, title string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(client.Problem{Status: status, Title: title, Type: "/problems/test"})
+}
+
+func reply(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func (a *fakeAPI) find(r *http.Request) int {
+	for i, s := range a.subs {
+		if s.Id.String() == r.PathValue("id") {
+			return i
+		}
+	}
+	return -1
+}
+
+func (a *fakeAPI) list(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	limit, offset := len(a.subs), 0
+	if v := q.Get("limit"); v != "" {
+		limit, _ = strconv.Atoi(v)
+	}
+	if v := q.Get("offset"); v != "" {
+		offset, _ = strconv.Atoi(v)
+	}
+
+	res := []client.Subscription{}
+	for _, s := range a.subs {
+		if name := q.Get("service_name"); name != "" && s.ServiceName != name {
+			continue
+		}
+		res = append(res, s)
+	}
+
+	res = res[min(offset, len(res)):]
+	res = res[:min(limit, len(res))]
+	reply(w, http.StatusOK, res)
+}
+
+func (a *fakeAPI) create(w http.ResponseWriter, r *http.Request) {
+	var req client.CreateSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem(w, http.StatusBadRequest, "Malformed request")
+		return
+	}
+
+	key := r.Header.Get("Idempotency-Key")
+	if s, ok := a.idempotency[key]; ok && key != "" {
+		reply(w, http.StatusCreated, s)
+		return
+	}
+
+	if req.Price == nil {
+		problem(w, http.StatusUnprocessableEntity, "Invalid subscription data")
+		return
+	}
+
+	id := uuid.New()
+	s := client.Subscription{
+		Id:          &id,
+		ServiceName: req.ServiceName,
+		Price:       *req.Price,
+		UserId:      req.UserId,
+		StartDate:   req.StartDate,
+		EndDate:     req.EndDate,
+	}
+	a.subs = append(a.subs, s)
+	a.idempotency[key] = s
+	reply(w, http.StatusCreated, s)
+}
+
+func (a *fakeAPI) get(w http.ResponseWriter, r *http.Request) {
+	i := a.find(r)
+	if i < 0 {
+		problem(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+	reply(w, http.StatusOK, a.subs[i])
+}
+
+func (a *fakeAPI) update(w http.ResponseWriter, r *http.Request) {
+	i := a.find(r)
+	if i < 0 {
+		problem(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+
+	var req client.UpdateSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem(w, http.StatusBadRequest, "Malformed request")
+		return
+	}
+
+	s := &a.subs[i]
+	s.ServiceName, s.Price, s.StartDate, s.EndDate = req.ServiceName, req.Price, req.StartDate, req.EndDate
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (a *fakeAPI) delete(w http.ResponseWriter, r *http.Request) {
+	i := a.find(r)
+	if i < 0 {
+		problem(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+	a.subs = append(a.subs[:i], a.subs[i+1:]...)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (a *fakeAPI) sum(w http.ResponseWriter, _ *http.Request) {
+	total := 0
+	for _, s := range a.subs {
+		total += s.Price
+	}
+	reply(w, http.StatusOK, client.AggregationResult{TotalCost: total})
+}
+
+type result struct {
+	code   int
+	stdout string
+	stderr string
+}
+
+func run(t *testing.T, env map[string]string, stdin string, args ...string) result {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+
+	code := subctl.Run(context.Background(), args, subctl.Options{
+		Stdin:  strings.NewReader(stdin),
+		Stdout: &stdout,
+		Stderr: &stderr,
+		LookupEnv: func(key string) (string, bool) {
+			v, ok := env[key]
+			return v, ok
+		},
+	})
+
+	return result{code: code, stdout: stdout.String(), stderr: stderr.String()}
+}
+
+func serverEnv(srv *httptest.Server) map[string]string {
+	return map[string]string{"SUBCTL_SERVER": srv.URL, "SUBCTL_API_KEY": apiKey, "SUBCTL_CONFIG": os.DevNull}
+}
+
+func TestSubscriptionLifecycle(t *testing.T) {
+	_, srv := newFakeAPI(t)
+	env := serverEnv(srv)
+
+	res := run(t, env, "", "create", "--service-name", "Yandex Plus", "--price", "400",
+		"--user-id", userID, "--start-date", "07-2025", "-o", "json")
+	if res.code != subctl.ExitOK {
+		t.Fatalf("create: exit %d: %s", res.code, res.stderr)
+	}
+
+	var created []client.Subscription
+	if err := json.Unmarshal([]byte(res.stdout), &created); err != nil || len(created) != 1 {
+		t.Fatalf("create: unexpected output %q: %v", res.stdout, err)
+	}
+	id := created[0].Id.String()
+
+	if res := run(t, env, "", "update", id, "--price", "500", "--end-date", "12-2025"); res.code != subctl.ExitOK {
+		t.Fatalf("update: exit %d: %s", res.code, res.stderr)
+	}
+
+	res = run(t, env, "", "get", id, "-o", "csv")
+	if res.code != subctl.ExitOK {
+		t.Fatalf("get: exit %d: %s", res.code, res.stderr)
+	}
+	if !strings.Contains(res.stdout, "Yandex Plus,500,"+userID+",07-2025,12-2025") {
+		t.Errorf("get: expected the updated subscription, got:\n%s", res.stdout)
+	}
+
+	res = run(t, env, "", "list", "--service-name", "Yandex Plus")
+	if res.code != subctl.ExitOK || !strings.Contains(res.stdout, id) || !strings.HasPrefix(res.stdout, "ID") {
+		t.Errorf("list: exit %d, expected a table with the subscription, got:\n%s%s", res.code, res.stdout, res.stderr)
+	}
+
+	res = run(t, env, "", "sum", "--start-date", "01-2025", "--end-date", "12-2025")
+	if res.code != subctl.ExitOK || strings.TrimSpace(res.stdout) != "500" {
+		t.Errorf("sum: exit %d, expected 500, got %q%s", res.code, res.stdout, res.stderr)
+	}
+
+	if res := run(t, env, "", "delete", id); res.code != subctl.ExitOK {
+		t.Fatalf("delete: exit %d: %s", res.code, res.stderr)
+	}
+
+	res = run(t, env, "", "get", id)
+	if res.code != subctl.ExitClientError || !strings.Contains(res.stderr, "404 Resource not found") {
+		t.Errorf("get deleted: expected exit %d with the problem, got %d: %s", subctl.ExitClientError, res.code, res.stderr)
+	}
+}
+
+func TestExitCodes(t *testing.T) {
+	api, srv := newFakeAPI(t)
+
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	tests := []struct {
+		name     string
+		env      map[string]string
+		failWith int
+		args     []string
+		code     int
+	}{
+		{"unknown command", serverEnv(srv), 0, []string{"frobnicate"}, subctl.ExitUsage},
+		{"missing argument", serverEnv(srv), 0, []string{"get"}, subctl.ExitUsage},
+		{"invalid id", serverEnv(srv), 0, []string{"get", "42"}, subctl.ExitUsage},
+		{"unauthenticated", map[string]string{"SUBCTL_SERVER": srv.URL, "SUBCTL_CONFIG": os.DevNull}, 0,
+			[]string{"list"}, subctl.ExitClientError},
+		{"server error", serverEnv(srv), http.StatusInternalServerError, []string{"list"}, subctl.ExitServerError},
+		{"unreachable", map[string]string{"SUBCTL_SERVER": unreachable.URL, "SUBCTL_CONFIG": os.DevNull}, 0,
+			[]string{"list"}, subctl.ExitUnreachable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api.failWith = tt.failWith
+
+			if res := run(t, tt.env, "", tt.args...); res.code != tt.code {
+				t.Errorf("expected exit %d, got %d: %s", tt.code, res.code, res.stderr)
+			}
+		})
+	}
+}
+
+func TestExportImport(t *testing.T) {
+	source, sourceSrv := newFakeAPI(t)
+	for i := range 3 {
+		id := uuid.New()
+		source.subs = append(source.subs, client.Subscription{
+			Id:          &id,
+			ServiceName: "Service " + strconv.Itoa(i),
+			Price:       100 * (i + 1),
+			UserId:      uuid.MustParse(userID),
+			StartDate:   "01-2025",
+		})
+	}
+
+	file := filepath.Join(t.TempDir(), "subs.csv")
+
+	if res := run(t, serverEnv(sourceSrv), "", "export", "--file", file); res.code != subctl.ExitOK {
+		t.Fatalf("export: exit %d: %s", res.code, res.stderr)
+	}
+
+	target, targetSrv := newFakeAPI(t)
+
+	for range 2 {
+		res := run(t, serverEnv(targetSrv), "", "import", "--file", file)
+		if res.code != subctl.ExitOK || !strings.Contains(res.stdout, "imported 3 of 3") {
+			t.Fatalf("import: exit %d: %s%s", res.code, res.stdout, res.stderr)
+		}
+	}
+
+	if len(target.subs) != 3 {
+		t.Errorf("expected a repeated import to be idempotent, got %d subscriptions", len(target.subs))
+	}
+}
+
+func TestProfile(t *testing.T) {
+	_, srv := newFakeAPI(t)
+
+	config := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(config, []byte(`
+current_profile: local
+profiles:
+  local:
+    server: `+srv.URL+`
+    api_key: `+apiKey+`
+    output: json
+  broken:
+    server: http://127.0.0.1:1
+`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := run(t, map[string]string{"SUBCTL_CONFIG": config}, "", "list")
+	if res.code != subctl.ExitOK || strings.TrimSpace(res.stdout) != "[]" {
+		t.Errorf("expected the current profile to be used, got exit %d: %s%s", res.code, res.stdout, res.stderr)
+	}
+
+	res = run(t, map[string]string{"SUBCTL_CONFIG": config}, "", "list", "--profile", "broken", "--server", srv.URL,
+		"--api-key", apiKey, "-o", "csv")
+	if res.code != subctl.ExitOK || !strings.HasPrefix(res.stdout, "id,service_name") {
+		t.Errorf("expected flags to override the profile, got exit %d: %s%s", res.code, res.stdout, res.stderr)
+	}
+}
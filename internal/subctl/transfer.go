@@ -0,0 +1,246 @@
+package subctl
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"subscription-service/pkg/client"
+)
+
+const (
+	exportPageSize = 1000
+	// exportMaxOffset is the largest offset the API accepts.
+	exportMaxOffset = 10000
+)
+
+// export writes every subscription matching the filters, paging through the list. The
+// format follows the extension of --file, then -o.
+func (c *cli) export(ctx context.Context, args []string) error {
+	fs := c.flagSet("export")
+
+	var (
+		filter listFilter
+		path   string
+	)
+
+	filter.register(fs, false)
+	fs.StringVar(&path, "file", "", "file to write to (default: stdout)")
+
+	if _, err := c.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	params, err := filter.params()
+	if err != nil {
+		return err
+	}
+
+	var subs []client.Subscription
+
+	for offset := 0; ; offset += exportPageSize {
+		if offset > exportMaxOffset {
+			return usagef("more than %d subscriptions match, narrow the filters", exportMaxOffset)
+		}
+
+		page := *params
+		page.Limit = ptr(exportPageSize)
+		page.Offset = ptr(offset)
+
+		resp, err := c.api.GetSubscriptionsWithResponse(ctx, &page)
+		if err != nil {
+			return err
+		}
+		if resp.JSON200 == nil {
+			return responseError(resp.HTTPResponse, resp.Body)
+		}
+
+		subs = append(subs, *resp.JSON200...)
+
+		if len(*resp.JSON200) < exportPageSize {
+			break
+		}
+	}
+
+	if path == "" {
+		return writeSubscriptions(c.opts.Stdout, c.output, subs)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := writeSubscriptions(f, formatOf(path, c.output), subs); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.opts.Stderr, "exported %d subscriptions to %s\n", len(subs), path)
+
+	return nil
+}
+
+// importSubscriptions creates subscriptions read from a JSON array or a CSV file with a
+// header, as written by export. Every row is sent with an idempotency key derived from
+// its content, so an interrupted import can simply be run again. Rows that fail are
+// reported and skipped.
+func (c *cli) importSubscriptions(ctx context.Context, args []string) error {
+	fs := c.flagSet("import")
+
+	var path, format string
+
+	fs.StringVar(&path, "file", "", "file to read from (default: stdin)")
+	fs.StringVar(&format, "format", "", "input format: json or csv (default: by file extension, else json)")
+
+	if _, err := c.parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	in := c.opts.Stdin
+	if path != "" && path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	if format == "" {
+		format = formatOf(path, outputJSON)
+	}
+
+	var (
+		reqs []client.CreateSubscriptionRequest
+		err  error
+	)
+
+	switch format {
+	case outputJSON:
+		err = json.NewDecoder(in).Decode(&reqs)
+	case outputCSV:
+		reqs, err = readCSV(in)
+	default:
+		return usagef("unknown import format %q, expected json or csv", format)
+	}
+	if err != nil {
+		return fmt.Errorf("read subscriptions: %w", err)
+	}
+
+	var (
+		created  int
+		firstErr error
+	)
+
+	for i, req := range reqs {
+		if _, err := c.createOne(ctx, req, importKey(req)); err != nil {
+			fmt.Fprintf(c.opts.Stderr, "record %d (%s): %v\n", i+1, req.ServiceName, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		created++
+	}
+
+	fmt.Fprintf(c.opts.Stdout, "imported %d of %d subscriptions\n", created, len(reqs))
+
+	if firstErr != nil {
+		return fmt.Errorf("%d subscriptions were not imported: %w", len(reqs)-created, firstErr)
+	}
+
+	return nil
+}
+
+func readCSV(in io.Reader) ([]client.CreateSubscriptionRequest, error) {
+	records, err := csv.NewReader(in).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	columns := make(map[string]int, len(records[0]))
+	for i, name := range records[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+
+	for _, name := range []string{"service_name", "price", "user_id", "start_date"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("column %q is missing", name)
+		}
+	}
+
+	value := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	reqs := make([]client.CreateSubscriptionRequest, 0, len(records)-1)
+
+	for line, record := range records[1:] {
+		price, err := strconv.Atoi(value(record, "price"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: price must be an integer", line+2)
+		}
+
+		userID, err := parseUUID("user_id", value(record, "user_id"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line+2, err)
+		}
+
+		req := client.CreateSubscriptionRequest{
+			ServiceName: value(record, "service_name"),
+			Price:       &price,
+			UserId:      userID,
+			StartDate:   value(record, "start_date"),
+		}
+		if endDate := value(record, "end_date"); endDate != "" {
+			req.EndDate = &endDate
+		}
+
+		reqs = append(reqs, req)
+	}
+
+	return reqs, nil
+}
+
+func importKey(req client.CreateSubscriptionRequest) string {
+	// Marshaling a struct of strings and numbers can't fail.
+	data, _ := json.Marshal(req)
+	sum := sha256.Sum256(data)
+
+	return "subctl-import-" + hex.EncodeToString(sum[:16])
+}
+
+func formatOf(path, fallback string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return outputJSON
+	case ".csv":
+		return outputCSV
+	default:
+		return fallback
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
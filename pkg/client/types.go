@@ -9,43 +9,625 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+const (
+	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
+// Defines values for InvalidParamIn.
+const (
+	Body   InvalidParamIn = "body"
+	Header InvalidParamIn = "header"
+	Path   InvalidParamIn = "path"
+	Query  InvalidParamIn = "query"
+)
+
+// Defines values for RenewalPolicy.
+const (
+	Auto RenewalPolicy = "auto"
+	None RenewalPolicy = "none"
+)
+
+// Defines values for Role.
+const (
+	Admin Role = "admin"
+	User  Role = "user"
+)
+
+// Defines values for SubscriptionEventType.
+const (
+	BudgetExceeded SubscriptionEventType = "budget_exceeded"
+	Renewed        SubscriptionEventType = "renewed"
+)
+
+// Defines values for SubscriptionStatus.
+const (
+	Active    SubscriptionStatus = "active"
+	Ended     SubscriptionStatus = "ended"
+	Paused    SubscriptionStatus = "paused"
+	Scheduled SubscriptionStatus = "scheduled"
+)
+
+// Defines values for GetAnalyticsCohortsParamsFormat.
+const (
+	Csv  GetAnalyticsCohortsParamsFormat = "csv"
+	Json GetAnalyticsCohortsParamsFormat = "json"
+)
+
+// APIKey defines model for APIKey.
+type APIKey struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+
+	// Prefix First characters of the key, used to recognise it.
+	Prefix    string     `json:"prefix"`
+	RevokedAt *time.Time `json:"revoked_at"`
+	Role      Role       `json:"role"`
+
+	// TenantId Tenant the key is bound to. Null for platform admin keys.
+	TenantId *openapi_types.UUID `json:"tenant_id"`
+	UserId   *openapi_types.UUID `json:"user_id"`
+}
+
 // AggregationResult defines model for AggregationResult.
 type AggregationResult struct {
 	TotalCost int `json:"total_cost"`
 }
 
+// Budget defines model for Budget.
+type Budget struct {
+	Category  *string            `json:"category,omitempty"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	Limit     int64              `json:"limit"`
+	Services  *[]string          `json:"services,omitempty"`
+	Strict    bool               `json:"strict"`
+	UpdatedAt time.Time          `json:"updated_at"`
+	UserId    openapi_types.UUID `json:"user_id"`
+}
+
+// BudgetAlertEvent Set on budget_exceeded events: the first month the change of the subscription took over the budget, with the cost of that month after the change.
+type BudgetAlertEvent struct {
+	BudgetId openapi_types.UUID `json:"budget_id"`
+	Category *string            `json:"category,omitempty"`
+	Cost     int64              `json:"cost"`
+	Limit    int64              `json:"limit"`
+	Month    string             `json:"month"`
+}
+
+// BudgetList defines model for BudgetList.
+type BudgetList struct {
+	Items []Budget `json:"items"`
+}
+
+// BudgetRequest A monthly spending limit. Without a category it covers all subscriptions of the user; with one it covers the subscriptions to the services listed.
+type BudgetRequest struct {
+	Category *string `json:"category,omitempty"`
+
+	// Limit Monthly limit.
+	Limit int64 `json:"limit"`
+
+	// Services Service names of the category, compared ignoring case; required with a category.
+	Services *[]string `json:"services,omitempty"`
+
+	// Strict Reject creations and updates of subscriptions that take a month over the limit with 422 instead of recording a budget_exceeded event.
+	Strict *bool `json:"strict,omitempty"`
+}
+
+// BudgetStatus defines model for BudgetStatus.
+type BudgetStatus struct {
+	BudgetId openapi_types.UUID `json:"budget_id"`
+	Category *string            `json:"category,omitempty"`
+
+	// Cost Cost of the month of the subscriptions the budget covers.
+	Cost     int64 `json:"cost"`
+	Exceeded bool  `json:"exceeded"`
+	Limit    int64 `json:"limit"`
+
+	// Remaining Limit less cost; negative when the budget is exceeded.
+	Remaining int64 `json:"remaining"`
+	Strict    bool  `json:"strict"`
+}
+
+// BudgetStatusReport defines model for BudgetStatusReport.
+type BudgetStatusReport struct {
+	Budgets []BudgetStatus `json:"budgets"`
+	Month   string         `json:"month"`
+}
+
+// CancelRequest defines model for CancelRequest.
+type CancelRequest struct {
+	// EffectiveDate Last month of the subscription; the last month of the current billing period by default.
+	EffectiveDate *string `json:"effective_date,omitempty"`
+	Note          *string `json:"note,omitempty"`
+
+	// Reason Reason code, one of the codes the service is configured with.
+	Reason string `json:"reason"`
+}
+
+// Cancellation defines model for Cancellation.
+type Cancellation struct {
+	CancelledAt time.Time `json:"cancelled_at"`
+
+	// EffectiveDate Last month of the subscription.
+	EffectiveDate string `json:"effective_date"`
+	Note          string `json:"note"`
+	Reason        string `json:"reason"`
+}
+
+// CancellationCount defines model for CancellationCount.
+type CancellationCount struct {
+	Count int `json:"count"`
+
+	// Month Month the cancellations take effect in.
+	Month       string `json:"month"`
+	Reason      string `json:"reason"`
+	ServiceName string `json:"service_name"`
+}
+
+// CancellationReport defines model for CancellationReport.
+type CancellationReport struct {
+	Items []CancellationCount `json:"items"`
+	Total int                 `json:"total"`
+}
+
+// ChurnMonth defines model for ChurnMonth.
+type ChurnMonth struct {
+	ActiveSubscribers  int     `json:"active_subscribers"`
+	ChurnedSubscribers int     `json:"churned_subscribers"`
+	LogoChurnRate      float64 `json:"logo_churn_rate"`
+	Month              string  `json:"month"`
+	NewSubscribers     int     `json:"new_subscribers"`
+
+	// PreviousSubscribers Subscribers of the month before.
+	PreviousSubscribers int `json:"previous_subscribers"`
+}
+
+// ChurnReport defines model for ChurnReport.
+type ChurnReport struct {
+	Months []ChurnMonth `json:"months"`
+}
+
+// Cohort defines model for Cohort.
+type Cohort struct {
+	// Month The month the subscriptions of the cohort start in.
+	Month string `json:"month"`
+
+	// Retained Subscriptions still running 1, 2, ... months after the start month.
+	Retained []int `json:"retained"`
+
+	// Retention The share of the cohort each of retained is.
+	Retention []float64 `json:"retention"`
+	Size      int       `json:"size"`
+}
+
+// CohortReport defines model for CohortReport.
+type CohortReport struct {
+	// AsOf The current month, the last one the retention is known for.
+	AsOf    string   `json:"as_of"`
+	Cohorts []Cohort `json:"cohorts"`
+}
+
+// CreateAPIKeyRequest defines model for CreateAPIKeyRequest.
+type CreateAPIKeyRequest struct {
+	Name string `json:"name"`
+	Role Role   `json:"role"`
+
+	// TenantId Required for user keys issued by platform admins.
+	TenantId *openapi_types.UUID `json:"tenant_id,omitempty"`
+	UserId   *openapi_types.UUID `json:"user_id,omitempty"`
+}
+
 // CreateSubscriptionRequest defines model for CreateSubscriptionRequest.
 type CreateSubscriptionRequest struct {
-	EndDate     *string            `json:"end_date"`
-	Price       int                `json:"price"`
+	EndDate *string `json:"end_date"`
+
+	// Phases Periods with their own price, such as a free trial or an introductory discount, in order. The first starts with the subscription and each next one the month after the previous ends; the regular price applies after the last.
+	Phases *[]Phase `json:"phases,omitempty"`
+
+	// Price Monthly price; defaults to the default price of the service of the catalog the subscription is linked to, and is required otherwise.
+	Price *int `json:"price,omitempty"`
+
+	// Renewal How a subscription with an end date continues: with policy none it ends, with auto its end date moves term_months later once the last month of the term starts. Cancelled subscriptions are not renewed.
+	Renewal *Renewal `json:"renewal,omitempty"`
+
+	// ServiceId Service of the catalog to link the subscription to; its name replaces service_name.
+	ServiceId *openapi_types.UUID `json:"service_id,omitempty"`
+
+	// ServiceName A name or an alias of a service of the catalog, ignoring case, links the subscription to the service and is replaced by its name.
 	ServiceName string             `json:"service_name"`
 	StartDate   string             `json:"start_date"`
 	UserId      openapi_types.UUID `json:"user_id"`
 }
 
-// ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	Errors *string `json:"errors"`
+// CreatedAPIKey defines model for CreatedAPIKey.
+type CreatedAPIKey struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Key Plaintext key. It is not stored and can't be shown again.
+	Key  string `json:"key"`
+	Name string `json:"name"`
+
+	// Prefix First characters of the key, used to recognise it.
+	Prefix    string     `json:"prefix"`
+	RevokedAt *time.Time `json:"revoked_at"`
+	Role      Role       `json:"role"`
+
+	// TenantId Tenant the key is bound to. Null for platform admin keys.
+	TenantId *openapi_types.UUID `json:"tenant_id"`
+	UserId   *openapi_types.UUID `json:"user_id"`
+}
+
+// Forecast defines model for Forecast.
+type Forecast struct {
+	Months    []ForecastMonth `json:"months"`
+	TotalCost int             `json:"total_cost"`
+}
+
+// ForecastItem defines model for ForecastItem.
+type ForecastItem struct {
+	// Grown The price includes the assumed growth.
+	Grown bool `json:"grown"`
+	Price int  `json:"price"`
+
+	// Renewed The month belongs to a term an automatic renewal has not added yet.
+	Renewed        bool               `json:"renewed"`
+	ServiceName    string             `json:"service_name"`
+	SubscriptionId openapi_types.UUID `json:"subscription_id"`
+}
+
+// ForecastMonth defines model for ForecastMonth.
+type ForecastMonth struct {
+	Month string `json:"month"`
+
+	// Subscriptions The subscriptions billed in the month, the most expensive first.
+	Subscriptions []ForecastItem `json:"subscriptions"`
+	TotalCost     int            `json:"total_cost"`
+}
+
+// InvalidParam defines model for InvalidParam.
+type InvalidParam struct {
+	In   InvalidParamIn `json:"in"`
+	Name string         `json:"name"`
+
+	// Pointer JSON pointer to the invalid value inside the request body.
+	Pointer *string `json:"pointer,omitempty"`
+	Reason  string  `json:"reason"`
+}
+
+// InvalidParamIn defines model for InvalidParam.In.
+type InvalidParamIn string
+
+// MonthlyCost defines model for MonthlyCost.
+type MonthlyCost struct {
+	Month     string `json:"month"`
+	TotalCost int    `json:"total_cost"`
+}
+
+// MonthlyCostReport defines model for MonthlyCostReport.
+type MonthlyCostReport struct {
+	Months    []MonthlyCost `json:"months"`
+	TotalCost int           `json:"total_cost"`
+}
+
+// Pause defines model for Pause.
+type Pause struct {
+	// EndDate Last paused month; null while the pause lasts until the subscription is resumed.
+	EndDate   *string `json:"end_date"`
+	StartDate string  `json:"start_date"`
+}
+
+// PauseRequest defines model for PauseRequest.
+type PauseRequest struct {
+	// EndDate Last paused month; without it the pause lasts until the subscription is resumed.
+	EndDate *string `json:"end_date"`
+
+	// StartDate First paused month; the current month by default.
+	StartDate *string `json:"start_date,omitempty"`
+}
+
+// Phase defines model for Phase.
+type Phase struct {
+	EndDate   string `json:"end_date"`
+	Price     int    `json:"price"`
+	StartDate string `json:"start_date"`
+}
+
+// Problem Error details in the RFC 7807 problem+json format.
+type Problem struct {
+	Detail   *string `json:"detail,omitempty"`
+	Instance *string `json:"instance,omitempty"`
+
+	// InvalidParams Every request field that violates the contract.
+	InvalidParams *[]InvalidParam `json:"invalid_params,omitempty"`
+	RequestId     *string         `json:"request_id,omitempty"`
+	Status        int             `json:"status"`
+	Title         string          `json:"title"`
+	Type          string          `json:"type"`
+}
+
+// ReminderPreferences Which reminders the user gets and how many days ahead. Reminders are sent through the channel the service is configured with; the email channel needs email.
+type ReminderPreferences struct {
+	// DaysBefore Days before the change to remind; 7 by default.
+	DaysBefore *int    `json:"days_before,omitempty"`
+	Email      *string `json:"email,omitempty"`
+
+	// Ends Remind before a subscription ends.
+	Ends bool `json:"ends"`
+
+	// PriceChanges Remind before the price changes, as a phase starts or ends.
+	PriceChanges bool `json:"price_changes"`
+
+	// Renewals Remind before a subscription renews automatically.
+	Renewals bool `json:"renewals"`
+}
+
+// Renewal How a subscription with an end date continues: with policy none it ends, with auto its end date moves term_months later once the last month of the term starts. Cancelled subscriptions are not renewed.
+type Renewal struct {
+	Policy RenewalPolicy `json:"policy"`
+
+	// TermMonths Length of a term; required with policy auto.
+	TermMonths *int `json:"term_months,omitempty"`
+}
+
+// RenewalPolicy defines model for Renewal.Policy.
+type RenewalPolicy string
+
+// RenewalEvent Set on renewed events.
+type RenewalEvent struct {
+	EndDate         string `json:"end_date"`
+	PreviousEndDate string `json:"previous_end_date"`
+	TermMonths      int    `json:"term_months"`
+}
+
+// ResumeRequest defines model for ResumeRequest.
+type ResumeRequest struct {
+	// ResumeDate First month billed again; the current month by default.
+	ResumeDate *string `json:"resume_date,omitempty"`
+}
+
+// RevenueMonth defines model for RevenueMonth.
+type RevenueMonth struct {
+	// Arr MRR over a year.
+	Arr            int    `json:"arr"`
+	ChurnedMrr     int    `json:"churned_mrr"`
+	ContractionMrr int    `json:"contraction_mrr"`
+	ExpansionMrr   int    `json:"expansion_mrr"`
+	Month          string `json:"month"`
+	Mrr            int    `json:"mrr"`
+
+	// NetNewMrr new_mrr + expansion_mrr - churned_mrr - contraction_mrr.
+	NetNewMrr int `json:"net_new_mrr"`
+	NewMrr    int `json:"new_mrr"`
+}
+
+// RevenueReport defines model for RevenueReport.
+type RevenueReport struct {
+	Months []RevenueMonth `json:"months"`
+}
+
+// Role defines model for Role.
+type Role string
+
+// Service defines model for Service.
+type Service struct {
+	Aliases      []string           `json:"aliases"`
+	Category     *string            `json:"category,omitempty"`
+	CreatedAt    time.Time          `json:"created_at"`
+	Currency     string             `json:"currency"`
+	DefaultPrice *int64             `json:"default_price,omitempty"`
+	Id           openapi_types.UUID `json:"id"`
+	Name         string             `json:"name"`
+	UpdatedAt    time.Time          `json:"updated_at"`
+	VendorUrl    *string            `json:"vendor_url,omitempty"`
+}
+
+// ServiceList defines model for ServiceList.
+type ServiceList struct {
+	Items []Service `json:"items"`
+}
+
+// ServiceRequest defines model for ServiceRequest.
+type ServiceRequest struct {
+	// Aliases Other names of the service, such as other spellings; compared ignoring case.
+	Aliases  *[]string `json:"aliases,omitempty"`
+	Category *string   `json:"category,omitempty"`
+
+	// Currency ISO 4217 code of the currency of the default price.
+	Currency *string `json:"currency,omitempty"`
+
+	// DefaultPrice Usual monthly price.
+	DefaultPrice *int64  `json:"default_price,omitempty"`
+	Name         string  `json:"name"`
+	VendorUrl    *string `json:"vendor_url,omitempty"`
 }
 
 // Subscription defines model for Subscription.
 type Subscription struct {
-	CreatedAt   *time.Time          `json:"created_at,omitempty"`
-	EndDate     *string             `json:"end_date"`
-	Id          *openapi_types.UUID `json:"id,omitempty"`
-	Price       int                 `json:"price"`
+	Cancellation *Cancellation       `json:"cancellation,omitempty"`
+	CreatedAt    *time.Time          `json:"created_at,omitempty"`
+	EndDate      *string             `json:"end_date"`
+	Id           *openapi_types.UUID `json:"id,omitempty"`
+
+	// Pauses Periods the subscription is on hold and not billed, in order.
+	Pauses *[]Pause `json:"pauses,omitempty"`
+
+	// Phases Periods with their own price, such as a free trial or an introductory discount, in order. The first starts with the subscription and each next one the month after the previous ends; the regular price applies after the last.
+	Phases *[]Phase `json:"phases,omitempty"`
+	Price  int      `json:"price"`
+
+	// Renewal How a subscription with an end date continues: with policy none it ends, with auto its end date moves term_months later once the last month of the term starts. Cancelled subscriptions are not renewed.
+	Renewal *Renewal `json:"renewal,omitempty"`
+
+	// ServiceId The service of the catalog the subscription is to, if any.
+	ServiceId   *openapi_types.UUID `json:"service_id,omitempty"`
 	ServiceName string              `json:"service_name"`
 	StartDate   string              `json:"start_date"`
-	UpdatedAt   *time.Time          `json:"updated_at,omitempty"`
-	UserId      openapi_types.UUID  `json:"user_id"`
+
+	// Status State of the subscription in the current month: scheduled before it starts, ended after its end date, paused during a pause and active otherwise.
+	Status    *SubscriptionStatus `json:"status,omitempty"`
+	UpdatedAt *time.Time          `json:"updated_at,omitempty"`
+	UserId    openapi_types.UUID  `json:"user_id"`
+}
+
+// SubscriptionEvent defines model for SubscriptionEvent.
+type SubscriptionEvent struct {
+	// BudgetAlert Set on budget_exceeded events: the first month the change of the subscription took over the budget, with the cost of that month after the change.
+	BudgetAlert *BudgetAlertEvent  `json:"budget_alert,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+	Id          openapi_types.UUID `json:"id"`
+
+	// Renewal Set on renewed events.
+	Renewal *RenewalEvent         `json:"renewal,omitempty"`
+	Type    SubscriptionEventType `json:"type"`
+}
+
+// SubscriptionEventType defines model for SubscriptionEvent.Type.
+type SubscriptionEventType string
+
+// SubscriptionEventList defines model for SubscriptionEventList.
+type SubscriptionEventList struct {
+	Items []SubscriptionEvent `json:"items"`
 }
 
+// SubscriptionStatus State of the subscription in the current month: scheduled before it starts, ended after its end date, paused during a pause and active otherwise.
+type SubscriptionStatus string
+
 // UpdateSubscriptionRequest defines model for UpdateSubscriptionRequest.
 type UpdateSubscriptionRequest struct {
-	EndDate     *string `json:"end_date"`
-	Price       int     `json:"price"`
-	ServiceName string  `json:"service_name"`
-	StartDate   string  `json:"start_date"`
+	EndDate *string `json:"end_date"`
+
+	// Phases Periods with their own price, such as a free trial or an introductory discount, in order. The first starts with the subscription and each next one the month after the previous ends; the regular price applies after the last.
+	Phases *[]Phase `json:"phases,omitempty"`
+	Price  int      `json:"price"`
+
+	// Renewal How a subscription with an end date continues: with policy none it ends, with auto its end date moves term_months later once the last month of the term starts. Cancelled subscriptions are not renewed.
+	Renewal *Renewal `json:"renewal,omitempty"`
+
+	// ServiceId Service of the catalog to link the subscription to; its name replaces service_name.
+	ServiceId *openapi_types.UUID `json:"service_id,omitempty"`
+
+	// ServiceName A name or an alias of a service of the catalog, ignoring case, links the subscription to the service and is replaced by its name.
+	ServiceName string `json:"service_name"`
+	StartDate   string `json:"start_date"`
+}
+
+// BudgetID defines model for BudgetID.
+type BudgetID = openapi_types.UUID
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// ServiceID defines model for ServiceID.
+type ServiceID = openapi_types.UUID
+
+// TenantID defines model for TenantID.
+type TenantID = openapi_types.UUID
+
+// UserID defines model for UserID.
+type UserID = openapi_types.UUID
+
+// BadRequest Error details in the RFC 7807 problem+json format.
+type BadRequest = Problem
+
+// Conflict Error details in the RFC 7807 problem+json format.
+type Conflict = Problem
+
+// Forbidden Error details in the RFC 7807 problem+json format.
+type Forbidden = Problem
+
+// InternalError Error details in the RFC 7807 problem+json format.
+type InternalError = Problem
+
+// NotFound Error details in the RFC 7807 problem+json format.
+type NotFound = Problem
+
+// TooManyRequests Error details in the RFC 7807 problem+json format.
+type TooManyRequests = Problem
+
+// Unauthorized Error details in the RFC 7807 problem+json format.
+type Unauthorized = Problem
+
+// UnprocessableEntity Error details in the RFC 7807 problem+json format.
+type UnprocessableEntity = Problem
+
+// GetAnalyticsCancellationsParams defines parameters for GetAnalyticsCancellations.
+type GetAnalyticsCancellationsParams struct {
+	StartDate   *string             `form:"start_date,omitempty" json:"start_date,omitempty"`
+	EndDate     *string             `form:"end_date,omitempty" json:"end_date,omitempty"`
+	UserId      *openapi_types.UUID `form:"user_id,omitempty" json:"user_id,omitempty"`
+	ServiceName *string             `form:"service_name,omitempty" json:"service_name,omitempty"`
+
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// GetAnalyticsChurnParams defines parameters for GetAnalyticsChurn.
+type GetAnalyticsChurnParams struct {
+	StartDate   string  `form:"start_date" json:"start_date"`
+	EndDate     string  `form:"end_date" json:"end_date"`
+	ServiceName *string `form:"service_name,omitempty" json:"service_name,omitempty"`
+
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// GetAnalyticsCohortsParams defines parameters for GetAnalyticsCohorts.
+type GetAnalyticsCohortsParams struct {
+	From        string                           `form:"from" json:"from"`
+	To          string                           `form:"to" json:"to"`
+	ServiceName *string                          `form:"service_name,omitempty" json:"service_name,omitempty"`
+	Format      *GetAnalyticsCohortsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// GetAnalyticsCohortsParamsFormat defines parameters for GetAnalyticsCohorts.
+type GetAnalyticsCohortsParamsFormat string
+
+// GetAnalyticsMrrParams defines parameters for GetAnalyticsMrr.
+type GetAnalyticsMrrParams struct {
+	StartDate   string  `form:"start_date" json:"start_date"`
+	EndDate     string  `form:"end_date" json:"end_date"`
+	ServiceName *string `form:"service_name,omitempty" json:"service_name,omitempty"`
+
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// GetServicesParams defines parameters for GetServices.
+type GetServicesParams struct {
+	Category *string `form:"category,omitempty" json:"category,omitempty"`
+
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// PostServicesParams defines parameters for PostServices.
+type PostServicesParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// DeleteServicesServiceIdParams defines parameters for DeleteServicesServiceId.
+type DeleteServicesServiceIdParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// GetServicesServiceIdParams defines parameters for GetServicesServiceId.
+type GetServicesServiceIdParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// PutServicesServiceIdParams defines parameters for PutServicesServiceId.
+type PutServicesServiceIdParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
 }
 
 // GetSubscriptionsParams defines parameters for GetSubscriptions.
@@ -57,6 +639,28 @@ type GetSubscriptionsParams struct {
 	EndDate     *string             `form:"end_date,omitempty" json:"end_date,omitempty"`
 	Limit       *int                `form:"limit,omitempty" json:"limit,omitempty"`
 	Offset      *int                `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// PostSubscriptionsParams defines parameters for PostSubscriptions.
+type PostSubscriptionsParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+
+	// IdempotencyKey Client-generated key that makes retries safe. A retry with the same key and body gets the stored response with the Idempotent-Replayed header; the same key with another body is rejected with 422, and a retry while the first request is still running gets 409. Keys are kept for 24 hours.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetSubscriptionsForecastParams defines parameters for GetSubscriptionsForecast.
+type GetSubscriptionsForecastParams struct {
+	UserId        *openapi_types.UUID `form:"user_id,omitempty" json:"user_id,omitempty"`
+	Months        *int                `form:"months,omitempty" json:"months,omitempty"`
+	GrowthPercent *int                `form:"growth_percent,omitempty" json:"growth_percent,omitempty"`
+
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
 }
 
 // GetSubscriptionsSumParams defines parameters for GetSubscriptionsSum.
@@ -65,10 +669,143 @@ type GetSubscriptionsSumParams struct {
 	EndDate     string              `form:"end_date" json:"end_date"`
 	UserId      *openapi_types.UUID `form:"user_id,omitempty" json:"user_id,omitempty"`
 	ServiceName *string             `form:"service_name,omitempty" json:"service_name,omitempty"`
+
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// GetSubscriptionsSumMonthlyParams defines parameters for GetSubscriptionsSumMonthly.
+type GetSubscriptionsSumMonthlyParams struct {
+	StartDate   string              `form:"start_date" json:"start_date"`
+	EndDate     string              `form:"end_date" json:"end_date"`
+	UserId      *openapi_types.UUID `form:"user_id,omitempty" json:"user_id,omitempty"`
+	ServiceName *string             `form:"service_name,omitempty" json:"service_name,omitempty"`
+
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// DeleteSubscriptionsIdParams defines parameters for DeleteSubscriptionsId.
+type DeleteSubscriptionsIdParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// GetSubscriptionsIdParams defines parameters for GetSubscriptionsId.
+type GetSubscriptionsIdParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// PutSubscriptionsIdParams defines parameters for PutSubscriptionsId.
+type PutSubscriptionsIdParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// PostSubscriptionsIdCancelParams defines parameters for PostSubscriptionsIdCancel.
+type PostSubscriptionsIdCancelParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// GetSubscriptionsIdEventsParams defines parameters for GetSubscriptionsIdEvents.
+type GetSubscriptionsIdEventsParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// PostSubscriptionsIdPauseParams defines parameters for PostSubscriptionsIdPause.
+type PostSubscriptionsIdPauseParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// PostSubscriptionsIdResumeParams defines parameters for PostSubscriptionsIdResume.
+type PostSubscriptionsIdResumeParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// GetUsersIdBudgetStatusParams defines parameters for GetUsersIdBudgetStatus.
+type GetUsersIdBudgetStatusParams struct {
+	Month string `form:"month" json:"month"`
+
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// GetUsersIdBudgetsParams defines parameters for GetUsersIdBudgets.
+type GetUsersIdBudgetsParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
 }
 
+// PostUsersIdBudgetsParams defines parameters for PostUsersIdBudgets.
+type PostUsersIdBudgetsParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// DeleteUsersIdBudgetsBudgetIdParams defines parameters for DeleteUsersIdBudgetsBudgetId.
+type DeleteUsersIdBudgetsBudgetIdParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// GetUsersIdBudgetsBudgetIdParams defines parameters for GetUsersIdBudgetsBudgetId.
+type GetUsersIdBudgetsBudgetIdParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// PutUsersIdBudgetsBudgetIdParams defines parameters for PutUsersIdBudgetsBudgetId.
+type PutUsersIdBudgetsBudgetIdParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// GetUsersIdReminderPreferencesParams defines parameters for GetUsersIdReminderPreferences.
+type GetUsersIdReminderPreferencesParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// PutUsersIdReminderPreferencesParams defines parameters for PutUsersIdReminderPreferences.
+type PutUsersIdReminderPreferencesParams struct {
+	// XTenantID Organization to act within. Only platform admins, whose credentials are not bound to a tenant, need it; for everyone else the tenant comes from the credentials.
+	XTenantID *TenantID `json:"X-Tenant-ID,omitempty"`
+}
+
+// PostAdminApiKeysJSONRequestBody defines body for PostAdminApiKeys for application/json ContentType.
+type PostAdminApiKeysJSONRequestBody = CreateAPIKeyRequest
+
+// PostServicesJSONRequestBody defines body for PostServices for application/json ContentType.
+type PostServicesJSONRequestBody = ServiceRequest
+
+// PutServicesServiceIdJSONRequestBody defines body for PutServicesServiceId for application/json ContentType.
+type PutServicesServiceIdJSONRequestBody = ServiceRequest
+
 // PostSubscriptionsJSONRequestBody defines body for PostSubscriptions for application/json ContentType.
 type PostSubscriptionsJSONRequestBody = CreateSubscriptionRequest
 
 // PutSubscriptionsIdJSONRequestBody defines body for PutSubscriptionsId for application/json ContentType.
 type PutSubscriptionsIdJSONRequestBody = UpdateSubscriptionRequest
+
+// PostSubscriptionsIdCancelJSONRequestBody defines body for PostSubscriptionsIdCancel for application/json ContentType.
+type PostSubscriptionsIdCancelJSONRequestBody = CancelRequest
+
+// PostSubscriptionsIdPauseJSONRequestBody defines body for PostSubscriptionsIdPause for application/json ContentType.
+type PostSubscriptionsIdPauseJSONRequestBody = PauseRequest
+
+// PostSubscriptionsIdResumeJSONRequestBody defines body for PostSubscriptionsIdResume for application/json ContentType.
+type PostSubscriptionsIdResumeJSONRequestBody = ResumeRequest
+
+// PostUsersIdBudgetsJSONRequestBody defines body for PostUsersIdBudgets for application/json ContentType.
+type PostUsersIdBudgetsJSONRequestBody = BudgetRequest
+
+// PutUsersIdBudgetsBudgetIdJSONRequestBody defines body for PutUsersIdBudgetsBudgetId for application/json ContentType.
+type PutUsersIdBudgetsBudgetIdJSONRequestBody = BudgetRequest
+
+// PutUsersIdReminderPreferencesJSONRequestBody defines body for PutUsersIdReminderPreferences for application/json ContentType.
+type PutUsersIdReminderPreferencesJSONRequestBody = ReminderPreferences
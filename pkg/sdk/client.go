@@ -0,0 +1,453 @@
+// Package sdk is a Go client of the subscription API on top of the generated
+// pkg/client: months are time.Time, errors are typed, lists are iterators and
+// idempotent calls are retried.
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"subscription-service/pkg/client"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+	// maxOffset is the largest offset the API accepts.
+	maxOffset = 10000
+
+	problemIdempotencyKeyReused = "/problems/idempotency-key-reused"
+
+	defaultMaxAttempts     = 3
+	defaultInitialInterval = 100 * time.Millisecond
+	defaultMaxInterval     = 2 * time.Second
+)
+
+// Client calls the subscription API. It is safe for concurrent use.
+type Client struct {
+	api *client.ClientWithResponses
+
+	apiKey, token string
+	tenant        uuid.UUID
+
+	httpClient client.HttpRequestDoer
+	retry      retryDoer
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithAPIKey authenticates with an API key.
+func WithAPIKey(key string) Option {
+	return func(c *Client) {
+		c.apiKey = key
+	}
+}
+
+// WithToken authenticates with a bearer JWT.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithTenant sets the tenant to act within. Only platform admins need it.
+func WithTenant(tenant uuid.UUID) Option {
+	return func(c *Client) {
+		c.tenant = tenant
+	}
+}
+
+// WithHTTPClient sends the requests with doer instead of http.DefaultClient.
+func WithHTTPClient(doer client.HttpRequestDoer) Option {
+	return func(c *Client) {
+		c.httpClient = doer
+	}
+}
+
+// WithRetry sets how idempotent calls are retried: up to maxAttempts attempts in all,
+// waiting from initial up to max between them. A Retry-After of the response takes
+// precedence. maxAttempts of 1 disables retries; the default is 3 attempts waiting
+// 100ms to 2s.
+func WithRetry(maxAttempts int, initial, max time.Duration) Option {
+	return func(c *Client) {
+		c.retry.maxAttempts = maxAttempts
+		c.retry.initial = initial
+		c.retry.max = max
+	}
+}
+
+// New returns a client of the API at baseURL, such as "https://subs.example.com".
+func New(baseURL string, opts ...Option) (*Client, error) {
+	c := &Client{
+		httpClient: http.DefaultClient,
+		retry: retryDoer{
+			maxAttempts: defaultMaxAttempts,
+			initial:     defaultInitialInterval,
+			max:         defaultMaxInterval,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.retry.next = c.httpClient
+
+	api, err := client.NewClientWithResponses(baseURL,
+		client.WithHTTPClient(&c.retry),
+		client.WithRequestEditorFn(c.authenticate),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("sdk: %w", err)
+	}
+
+	c.api = api
+
+	return c, nil
+}
+
+func (c *Client) authenticate(_ context.Context, req *http.Request) error {
+	switch {
+	case c.apiKey != "":
+		req.Header.Set("X-API-Key", c.apiKey)
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	if c.tenant != uuid.Nil {
+		req.Header.Set("X-Tenant-ID", c.tenant.String())
+	}
+
+	return nil
+}
+
+// Create creates a subscription. It is sent with an idempotency key, so it is retried
+// like the other calls without the risk of creating it twice.
+func (c *Client) Create(ctx context.Context, s NewSubscription) (*Subscription, error) {
+	key := s.IdempotencyKey
+	if key == "" {
+		key = uuid.NewString()
+	}
+
+	resp, err := c.api.PostSubscriptionsWithResponse(ctx,
+		&client.PostSubscriptionsParams{IdempotencyKey: &key},
+		client.CreateSubscriptionRequest{
+			ServiceName: s.ServiceName,
+			Price:       &s.Price,
+			UserId:      s.UserID,
+			StartDate:   FormatMonth(s.Start),
+			EndDate:     formatMonthPtr(s.End),
+			Phases:      toClientPhases(s.Phases),
+			Renewal:     toClientRenewal(s.Renewal),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	sub, err := fromClient(*resp.JSON201)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sub, nil
+}
+
+// Get returns the subscription with id, or ErrNotFound.
+func (c *Client) Get(ctx context.Context, id uuid.UUID) (*Subscription, error) {
+	resp, err := c.api.GetSubscriptionsIdWithResponse(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	sub, err := fromClient(*resp.JSON200)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sub, nil
+}
+
+// Update replaces the subscription with id.
+func (c *Client) Update(ctx context.Context, id uuid.UUID, s SubscriptionUpdate) error {
+	resp, err := c.api.PutSubscriptionsIdWithResponse(ctx, id, nil, client.UpdateSubscriptionRequest{
+		ServiceName: s.ServiceName,
+		Price:       s.Price,
+		StartDate:   FormatMonth(s.Start),
+		EndDate:     formatMonthPtr(s.End),
+		Phases:      toClientPhases(s.Phases),
+		Renewal:     toClientRenewal(s.Renewal),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	return nil
+}
+
+// Pause puts the subscription with id on hold from the month of start until the month of
+// end, or until it is resumed when end is nil. A zero start pauses it from the current
+// month.
+func (c *Client) Pause(ctx context.Context, id uuid.UUID, start time.Time, end *time.Time) (*Subscription, error) {
+	body := client.PauseRequest{EndDate: formatMonthPtr(end)}
+	if !start.IsZero() {
+		body.StartDate = formatMonthPtr(&start)
+	}
+
+	resp, err := c.api.PostSubscriptionsIdPauseWithResponse(ctx, id, nil, body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	sub, err := fromClient(*resp.JSON200)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sub, nil
+}
+
+// Resume ends the pause of the subscription with id, so that it is billed again from the
+// month of resume, or from the current month when resume is zero.
+func (c *Client) Resume(ctx context.Context, id uuid.UUID, resume time.Time) (*Subscription, error) {
+	body := client.ResumeRequest{}
+	if !resume.IsZero() {
+		body.ResumeDate = formatMonthPtr(&resume)
+	}
+
+	resp, err := c.api.PostSubscriptionsIdResumeWithResponse(ctx, id, nil, body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	sub, err := fromClient(*resp.JSON200)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sub, nil
+}
+
+// Cancel ends the subscription with id with the month of effective, recording reason, one
+// of the reason codes the service accepts, and note. A zero effective is the current
+// month, or the first month of a subscription that has not started.
+func (c *Client) Cancel(ctx context.Context, id uuid.UUID, effective time.Time, reason, note string) (*Subscription, error) {
+	body := client.CancelRequest{Reason: reason}
+	if !effective.IsZero() {
+		body.EffectiveDate = formatMonthPtr(&effective)
+	}
+	if note != "" {
+		body.Note = &note
+	}
+
+	resp, err := c.api.PostSubscriptionsIdCancelWithResponse(ctx, id, nil, body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	sub, err := fromClient(*resp.JSON200)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sub, nil
+}
+
+// Events returns the history of the subscription with id, oldest first, such as its
+// automatic renewals.
+func (c *Client) Events(ctx context.Context, id uuid.UUID) ([]Event, error) {
+	resp, err := c.api.GetSubscriptionsIdEventsWithResponse(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	events := make([]Event, 0, len(resp.JSON200.Items))
+	for _, e := range resp.JSON200.Items {
+		event, err := fromClientEvent(e)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// Delete deletes the subscription with id.
+func (c *Client) Delete(ctx context.Context, id uuid.UUID) error {
+	resp, err := c.api.DeleteSubscriptionsIdWithResponse(ctx, id, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		return responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	return nil
+}
+
+// List iterates over the subscriptions matching the filter, fetching them a page at a
+// time as the loop goes. An error ends the iteration.
+func (c *Client) List(ctx context.Context, f ListFilter) iter.Seq2[Subscription, error] {
+	return func(yield func(Subscription, error) bool) {
+		pageSize := f.PageSize
+		if pageSize <= 0 {
+			pageSize = defaultPageSize
+		}
+		pageSize = min(pageSize, maxPageSize)
+
+		params := f.params()
+		params.Limit = &pageSize
+
+		for offset := 0; ; offset += pageSize {
+			if offset > maxOffset {
+				yield(Subscription{}, ErrListTooLong)
+				return
+			}
+
+			page := *params
+			page.Offset = &offset
+
+			resp, err := c.api.GetSubscriptionsWithResponse(ctx, &page)
+			if err != nil {
+				yield(Subscription{}, err)
+				return
+			}
+			if resp.JSON200 == nil {
+				yield(Subscription{}, responseError(resp.HTTPResponse, resp.Body))
+				return
+			}
+
+			for _, s := range *resp.JSON200 {
+				sub, err := fromClient(s)
+				if !yield(sub, err) || err != nil {
+					return
+				}
+			}
+
+			if len(*resp.JSON200) < pageSize {
+				return
+			}
+		}
+	}
+}
+
+func (f ListFilter) params() *client.GetSubscriptionsParams {
+	params := &client.GetSubscriptionsParams{Price: f.Price}
+
+	if f.UserID != uuid.Nil {
+		params.UserId = &f.UserID
+	}
+	if f.ServiceName != "" {
+		params.ServiceName = &f.ServiceName
+	}
+	if !f.Start.IsZero() {
+		params.StartDate = formatMonthPtr(&f.Start)
+	}
+	if !f.End.IsZero() {
+		params.EndDate = formatMonthPtr(&f.End)
+	}
+
+	return params
+}
+
+// Sum returns the cost of the subscriptions matching the filter over the months of its
+// period, leaving out the paused ones.
+func (c *Client) Sum(ctx context.Context, f SumFilter) (int, error) {
+	params := &client.GetSubscriptionsSumParams{
+		StartDate: FormatMonth(f.Start),
+		EndDate:   FormatMonth(f.End),
+	}
+	if f.UserID != uuid.Nil {
+		params.UserId = &f.UserID
+	}
+	if f.ServiceName != "" {
+		params.ServiceName = &f.ServiceName
+	}
+
+	resp, err := c.api.GetSubscriptionsSumWithResponse(ctx, params)
+	if err != nil {
+		return 0, err
+	}
+	if resp.JSON200 == nil {
+		return 0, responseError(resp.HTTPResponse, resp.Body)
+	}
+
+	return resp.JSON200.TotalCost, nil
+}
+
+// responseError turns an unexpected response into an *APIError, or a *ValidationError
+// for rejected input.
+func responseError(resp *http.Response, body []byte) error {
+	if resp == nil {
+		return errors.New("sdk: no response")
+	}
+
+	apiErr := APIError{StatusCode: resp.StatusCode}
+
+	var problem client.Problem
+	if err := json.Unmarshal(body, &problem); err == nil && problem.Title != "" {
+		apiErr.Type = problem.Type
+		apiErr.Title = problem.Title
+		if problem.Detail != nil {
+			apiErr.Detail = *problem.Detail
+		}
+		if problem.RequestId != nil {
+			apiErr.RequestID = *problem.RequestId
+		}
+	}
+
+	if apiErr.RequestID == "" {
+		apiErr.RequestID = resp.Header.Get("X-Request-ID")
+	}
+
+	// A reused idempotency key is a 422 too, but the input itself is fine.
+	validation := resp.StatusCode == http.StatusBadRequest ||
+		resp.StatusCode == http.StatusUnprocessableEntity && apiErr.Type != problemIdempotencyKeyReused
+	if !validation {
+		return &apiErr
+	}
+
+	valErr := &ValidationError{APIError: apiErr}
+
+	if problem.InvalidParams != nil {
+		for _, p := range *problem.InvalidParams {
+			valErr.Fields = append(valErr.Fields, FieldError{
+				Name:   p.Name,
+				In:     string(p.In),
+				Reason: p.Reason,
+			})
+		}
+	}
+
+	return valErr
+}
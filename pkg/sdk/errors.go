@@ -0,0 +1,98 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Errors to match the errors of the client against with errors.Is.
+var (
+	ErrNotFound     = errors.New("sdk: not found")
+	ErrConflict     = errors.New("sdk: conflict")
+	ErrUnauthorized = errors.New("sdk: unauthorized")
+	ErrForbidden    = errors.New("sdk: forbidden")
+	ErrRateLimited  = errors.New("sdk: rate limited")
+	// ErrListTooLong is returned by List when the listing reaches the largest offset the
+	// API accepts; narrow the filter to get the rest.
+	ErrListTooLong = errors.New("sdk: list exceeds the largest offset of the API")
+)
+
+// APIError is a response of the API with an error status. The fields come from the
+// problem details of the response when the server sent them.
+type APIError struct {
+	StatusCode int
+	// Type is the problem type URI, such as "/problems/not-found".
+	Type      string
+	Title     string
+	Detail    string
+	RequestID string
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+
+	title := e.Title
+	if title == "" {
+		title = http.StatusText(e.StatusCode)
+	}
+	fmt.Fprintf(&b, "sdk: %d %s", e.StatusCode, title)
+
+	if e.Detail != "" {
+		b.WriteString(": " + e.Detail)
+	}
+	if e.RequestID != "" {
+		b.WriteString(" (request id " + e.RequestID + ")")
+	}
+
+	return b.String()
+}
+
+// Is reports whether the status of the response matches one of the sentinel errors.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	default:
+		return false
+	}
+}
+
+// ValidationError is returned when the API rejects the input: 400 for malformed
+// parameters, with a FieldError each, and 422 for data that is well-formed but
+// inconsistent, such as an end date before the start date.
+type ValidationError struct {
+	APIError
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msg := e.APIError.Error()
+
+	for _, f := range e.Fields {
+		msg += fmt.Sprintf("; %s: %s", f.Name, f.Reason)
+	}
+
+	return msg
+}
+
+func (e *ValidationError) Unwrap() error {
+	return &e.APIError
+}
+
+// FieldError is a parameter or body field rejected by the API.
+type FieldError struct {
+	Name string
+	// In is where the field is: "query", "path", "header" or "body".
+	In     string
+	Reason string
+}
@@ -0,0 +1,122 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	"subscription-service/pkg/client"
+)
+
+// retryDoer retries idempotent requests that failed on the network or with a status
+// that is likely to pass on a retry.
+type retryDoer struct {
+	next        client.HttpRequestDoer
+	maxAttempts int
+	initial     time.Duration
+	max         time.Duration
+}
+
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	if d.maxAttempts <= 1 || !idempotent(req) {
+		return d.next.Do(req)
+	}
+
+	b := backoff.NewExponentialBackOff(
+		backoff.WithInitialInterval(d.initial),
+		backoff.WithMaxInterval(d.max),
+		backoff.WithMaxElapsedTime(0),
+	)
+
+	for attempt := 1; ; attempt++ {
+		resp, err := d.next.Do(req)
+		if attempt == d.maxAttempts || !retryable(req.Context(), resp, err) {
+			return resp, err
+		}
+
+		wait := b.NextBackOff()
+		if resp != nil {
+			if after := retryAfter(resp); after > 0 {
+				wait = after
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// idempotent reports whether the request may be sent again: by its method, or for a
+// POST by its Idempotency-Key. A body that can't be rewound rules a retry out.
+func idempotent(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		return req.Header.Get("Idempotency-Key") != ""
+	default:
+		return false
+	}
+}
+
+func retryable(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	case http.StatusConflict:
+		// The first request with the same idempotency key is still running.
+		return resp.Header.Get("Retry-After") != ""
+	default:
+		return false
+	}
+}
+
+func retryAfter(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,353 @@
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"subscription-service/pkg/sdk"
+	"subscription-service/pkg/sdk/sdktest"
+)
+
+func TestLifecycle(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+
+	c := srv.NewClient()
+	ctx := context.Background()
+	userID := uuid.New()
+
+	sub, err := c.Create(ctx, sdk.NewSubscription{
+		ServiceName: "Yandex Plus",
+		Price:       400,
+		UserID:      userID,
+		Start:       time.Date(2025, time.July, 15, 10, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if !sub.Start.Equal(sdk.Month(2025, time.July)) || sub.End != nil {
+		t.Fatalf("created subscription has period %v-%v", sub.Start, sub.End)
+	}
+
+	end := sdk.Month(2025, time.December)
+	err = c.Update(ctx, sub.ID, sdk.SubscriptionUpdate{
+		ServiceName: sub.ServiceName,
+		Price:       500,
+		Start:       sub.Start,
+		End:         &end,
+	})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	got, err := c.Get(ctx, sub.ID)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Price != 500 || got.End == nil || !got.End.Equal(end) {
+		t.Fatalf("updated subscription = %+v", got)
+	}
+
+	total, err := c.Sum(ctx, sdk.SumFilter{
+		Start:  sdk.Month(2025, time.January),
+		End:    sdk.Month(2025, time.December),
+		UserID: userID,
+	})
+	if err != nil {
+		t.Fatalf("sum: %v", err)
+	}
+	if total != 3000 {
+		t.Fatalf("sum = %d, want 3000", total)
+	}
+
+	if err := c.Delete(ctx, sub.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	_, err = c.Get(ctx, sub.ID)
+	if !errors.Is(err, sdk.ErrNotFound) {
+		t.Fatalf("get after delete: err = %v, want ErrNotFound", err)
+	}
+
+	var apiErr *sdk.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound || apiErr.Type != "/problems/not-found" {
+		t.Fatalf("get after delete: err = %#v", err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+
+	c := srv.NewClient()
+	ctx := context.Background()
+
+	sub := sdk.NewSubscription{
+		ServiceName: "Kinopoisk",
+		Price:       300,
+		UserID:      uuid.New(),
+		Start:       sdk.Month(2025, time.March),
+	}
+	if _, err := c.Create(ctx, sub); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	sub.Start = sdk.Month(2025, time.June)
+	if _, err := c.Create(ctx, sub); !errors.Is(err, sdk.ErrConflict) {
+		t.Fatalf("overlapping create: err = %v, want ErrConflict", err)
+	}
+
+	sub.ServiceName = ""
+	_, err := c.Create(ctx, sub)
+
+	var valErr *sdk.ValidationError
+	if !errors.As(err, &valErr) {
+		t.Fatalf("invalid create: err = %v, want a ValidationError", err)
+	}
+	if len(valErr.Fields) != 1 || valErr.Fields[0].Name != "service_name" || valErr.Fields[0].In != "body" {
+		t.Fatalf("invalid create: fields = %+v", valErr.Fields)
+	}
+
+	unauthenticated, err := sdk.New(srv.URL, sdk.WithHTTPClient(srv.Client()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := unauthenticated.Get(ctx, uuid.New()); !errors.Is(err, sdk.ErrUnauthorized) {
+		t.Fatalf("unauthenticated get: err = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestPauseAndResume(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+
+	c := srv.NewClient()
+	ctx := context.Background()
+
+	sub, err := c.Create(ctx, sdk.NewSubscription{
+		ServiceName: "Okko",
+		Price:       399,
+		UserID:      uuid.New(),
+		Start:       sdk.Month(2025, time.January),
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	paused, err := c.Pause(ctx, sub.ID, sdk.Month(2025, time.March), nil)
+	if err != nil {
+		t.Fatalf("pause: %v", err)
+	}
+	if len(paused.Pauses) != 1 || !paused.Pauses[0].Start.Equal(sdk.Month(2025, time.March)) || paused.Pauses[0].End != nil {
+		t.Fatalf("paused subscription has pauses %+v", paused.Pauses)
+	}
+
+	resumed, err := c.Resume(ctx, sub.ID, sdk.Month(2025, time.June))
+	if err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+	if len(resumed.Pauses) != 1 || resumed.Pauses[0].End == nil || !resumed.Pauses[0].End.Equal(sdk.Month(2025, time.May)) {
+		t.Fatalf("resumed subscription has pauses %+v", resumed.Pauses)
+	}
+
+	_, err = c.Resume(ctx, sub.ID, time.Time{})
+
+	var apiErr *sdk.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("resume when not paused: err = %v, want a 422 APIError", err)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+
+	c := srv.NewClient()
+	ctx := context.Background()
+
+	sub, err := c.Create(ctx, sdk.NewSubscription{
+		ServiceName: "Okko",
+		Price:       399,
+		UserID:      uuid.New(),
+		Start:       sdk.Month(2090, time.January),
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	cancelled, err := c.Cancel(ctx, sub.ID, sdk.Month(2090, time.June), "too_expensive", "family plan")
+	if err != nil {
+		t.Fatalf("cancel: %v", err)
+	}
+
+	want := sdk.Month(2090, time.June)
+	if cancelled.End == nil || !cancelled.End.Equal(want) || cancelled.Cancellation == nil ||
+		!cancelled.Cancellation.Effective.Equal(want) || cancelled.Cancellation.Reason != "too_expensive" {
+		t.Fatalf("cancelled subscription ends %v with %+v", cancelled.End, cancelled.Cancellation)
+	}
+}
+
+func TestRenewal(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+
+	c := srv.NewClient()
+	ctx := context.Background()
+
+	end := sdk.Month(2090, time.December)
+	sub, err := c.Create(ctx, sdk.NewSubscription{
+		ServiceName: "Okko",
+		Price:       3990,
+		UserID:      uuid.New(),
+		Start:       sdk.Month(2090, time.January),
+		End:         &end,
+		Renewal:     &sdk.Renewal{TermMonths: 12},
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if sub.Renewal == nil || sub.Renewal.TermMonths != 12 {
+		t.Fatalf("created subscription renews with %+v, want a 12-month term", sub.Renewal)
+	}
+
+	events, err := c.Events(ctx, sub.ID)
+	if err != nil || len(events) != 0 {
+		t.Fatalf("events = %+v, %v, want none", events, err)
+	}
+
+	err = c.Update(ctx, sub.ID, sdk.SubscriptionUpdate{ServiceName: "Okko", Price: 3990, Start: sub.Start, End: &end})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	got, err := c.Get(ctx, sub.ID)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Renewal != nil {
+		t.Errorf("updated subscription renews with %+v, want no renewal", got.Renewal)
+	}
+
+	if _, err := c.Events(ctx, uuid.New()); !errors.Is(err, sdk.ErrNotFound) {
+		t.Errorf("events of an unknown subscription: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestList(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+
+	c := srv.NewClient()
+	ctx := context.Background()
+	userID := uuid.New()
+
+	for i := range 5 {
+		_, err := c.Create(ctx, sdk.NewSubscription{
+			ServiceName: "Service " + string(rune('A'+i)),
+			Price:       100 * (i + 1),
+			UserID:      userID,
+			Start:       sdk.Month(2025, time.January),
+		})
+		if err != nil {
+			t.Fatalf("create %d: %v", i, err)
+		}
+	}
+
+	calls := srv.Calls()
+
+	var prices []int
+	for sub, err := range c.List(ctx, sdk.ListFilter{UserID: userID, PageSize: 2}) {
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		prices = append(prices, sub.Price)
+	}
+
+	if len(prices) != 5 || prices[0] != 100 || prices[4] != 500 {
+		t.Fatalf("listed prices = %v", prices)
+	}
+	if pages := srv.Calls() - calls; pages != 3 {
+		t.Fatalf("list fetched %d pages, want 3", pages)
+	}
+
+	calls = srv.Calls()
+	for range c.List(ctx, sdk.ListFilter{UserID: userID, PageSize: 2}) {
+		break
+	}
+	if pages := srv.Calls() - calls; pages != 1 {
+		t.Fatalf("list stopped after the first item fetched %d pages, want 1", pages)
+	}
+
+	srv.FailNext(http.StatusBadRequest)
+	for _, err := range c.List(ctx, sdk.ListFilter{}) {
+		var valErr *sdk.ValidationError
+		if !errors.As(err, &valErr) {
+			t.Fatalf("failed list: err = %v, want a ValidationError", err)
+		}
+	}
+}
+
+func TestRetry(t *testing.T) {
+	srv := sdktest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	sub := sdk.NewSubscription{
+		ServiceName: "Okko",
+		Price:       200,
+		UserID:      uuid.New(),
+		Start:       sdk.Month(2025, time.May),
+	}
+
+	t.Run("transient failures are retried", func(t *testing.T) {
+		c := srv.NewClient()
+		srv.FailNext(http.StatusServiceUnavailable, http.StatusBadGateway)
+
+		created, err := c.Create(ctx, sub)
+		if err != nil {
+			t.Fatalf("create: %v", err)
+		}
+
+		srv.FailNext(http.StatusTooManyRequests)
+		if _, err := c.Get(ctx, created.ID); err != nil {
+			t.Fatalf("get: %v", err)
+		}
+
+		if n := len(srv.Subscriptions()); n != 1 {
+			t.Fatalf("%d subscriptions stored, want 1", n)
+		}
+	})
+
+	t.Run("attempts are limited", func(t *testing.T) {
+		c := srv.NewClient(sdk.WithRetry(2, time.Millisecond, time.Millisecond))
+		srv.FailNext(http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+
+		calls := srv.Calls()
+		_, err := c.Get(ctx, uuid.New())
+
+		var apiErr *sdk.APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+			t.Fatalf("get: err = %v, want 503", err)
+		}
+		if n := srv.Calls() - calls; n != 2 {
+			t.Fatalf("get made %d attempts, want 2", n)
+		}
+	})
+
+	t.Run("client errors are not retried", func(t *testing.T) {
+		c := srv.NewClient()
+
+		calls := srv.Calls()
+		if _, err := c.Create(ctx, sub); !errors.Is(err, sdk.ErrConflict) {
+			t.Fatalf("create: err = %v, want ErrConflict", err)
+		}
+		if n := srv.Calls() - calls; n != 1 {
+			t.Fatalf("create made %d attempts, want 1", n)
+		}
+	})
+}
@@ -0,0 +1,725 @@
+// Package sdktest provides an in-process fake of the subscription API for tests of code
+// that uses pkg/sdk. It keeps subscriptions in memory and follows the contract of the
+// real service closely enough for clients: problem+json errors, overlap conflicts,
+// idempotency keys, paging, sums, pauses, cancellations and renewal policies.
+// Authorization, tenants, statuses and the renewal job are not modelled, so the events of
+// a subscription are always empty; any credentials are accepted.
+package sdktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"subscription-service/pkg/client"
+	"subscription-service/pkg/sdk"
+)
+
+// APIKey is the key the clients of NewClient authenticate with.
+const APIKey = "sdktest"
+
+const monthLayout = "01-2006"
+
+var monthPattern = regexp.MustCompile(`^(0[1-9]|1[0-2])-20\d{2}$`)
+
+// Server is a fake subscription API listening on a local port.
+type Server struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	subs   []client.Subscription
+	keys   map[string]storedResponse
+	faults []int
+	calls  int
+}
+
+type storedResponse struct {
+	body   []byte
+	status int
+	resp   []byte
+}
+
+// NewServer starts a fake server. It is closed by Close.
+func NewServer() *Server {
+	s := &Server{keys: make(map[string]storedResponse)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /subscriptions", s.list)
+	mux.HandleFunc("POST /subscriptions", s.create)
+	mux.HandleFunc("GET /subscriptions/sum", s.sum)
+	mux.HandleFunc("GET /subscriptions/{id}", s.get)
+	mux.HandleFunc("PUT /subscriptions/{id}", s.update)
+	mux.HandleFunc("DELETE /subscriptions/{id}", s.delete)
+	mux.HandleFunc("POST /subscriptions/{id}/pause", s.pause)
+	mux.HandleFunc("POST /subscriptions/{id}/resume", s.resume)
+	mux.HandleFunc("POST /subscriptions/{id}/cancel", s.cancel)
+	mux.HandleFunc("GET /subscriptions/{id}/events", s.events)
+
+	s.Server = httptest.NewServer(s.intercept(mux))
+
+	return s
+}
+
+// NewClient returns an SDK client of the server. Retries wait a millisecond, so tests
+// of failures stay fast; opts may override it.
+func (s *Server) NewClient(opts ...sdk.Option) *sdk.Client {
+	opts = append([]sdk.Option{
+		sdk.WithAPIKey(APIKey),
+		sdk.WithHTTPClient(s.Client()),
+		sdk.WithRetry(3, time.Millisecond, time.Millisecond),
+	}, opts...)
+
+	c, err := sdk.New(s.URL, opts...)
+	if err != nil {
+		// The URL of an httptest server is always valid.
+		panic(err)
+	}
+
+	return c
+}
+
+// FailNext makes the next requests fail with the statuses, one request per status, before
+// they reach the fake. A 429 or 503 carries Retry-After: 0.
+func (s *Server) FailNext(statuses ...int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, statuses...)
+}
+
+// Calls returns the number of requests the server received.
+func (s *Server) Calls() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.calls
+}
+
+// Subscriptions returns the stored subscriptions in the order of creation.
+func (s *Server) Subscriptions() []client.Subscription {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]client.Subscription(nil), s.subs...)
+}
+
+func (s *Server) intercept(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.calls++
+
+		var fault int
+		if len(s.faults) > 0 {
+			fault, s.faults = s.faults[0], s.faults[1:]
+		}
+		s.mu.Unlock()
+
+		if fault != 0 {
+			if fault == http.StatusTooManyRequests || fault == http.StatusServiceUnavailable {
+				w.Header().Set("Retry-After", "0")
+			}
+			writeProblem(w, fault, "injected-fault", http.StatusText(fault), "fault injected by sdktest")
+			return
+		}
+
+		if r.Header.Get("X-API-Key") == "" && r.Header.Get("Authorization") == "" {
+			writeProblem(w, http.StatusUnauthorized, "unauthorized", "Authentication required", "")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) create(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeProblem(w, http.StatusBadRequest, "malformed-request", "Malformed request", err.Error())
+		return
+	}
+
+	var req client.CreateSubscriptionRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "malformed-request", "Malformed request", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := r.Header.Get("Idempotency-Key")
+	if stored, ok := s.keys[key]; ok && key != "" {
+		if !bytes.Equal(stored.body, body) {
+			writeProblem(w, http.StatusUnprocessableEntity, "idempotency-key-reused", "Idempotency key reused",
+				"the key was used with another request")
+			return
+		}
+		w.Header().Set("Idempotent-Replayed", "true")
+		writeJSON(w, stored.status, json.RawMessage(stored.resp))
+		return
+	}
+
+	// The fake has no catalog to take a default price from.
+	if req.Price == nil {
+		writeProblem(w, http.StatusUnprocessableEntity, "invalid-subscription-data", "Invalid subscription data",
+			"the price is required, "+req.ServiceName+" has no default price")
+		return
+	}
+
+	now := time.Now().UTC()
+	sub := client.Subscription{
+		Id:          ptr(uuid.New()),
+		ServiceName: req.ServiceName,
+		Price:       *req.Price,
+		UserId:      req.UserId,
+		StartDate:   req.StartDate,
+		EndDate:     req.EndDate,
+		Phases:      req.Phases,
+		Renewal:     autoRenewal(req.Renewal),
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+	}
+
+	if !s.validSubscription(w, sub, "body") {
+		return
+	}
+
+	s.subs = append(s.subs, sub)
+
+	resp, _ := json.Marshal(sub)
+	if key != "" {
+		s.keys[key] = storedResponse{body: body, status: http.StatusCreated, resp: resp}
+	}
+
+	writeJSON(w, http.StatusCreated, json.RawMessage(resp))
+}
+
+func (s *Server) get(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, ok := s.find(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.subs[i])
+}
+
+func (s *Server) update(w http.ResponseWriter, r *http.Request) {
+	var req client.UpdateSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "malformed-request", "Malformed request", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, ok := s.find(w, r)
+	if !ok {
+		return
+	}
+
+	sub := s.subs[i]
+	sub.ServiceName = req.ServiceName
+	sub.Price = req.Price
+	sub.StartDate = req.StartDate
+	sub.EndDate = req.EndDate
+	sub.Phases = req.Phases
+	sub.Renewal = autoRenewal(req.Renewal)
+	sub.UpdatedAt = ptr(time.Now().UTC())
+
+	if !s.validSubscription(w, sub, "body") {
+		return
+	}
+
+	s.subs[i] = sub
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) events(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.find(w, r); !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, client.SubscriptionEventList{Items: []client.SubscriptionEvent{}})
+}
+
+func (s *Server) delete(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, ok := s.find(w, r)
+	if !ok {
+		return
+	}
+
+	s.subs = append(s.subs[:i], s.subs[i+1:]...)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// pause adds the pause of the request to the subscription. Unlike the service it does
+// not check that the pause fits the subscription or clears its other pauses.
+func (s *Server) pause(w http.ResponseWriter, r *http.Request) {
+	var req client.PauseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "malformed-request", "Malformed request", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, ok := s.find(w, r)
+	if !ok {
+		return
+	}
+
+	start := time.Now().UTC().Format(monthLayout)
+	if req.StartDate != nil {
+		start = *req.StartDate
+	}
+
+	sub := s.subs[i]
+	pauses := append(deref(sub.Pauses), client.Pause{StartDate: start, EndDate: req.EndDate})
+	sub.Pauses = &pauses
+	sub.UpdatedAt = ptr(time.Now().UTC())
+	s.subs[i] = sub
+
+	writeJSON(w, http.StatusOK, sub)
+}
+
+// resume ends the open pause of the subscription the month before the resume month, or
+// removes it when it starts in that month. Pauses with an end cannot be resumed.
+func (s *Server) resume(w http.ResponseWriter, r *http.Request) {
+	var req client.ResumeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "malformed-request", "Malformed request", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, ok := s.find(w, r)
+	if !ok {
+		return
+	}
+
+	month := time.Now().UTC()
+	if req.ResumeDate != nil {
+		month, _ = time.Parse(monthLayout, *req.ResumeDate)
+	}
+
+	sub := s.subs[i]
+	pauses := deref(sub.Pauses)
+
+	j := slices.IndexFunc(pauses, func(p client.Pause) bool { return p.EndDate == nil })
+	if j < 0 {
+		writeProblem(w, http.StatusUnprocessableEntity, "invalid-subscription-data", "Invalid subscription data",
+			"the subscription is not paused")
+		return
+	}
+
+	resumed := month.Format(monthLayout)
+	if pauses[j].StartDate == resumed {
+		pauses = slices.Delete(pauses, j, j+1)
+	} else {
+		pauses[j].EndDate = ptr(time.Date(month.Year(), month.Month()-1, 1, 0, 0, 0, 0, time.UTC).Format(monthLayout))
+	}
+
+	sub.Pauses = &pauses
+	if len(pauses) == 0 {
+		sub.Pauses = nil
+	}
+	sub.UpdatedAt = ptr(time.Now().UTC())
+	s.subs[i] = sub
+
+	writeJSON(w, http.StatusOK, sub)
+}
+
+// cancel sets the end date and the cancellation of the subscription. Any reason is
+// accepted, and phases and pauses are left as they are.
+func (s *Server) cancel(w http.ResponseWriter, r *http.Request) {
+	var req client.CancelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeProblem(w, http.StatusBadRequest, "malformed-request", "Malformed request", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i, ok := s.find(w, r)
+	if !ok {
+		return
+	}
+
+	now := time.Now().UTC()
+	sub := s.subs[i]
+
+	if sub.EndDate != nil && endOf(sub).Before(time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)) {
+		writeProblem(w, http.StatusConflict, "subscription-ended", "Subscription has ended",
+			"subscription has already ended")
+		return
+	}
+
+	effective := now.Format(monthLayout)
+	if start, _ := time.Parse(monthLayout, sub.StartDate); start.After(now) {
+		effective = sub.StartDate
+	}
+	if req.EffectiveDate != nil {
+		effective = *req.EffectiveDate
+	}
+
+	note := ""
+	if req.Note != nil {
+		note = *req.Note
+	}
+
+	sub.EndDate = &effective
+	sub.Cancellation = &client.Cancellation{EffectiveDate: effective, Reason: req.Reason, Note: note, CancelledAt: now}
+	sub.UpdatedAt = &now
+	s.subs[i] = sub
+
+	writeJSON(w, http.StatusOK, sub)
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	var invalid []client.InvalidParam
+
+	limit := queryInt(q.Get("limit"), 100, 1, 1000, "limit", &invalid)
+	offset := queryInt(q.Get("offset"), 0, 0, 10000, "offset", &invalid)
+	price := queryInt(q.Get("price"), -1, 0, 1000000, "price", &invalid)
+	match := filter(q, &invalid)
+
+	if len(invalid) > 0 {
+		writeValidation(w, invalid)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	page := []client.Subscription{}
+	skipped := 0
+
+	for _, sub := range s.subs {
+		if !match(sub) || price >= 0 && sub.Price != price {
+			continue
+		}
+		if skipped < offset {
+			skipped++
+			continue
+		}
+		if len(page) == limit {
+			break
+		}
+		page = append(page, sub)
+	}
+
+	writeJSON(w, http.StatusOK, page)
+}
+
+// sum adds up the price of every month of the period each subscription is billed in:
+// at the price of the phase covering it or the regular one, and not while paused.
+func (s *Server) sum(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	var invalid []client.InvalidParam
+
+	var bounds [2]time.Time
+	for i, name := range []string{"start_date", "end_date"} {
+		switch v := q.Get(name); {
+		case v == "":
+			invalid = append(invalid, client.InvalidParam{Name: name, In: "query", Reason: "is required"})
+		case !monthPattern.MatchString(v):
+			invalid = append(invalid, client.InvalidParam{Name: name, In: "query", Reason: "must be MM-YYYY"})
+		default:
+			bounds[i], _ = time.Parse(monthLayout, v)
+		}
+	}
+
+	// The dates bound the months counted rather than the subscriptions.
+	byOwner := maps.Clone(q)
+	delete(byOwner, "start_date")
+	delete(byOwner, "end_date")
+	match := filter(byOwner, &invalid)
+
+	if len(invalid) > 0 {
+		writeValidation(w, invalid)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	total := 0
+	for _, sub := range s.subs {
+		if !match(sub) {
+			continue
+		}
+		for month := bounds[0]; !month.After(bounds[1]); month = month.AddDate(0, 1, 0) {
+			total += priceAt(sub, month)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, client.AggregationResult{TotalCost: total})
+}
+
+// priceAt returns the price of the subscription in month, zero when it is not billed.
+func priceAt(sub client.Subscription, month time.Time) int {
+	within := func(start string, end *string) bool {
+		from, _ := time.Parse(monthLayout, start)
+		if month.Before(from) {
+			return false
+		}
+		if end == nil {
+			return true
+		}
+		to, _ := time.Parse(monthLayout, *end)
+		return !month.After(to)
+	}
+
+	if !within(sub.StartDate, sub.EndDate) {
+		return 0
+	}
+	for _, p := range deref(sub.Pauses) {
+		if within(p.StartDate, p.EndDate) {
+			return 0
+		}
+	}
+	for _, p := range deref(sub.Phases) {
+		if within(p.StartDate, &p.EndDate) {
+			return p.Price
+		}
+	}
+
+	return sub.Price
+}
+
+// find returns the index of the subscription of the path, writing 404 when there is
+// none. The caller holds the lock.
+func (s *Server) find(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		writeValidation(w, []client.InvalidParam{{Name: "id", In: "path", Reason: "must be a uuid"}})
+		return 0, false
+	}
+
+	for i, sub := range s.subs {
+		if *sub.Id == id {
+			return i, true
+		}
+	}
+
+	writeProblem(w, http.StatusNotFound, "not-found", "Resource not found", "subscription not found")
+
+	return 0, false
+}
+
+// validSubscription checks sub as the service does, writing the problem when it is
+// invalid. The caller holds the lock.
+func (s *Server) validSubscription(w http.ResponseWriter, sub client.Subscription, in client.InvalidParamIn) bool {
+	var invalid []client.InvalidParam
+
+	if sub.ServiceName == "" {
+		invalid = append(invalid, client.InvalidParam{Name: "service_name", In: in, Reason: "is required"})
+	}
+	if sub.Price < 0 {
+		invalid = append(invalid, client.InvalidParam{Name: "price", In: in, Reason: "must be at least 0"})
+	}
+	if !monthPattern.MatchString(sub.StartDate) {
+		invalid = append(invalid, client.InvalidParam{Name: "start_date", In: in, Reason: "must be MM-YYYY"})
+	}
+	if sub.EndDate != nil && !monthPattern.MatchString(*sub.EndDate) {
+		invalid = append(invalid, client.InvalidParam{Name: "end_date", In: in, Reason: "must be MM-YYYY"})
+	}
+
+	if len(invalid) > 0 {
+		writeValidation(w, invalid)
+		return false
+	}
+
+	start, end := period(sub)
+	if end.Before(start) {
+		writeProblem(w, http.StatusUnprocessableEntity, "invalid-subscription-data", "Invalid subscription data",
+			"end_date is before start_date")
+		return false
+	}
+
+	for _, other := range s.subs {
+		if *other.Id == *sub.Id || other.UserId != sub.UserId || other.ServiceName != sub.ServiceName {
+			continue
+		}
+
+		otherStart, otherEnd := period(other)
+		if !start.After(otherEnd) && !otherStart.After(end) {
+			writeProblem(w, http.StatusConflict, "already-exists", "Resource already exists",
+				"subscription already exists")
+			return false
+		}
+	}
+
+	return true
+}
+
+// filter returns the match of the user_id, service_name, start_date and end_date
+// parameters, recording the invalid ones.
+func filter(q map[string][]string, invalid *[]client.InvalidParam) func(client.Subscription) bool {
+	get := func(name string) string {
+		if v := q[name]; len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+
+	var userID uuid.UUID
+	if v := get("user_id"); v != "" {
+		var err error
+		if userID, err = uuid.Parse(v); err != nil {
+			*invalid = append(*invalid, client.InvalidParam{Name: "user_id", In: "query", Reason: "must be a uuid"})
+		}
+	}
+
+	month := func(name string) (time.Time, bool) {
+		v := get(name)
+		if v == "" {
+			return time.Time{}, false
+		}
+		if !monthPattern.MatchString(v) {
+			*invalid = append(*invalid, client.InvalidParam{Name: name, In: "query", Reason: "must be MM-YYYY"})
+			return time.Time{}, false
+		}
+		t, _ := time.Parse(monthLayout, v)
+		return t, true
+	}
+
+	from, hasFrom := month("start_date")
+	to, hasTo := month("end_date")
+	serviceName := get("service_name")
+
+	return func(sub client.Subscription) bool {
+		start, _ := time.Parse(monthLayout, sub.StartDate)
+
+		switch {
+		case userID != uuid.Nil && sub.UserId != userID:
+			return false
+		case serviceName != "" && sub.ServiceName != serviceName:
+			return false
+		case hasFrom && start.Before(from):
+			return false
+		case hasTo && (sub.EndDate == nil || endOf(sub).After(to)):
+			return false
+		default:
+			return true
+		}
+	}
+}
+
+// period returns the first and last month of sub; an open end is far in the future.
+func period(sub client.Subscription) (time.Time, time.Time) {
+	start, _ := time.Parse(monthLayout, sub.StartDate)
+	if sub.EndDate == nil {
+		return start, time.Date(9999, time.December, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	return start, endOf(sub)
+}
+
+func endOf(sub client.Subscription) time.Time {
+	end, _ := time.Parse(monthLayout, *sub.EndDate)
+	return end
+}
+
+func queryInt(raw string, def, lo, hi int, name string, invalid *[]client.InvalidParam) int {
+	if raw == "" {
+		return def
+	}
+
+	v, err := strconv.Atoi(raw)
+	if err != nil || v < lo || v > hi {
+		*invalid = append(*invalid, client.InvalidParam{
+			Name:   name,
+			In:     "query",
+			Reason: fmt.Sprintf("must be an integer from %d to %d", lo, hi),
+		})
+		return def
+	}
+
+	return v
+}
+
+func writeValidation(w http.ResponseWriter, invalid []client.InvalidParam) {
+	writeJSONAs(w, "application/problem+json", http.StatusBadRequest, client.Problem{
+		Type:          "/problems/validation-error",
+		Title:         "Request validation failed",
+		Status:        http.StatusBadRequest,
+		InvalidParams: &invalid,
+	})
+}
+
+func writeProblem(w http.ResponseWriter, status int, problemType, title, detail string) {
+	problem := client.Problem{
+		Type:   "/problems/" + problemType,
+		Title:  title,
+		Status: status,
+	}
+	if detail != "" {
+		problem.Detail = &detail
+	}
+
+	writeJSONAs(w, "application/problem+json", status, problem)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	writeJSONAs(w, "application/json", status, v)
+}
+
+func writeJSONAs(w http.ResponseWriter, contentType string, status int, v any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// autoRenewal is the renewal of a subscription as the service returns it: only automatic
+// renewal is shown.
+func autoRenewal(r *client.Renewal) *client.Renewal {
+	if r == nil || r.Policy != client.Auto {
+		return nil
+	}
+
+	return r
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// deref returns a copy of the slice p points to, nil when p is nil.
+func deref[T any](p *[]T) []T {
+	if p == nil {
+		return nil
+	}
+
+	return slices.Clone(*p)
+}
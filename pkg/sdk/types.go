@@ -0,0 +1,303 @@
+package sdk
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"subscription-service/pkg/client"
+)
+
+// monthLayout is the format of months in the API.
+const monthLayout = "01-2006"
+
+// Subscription is a paid subscription of a user to a service. Months are the first
+// instant of the month in UTC.
+type Subscription struct {
+	ID          uuid.UUID
+	ServiceName string
+	// Price is the monthly price in rubles.
+	Price  int
+	UserID uuid.UUID
+	Start  time.Time
+	// End is the last month of the subscription; nil when it is open-ended.
+	End *time.Time
+	// Phases are the periods billed at their own price, such as a free trial.
+	Phases []Phase
+	// Renewal is set when the subscription renews automatically.
+	Renewal *Renewal
+	// Pauses are the periods on hold, which are not billed.
+	Pauses []Pause
+	Status Status
+	// Cancellation is set once the subscription is cancelled.
+	Cancellation *Cancellation
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Cancellation records why a subscription was cancelled. Effective is its last month.
+type Cancellation struct {
+	Effective   time.Time
+	Reason      string
+	Note        string
+	CancelledAt time.Time
+}
+
+// Renewal makes a subscription with an end renew automatically: once its last month
+// starts, the end moves TermMonths later.
+type Renewal struct {
+	TermMonths int
+}
+
+// Event is an entry in the history of a subscription. Renewal is set on EventRenewed.
+type Event struct {
+	ID        uuid.UUID
+	Type      EventType
+	Renewal   *RenewalEvent
+	CreatedAt time.Time
+}
+
+// EventType is the kind of an Event.
+type EventType string
+
+const (
+	EventRenewed EventType = "renewed"
+)
+
+// RenewalEvent is a term added by an automatic renewal: the end of the subscription
+// moved from PreviousEnd to End.
+type RenewalEvent struct {
+	PreviousEnd time.Time
+	End         time.Time
+	TermMonths  int
+}
+
+// Status is the state of a subscription in the current month.
+type Status string
+
+const (
+	StatusScheduled Status = "scheduled"
+	StatusActive    Status = "active"
+	StatusPaused    Status = "paused"
+	StatusEnded     Status = "ended"
+)
+
+// Pause is a period a subscription is on hold. End is the last paused month; nil while
+// the pause lasts until the subscription is resumed.
+type Pause struct {
+	Start time.Time
+	End   *time.Time
+}
+
+// Phase is a period of a subscription with its own price. Start and End are inclusive
+// months.
+type Phase struct {
+	Start time.Time
+	End   time.Time
+	Price int
+}
+
+// NewSubscription is the input of Client.Create. Only the year and month of Start and
+// End are sent.
+type NewSubscription struct {
+	ServiceName string
+	Price       int
+	UserID      uuid.UUID
+	Start       time.Time
+	End         *time.Time
+	Phases      []Phase
+	// Renewal makes the subscription renew automatically; it requires End.
+	Renewal *Renewal
+	// IdempotencyKey makes the create safe to retry across processes. When empty, the
+	// client generates one per call, which still covers its own retries.
+	IdempotencyKey string
+}
+
+// SubscriptionUpdate is the input of Client.Update. It replaces the whole subscription,
+// so a nil End clears the end date, nil Phases remove the phases and a nil Renewal stops
+// automatic renewal.
+type SubscriptionUpdate struct {
+	ServiceName string
+	Price       int
+	Start       time.Time
+	End         *time.Time
+	Phases      []Phase
+	Renewal     *Renewal
+}
+
+// ListFilter narrows Client.List. Zero fields don't filter.
+type ListFilter struct {
+	UserID      uuid.UUID
+	ServiceName string
+	Price       *int
+	// Start keeps subscriptions starting in the month or later.
+	Start time.Time
+	// End keeps subscriptions ending in the month or earlier.
+	End time.Time
+	// PageSize is the number of subscriptions fetched per request; 100 when zero, at
+	// most 1000.
+	PageSize int
+}
+
+// SumFilter selects the subscriptions whose prices Client.Sum adds up. Start and End
+// are required.
+type SumFilter struct {
+	Start       time.Time
+	End         time.Time
+	UserID      uuid.UUID
+	ServiceName string
+}
+
+// Month returns the first instant of the month of t in UTC.
+func Month(year int, month time.Month) time.Time {
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// FormatMonth formats the month of t as the API expects it, "MM-YYYY".
+func FormatMonth(t time.Time) string {
+	return t.Format(monthLayout)
+}
+
+// ParseMonth parses a "MM-YYYY" month of the API.
+func ParseMonth(s string) (time.Time, error) {
+	t, err := time.Parse(monthLayout, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("sdk: invalid month %q: %w", s, err)
+	}
+
+	return t, nil
+}
+
+func formatMonthPtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+
+	s := FormatMonth(*t)
+
+	return &s
+}
+
+func fromClient(s client.Subscription) (Subscription, error) {
+	sub := Subscription{
+		ServiceName: s.ServiceName,
+		Price:       s.Price,
+		UserID:      s.UserId,
+	}
+
+	if s.Id != nil {
+		sub.ID = *s.Id
+	}
+	if s.CreatedAt != nil {
+		sub.CreatedAt = *s.CreatedAt
+	}
+	if s.UpdatedAt != nil {
+		sub.UpdatedAt = *s.UpdatedAt
+	}
+
+	var err error
+	if sub.Start, err = ParseMonth(s.StartDate); err != nil {
+		return Subscription{}, err
+	}
+
+	if s.EndDate != nil {
+		end, err := ParseMonth(*s.EndDate)
+		if err != nil {
+			return Subscription{}, err
+		}
+		sub.End = &end
+	}
+
+	if s.Cancellation != nil {
+		effective, err := ParseMonth(s.Cancellation.EffectiveDate)
+		if err != nil {
+			return Subscription{}, err
+		}
+		sub.Cancellation = &Cancellation{
+			Effective:   effective,
+			Reason:      s.Cancellation.Reason,
+			Note:        s.Cancellation.Note,
+			CancelledAt: s.Cancellation.CancelledAt,
+		}
+	}
+
+	if s.Status != nil {
+		sub.Status = Status(*s.Status)
+	}
+
+	if s.Renewal != nil && s.Renewal.Policy == client.Auto && s.Renewal.TermMonths != nil {
+		sub.Renewal = &Renewal{TermMonths: *s.Renewal.TermMonths}
+	}
+
+	if s.Pauses != nil {
+		for _, p := range *s.Pauses {
+			pause := Pause{}
+			if pause.Start, err = ParseMonth(p.StartDate); err != nil {
+				return Subscription{}, err
+			}
+			if p.EndDate != nil {
+				end, err := ParseMonth(*p.EndDate)
+				if err != nil {
+					return Subscription{}, err
+				}
+				pause.End = &end
+			}
+			sub.Pauses = append(sub.Pauses, pause)
+		}
+	}
+
+	if s.Phases != nil {
+		for _, p := range *s.Phases {
+			phase := Phase{Price: p.Price}
+			if phase.Start, err = ParseMonth(p.StartDate); err != nil {
+				return Subscription{}, err
+			}
+			if phase.End, err = ParseMonth(p.EndDate); err != nil {
+				return Subscription{}, err
+			}
+			sub.Phases = append(sub.Phases, phase)
+		}
+	}
+
+	return sub, nil
+}
+
+func toClientPhases(phases []Phase) *[]client.Phase {
+	if len(phases) == 0 {
+		return nil
+	}
+
+	out := make([]client.Phase, len(phases))
+	for i, p := range phases {
+		out[i] = client.Phase{StartDate: FormatMonth(p.Start), EndDate: FormatMonth(p.End), Price: p.Price}
+	}
+
+	return &out
+}
+
+func toClientRenewal(r *Renewal) *client.Renewal {
+	if r == nil {
+		return nil
+	}
+
+	return &client.Renewal{Policy: client.Auto, TermMonths: &r.TermMonths}
+}
+
+func fromClientEvent(e client.SubscriptionEvent) (Event, error) {
+	event := Event{ID: e.Id, Type: EventType(e.Type), CreatedAt: e.CreatedAt}
+
+	if r := e.Renewal; r != nil {
+		previous, err := ParseMonth(r.PreviousEndDate)
+		if err != nil {
+			return Event{}, err
+		}
+		end, err := ParseMonth(r.EndDate)
+		if err != nil {
+			return Event{}, err
+		}
+		event.Renewal = &RenewalEvent{PreviousEnd: previous, End: end, TermMonths: r.TermMonths}
+	}
+
+	return event, nil
+}
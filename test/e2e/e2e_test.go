@@ -0,0 +1,180 @@
+// Package e2e_test replays the scenarios of testdata against the full router of the
+// service: authentication, validation against the OpenAPI contract, idempotency and the
+// use cases on top of in-memory storage, or Postgres when TEST_DATABASE_URL is set; the
+// database is migrated first.
+//
+// A scenario is a JSON Lines file with a request and the expected response per line; see
+// step for the format. Every scenario gets fresh variables, so scenarios don't share data
+// even on a reused database:
+//   - {{admin_key}}: the static platform admin key;
+//   - {{tenant}}, {{other_tenant}}: new tenant ids;
+//   - {{user_id}}, {{other_user_id}}, {{missing_id}}: new uuids.
+package e2e_test
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"subscription-service/internal/app"
+	"subscription-service/internal/config"
+	handler "subscription-service/internal/controller/http"
+)
+
+const adminKey = "e2e-admin-key-0123456789abcdefghijklmnop"
+
+func TestScenarios(t *testing.T) {
+	cfg := config.Default()
+	cfg.Storage = config.StorageMemory
+	cfg.Auth.AdminAPIKey = adminKey
+	cfg.RateLimit.Backend = config.RateLimitBackendOff
+
+	if connStr := os.Getenv("TEST_DATABASE_URL"); connStr != "" {
+		cfg.Storage = config.StoragePostgres
+		cfg.DBConfig.ConnectionString = connStr
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if cfg.Storage == config.StoragePostgres {
+		if err := app.Migrate(ctx, &cfg, "up", io.Discard); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	service, err := app.NewService(ctx, &cfg, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	router, err := service.Server.Router(append(service.RouterOptions, handler.WithResponseValidation())...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	operations := loadOperations(t)
+
+	files, err := filepath.Glob(filepath.Join("testdata", "*.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no scenarios in testdata")
+	}
+
+	for _, file := range files {
+		t.Run(strings.TrimSuffix(filepath.Base(file), ".jsonl"), func(t *testing.T) {
+			steps, err := readScenario(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			s := &scenario{
+				t:       t,
+				baseURL: srv.URL,
+				client:  srv.Client(),
+				vars: map[string]string{
+					"admin_key":     adminKey,
+					"tenant":        uuid.NewString(),
+					"other_tenant":  uuid.NewString(),
+					"user_id":       uuid.NewString(),
+					"other_user_id": uuid.NewString(),
+					"missing_id":    uuid.NewString(),
+				},
+				hit: operations.hit,
+			}
+
+			s.run(steps)
+		})
+	}
+
+	if missed := operations.missed(); len(missed) > 0 {
+		t.Errorf("operations of the API no scenario calls:\n  %s", strings.Join(missed, "\n  "))
+	}
+}
+
+// operations tracks which operations of the OpenAPI document the scenarios call.
+type operations struct {
+	mu        sync.Mutex
+	templates []pathTemplate
+	called    map[string]bool
+}
+
+type pathTemplate struct {
+	path    string
+	pattern *regexp.Regexp
+}
+
+var pathParam = regexp.MustCompile(`\\\{[^}]+\\\}`)
+
+func loadOperations(t *testing.T) *operations {
+	t.Helper()
+
+	doc, err := openapi3.NewLoader().LoadFromFile(filepath.Join("..", "..", "api", "openapi.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ops := &operations{called: map[string]bool{}}
+
+	for path, item := range doc.Paths.Map() {
+		pattern := pathParam.ReplaceAllString(regexp.QuoteMeta(path), `[^/]+`)
+		ops.templates = append(ops.templates, pathTemplate{path: path, pattern: regexp.MustCompile("^" + pattern + "$")})
+
+		for method := range item.Operations() {
+			ops.called[method+" "+path] = false
+		}
+	}
+
+	// Literal paths win over templates: /subscriptions/sum is not /subscriptions/{id}.
+	sort.Slice(ops.templates, func(i, j int) bool {
+		return strings.Count(ops.templates[i].path, "{") < strings.Count(ops.templates[j].path, "{")
+	})
+
+	return ops
+}
+
+func (o *operations) hit(method, path string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for _, tmpl := range o.templates {
+		if tmpl.pattern.MatchString(path) {
+			if _, ok := o.called[method+" "+tmpl.path]; ok {
+				o.called[method+" "+tmpl.path] = true
+			}
+			return
+		}
+	}
+}
+
+func (o *operations) missed() []string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var missed []string
+	for op, called := range o.called {
+		if !called {
+			missed = append(missed, op)
+		}
+	}
+	sort.Strings(missed)
+
+	return missed
+}
@@ -0,0 +1,323 @@
+package e2e_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// step is one line of a scenario file: a request and the response it must get.
+type step struct {
+	Name     string           `json:"name"`
+	Request  stepRequest      `json:"request"`
+	Response expectedResponse `json:"response"`
+	// Capture stores fields of the response body as variables of the scenario, keyed by
+	// the variable name, with dotted paths such as "0.id".
+	Capture map[string]string `json:"capture"`
+}
+
+type stepRequest struct {
+	Method  string            `json:"method"`
+	Path    string            `json:"path"`
+	Headers map[string]string `json:"headers"`
+	Body    json.RawMessage   `json:"body"`
+}
+
+type expectedResponse struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers"`
+	// Body is matched with matchJSON; absent means the body is not checked.
+	Body json.RawMessage `json:"body"`
+}
+
+// readScenario parses a JSON Lines file. Blank lines and lines starting with # are
+// skipped.
+func readScenario(path string) ([]step, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var steps []step
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		var s step
+		dec := json.NewDecoder(strings.NewReader(text))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&s); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		if s.Name == "" {
+			s.Name = fmt.Sprintf("line %d", line)
+		}
+
+		steps = append(steps, s)
+	}
+
+	return steps, scanner.Err()
+}
+
+var variablePattern = regexp.MustCompile(`\{\{([a-z0-9_]+)\}\}`)
+
+// scenario runs steps against a server and keeps the variables between them.
+type scenario struct {
+	t       *testing.T
+	baseURL string
+	client  *http.Client
+	vars    map[string]string
+	// hit records the operations the steps called, as "METHOD /path/template".
+	hit func(method, path string)
+}
+
+func (s *scenario) run(steps []step) {
+	for _, st := range steps {
+		if !s.runStep(st) {
+			// Later steps depend on the captures of the failed one.
+			return
+		}
+	}
+}
+
+func (s *scenario) runStep(st step) bool {
+	s.t.Helper()
+
+	path := s.expand(st.Request.Path)
+
+	var body io.Reader
+	if len(st.Request.Body) > 0 {
+		body = strings.NewReader(s.expand(string(st.Request.Body)))
+	}
+
+	req, err := http.NewRequest(st.Request.Method, s.baseURL+path, body)
+	if err != nil {
+		s.t.Errorf("%s: %v", st.Name, err)
+		return false
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for name, value := range st.Request.Headers {
+		req.Header.Set(name, s.expand(value))
+	}
+
+	s.hit(req.Method, req.URL.Path)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		s.t.Errorf("%s: %v", st.Name, err)
+		return false
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		s.t.Errorf("%s: read response: %v", st.Name, err)
+		return false
+	}
+
+	var diffs []string
+
+	if resp.StatusCode != st.Response.Status {
+		diffs = append(diffs, fmt.Sprintf("status: expected %d, got %d", st.Response.Status, resp.StatusCode))
+	}
+
+	for name, want := range st.Response.Headers {
+		got := resp.Header.Get(name)
+		if !matchString(s.expand(want), got, got != "") {
+			diffs = append(diffs, fmt.Sprintf("header %s: expected %q, got %q", name, want, got))
+		}
+	}
+
+	var actual any
+	if len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, &actual); err != nil && len(st.Response.Body) > 0 {
+			diffs = append(diffs, fmt.Sprintf("body is not JSON: %v", err))
+		}
+	}
+
+	if len(st.Response.Body) > 0 {
+		var expected any
+		if err := json.Unmarshal([]byte(s.expand(string(st.Response.Body))), &expected); err != nil {
+			s.t.Errorf("%s: expected body: %v", st.Name, err)
+			return false
+		}
+		diffs = append(diffs, matchJSON("body", expected, actual)...)
+	}
+
+	if len(diffs) > 0 {
+		s.t.Errorf("%s: %s %s\n  %s\n  response body: %s",
+			st.Name, req.Method, path, strings.Join(diffs, "\n  "), bytes.TrimSpace(respBody))
+		return false
+	}
+
+	for name, field := range st.Capture {
+		value, ok := lookup(actual, field)
+		if !ok {
+			s.t.Errorf("%s: capture %s: no field %q in the response", st.Name, name, field)
+			return false
+		}
+		s.vars[name] = fmt.Sprint(value)
+	}
+
+	return true
+}
+
+// expand replaces {{name}} with the variables of the scenario. Unknown names are left
+// as they are, so that the mismatch shows up in the diff.
+func (s *scenario) expand(text string) string {
+	return variablePattern.ReplaceAllStringFunc(text, func(m string) string {
+		if v, ok := s.vars[variablePattern.FindStringSubmatch(m)[1]]; ok {
+			return v
+		}
+		return m
+	})
+}
+
+// volatileFields differ on every run. They are ignored when the expected object leaves
+// them out; to check one, list it with a placeholder such as "$uuid".
+var volatileFields = []string{"id", "created_at", "updated_at", "request_id", "key", "prefix"}
+
+// matchJSON compares an expected JSON value with the actual one and returns the
+// differences. Objects must have the same keys, except for volatile fields; arrays must
+// have the same length. These strings in the expectation match a class of values:
+//   - "$any": any value, including null;
+//   - "$string": any non-empty string;
+//   - "$uuid": a UUID;
+//   - "$datetime": an RFC 3339 timestamp.
+func matchJSON(path string, expected, actual any) []string {
+	switch exp := expected.(type) {
+	case map[string]any:
+		act, ok := actual.(map[string]any)
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected an object, got %s", path, show(actual))}
+		}
+
+		var diffs []string
+
+		keys := make([]string, 0, len(exp)+len(act))
+		for k := range exp {
+			keys = append(keys, k)
+		}
+		for k := range act {
+			if _, ok := exp[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			e, inExp := exp[k]
+			a, inAct := act[k]
+
+			switch {
+			case !inAct:
+				diffs = append(diffs, fmt.Sprintf("%s.%s: missing, expected %s", path, k, show(e)))
+			case !inExp && !slices.Contains(volatileFields, k):
+				diffs = append(diffs, fmt.Sprintf("%s.%s: unexpected %s", path, k, show(a)))
+			case inExp:
+				diffs = append(diffs, matchJSON(path+"."+k, e, a)...)
+			}
+		}
+
+		return diffs
+	case []any:
+		act, ok := actual.([]any)
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected an array, got %s", path, show(actual))}
+		}
+		if len(act) != len(exp) {
+			return []string{fmt.Sprintf("%s: expected %d elements, got %d: %s", path, len(exp), len(act), show(actual))}
+		}
+
+		var diffs []string
+		for i := range exp {
+			diffs = append(diffs, matchJSON(fmt.Sprintf("%s[%d]", path, i), exp[i], act[i])...)
+		}
+
+		return diffs
+	case string:
+		got, isString := actual.(string)
+		if exp == "$any" || matchString(exp, got, isString) {
+			return nil
+		}
+	default:
+		if fmt.Sprint(expected) == fmt.Sprint(actual) {
+			return nil
+		}
+	}
+
+	return []string{fmt.Sprintf("%s: expected %s, got %s", path, show(expected), show(actual))}
+}
+
+// matchString matches a string against an expected value or placeholder; present tells
+// whether there is a string at all.
+func matchString(expected, actual string, present bool) bool {
+	switch expected {
+	case "$any":
+		return true
+	case "$string":
+		return present && actual != ""
+	case "$uuid":
+		_, err := uuid.Parse(actual)
+		return present && err == nil
+	case "$datetime":
+		_, err := time.Parse(time.RFC3339, actual)
+		return present && err == nil
+	default:
+		return present && expected == actual
+	}
+}
+
+// lookup follows a dotted path of object keys and array indexes.
+func lookup(v any, path string) (any, bool) {
+	for _, part := range strings.Split(path, ".") {
+		switch node := v.(type) {
+		case map[string]any:
+			next, ok := node[part]
+			if !ok {
+				return nil, false
+			}
+			v = next
+		case []any:
+			var i int
+			if _, err := fmt.Sscan(part, &i); err != nil || i < 0 || i >= len(node) {
+				return nil, false
+			}
+			v = node[i]
+		default:
+			return nil, false
+		}
+	}
+
+	return v, true
+}
+
+func show(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+
+	return string(data)
+}